@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		spec        AppArmorProfileSpec
+		errContains string
+	}{
+		{
+			name: "Abstract profile",
+			spec: AppArmorProfileSpec{
+				Abstract: AppArmorAbstract{Filesystem: &AppArmorFsRules{}},
+			},
+		},
+		{
+			name: "Raw profile",
+			spec: AppArmorProfileSpec{Raw: "profile test {}"},
+		},
+		{
+			name: "Raw profile with abstract",
+			spec: AppArmorProfileSpec{
+				Raw:      "profile test {}",
+				Abstract: AppArmorAbstract{Filesystem: &AppArmorFsRules{}},
+			},
+			errContains: "raw cannot be combined with abstract",
+		},
+		{
+			name: "Raw profile with base profile",
+			spec: AppArmorProfileSpec{
+				Raw:             "profile test {}",
+				BaseProfileName: "base",
+			},
+			errContains: "raw cannot be combined with baseProfileName",
+		},
+		{
+			name:        "Invalid UTF-8",
+			spec:        AppArmorProfileSpec{Raw: string([]byte{0xff, 0xfe, 0xfd})},
+			errContains: "raw must be valid UTF-8",
+		},
+		{
+			name:        "Contains null byte",
+			spec:        AppArmorProfileSpec{Raw: "profile test {\x00}"},
+			errContains: "raw must not contain null bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &AppArmorProfile{Spec: tt.spec}
+
+			err := sp.ValidateRaw()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,6 +18,9 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +34,10 @@ var (
 	_ profilebasev1.SecurityProfileBase = &AppArmorProfile{}
 )
 
+// ResolveBaseProfilesAnnotation can be set to a new value to re-resolve the
+// tags of remote base profiles to their current digests.
+const ResolveBaseProfilesAnnotation = "spo.x-k8s.io/resolve-base-profiles"
+
 // AppArmorExecutablesRules stores the rules for allowed executable.
 type AppArmorExecutablesRules struct {
 	// allowedExecutables is a list of allowed executables.
@@ -41,6 +48,44 @@ type AppArmorExecutablesRules struct {
 	// +optional
 	// +listType=set
 	AllowedLibraries []string `json:"allowedLibraries,omitempty"`
+	// transitions is a list of executables which are executed under a
+	// different profile than the current one.
+	// +optional
+	// +listType=atomic
+	Transitions []AppArmorExecTransition `json:"transitions,omitempty"`
+}
+
+// AppArmorExecMode describes the profile transition on execution.
+// +kubebuilder:validation:Enum=ix;px;Px;cx;Cx
+type AppArmorExecMode string
+
+const (
+	// AppArmorExecModeInherit executes the program under the current profile.
+	AppArmorExecModeInherit AppArmorExecMode = "ix"
+	// AppArmorExecModeProfile executes the program under its own profile.
+	AppArmorExecModeProfile AppArmorExecMode = "px"
+	// AppArmorExecModeProfileScrubbed executes the program under its own
+	// profile and scrubs the environment.
+	AppArmorExecModeProfileScrubbed AppArmorExecMode = "Px"
+	// AppArmorExecModeChild executes the program under a child profile.
+	AppArmorExecModeChild AppArmorExecMode = "cx"
+	// AppArmorExecModeChildScrubbed executes the program under a child
+	// profile and scrubs the environment.
+	AppArmorExecModeChildScrubbed AppArmorExecMode = "Cx"
+)
+
+// AppArmorExecTransition stores the rule for executing a program under a
+// different profile.
+type AppArmorExecTransition struct {
+	// path is the path of the executable.
+	Path string `json:"path"`
+	// mode is the exec mode used for the transition.
+	Mode AppArmorExecMode `json:"mode"`
+	// profile is the name of the target profile. The profile attached to
+	// the path is used if empty. Child profiles are referenced by their
+	// name only, the full name is `<profile>//<child>`.
+	// +optional
+	Profile string `json:"profile,omitempty"`
 }
 
 // AppArmorFsRules stores the rules for file system access.
@@ -57,6 +102,25 @@ type AppArmorFsRules struct {
 	// +optional
 	// +listType=set
 	ReadWritePaths []string `json:"readWritePaths,omitempty"`
+	// rules is a list of file rules with explicit access modes.
+	// +optional
+	// +listType=atomic
+	Rules []AppArmorFileRule `json:"rules,omitempty"`
+}
+
+// AppArmorFileRule stores a file access rule for a single path.
+type AppArmorFileRule struct {
+	// path is the file path, which may contain AppArmor globs.
+	Path string `json:"path"`
+	// mode is the access mode, for example `r`, `rw` or `mrwlk`.
+	// +kubebuilder:validation:Pattern=`^[rwalkm]+$`
+	Mode string `json:"mode"`
+	// owner restricts the rule to files owned by the user of the process.
+	// +optional
+	Owner bool `json:"owner,omitempty"`
+	// deny explicitly denies the access.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
 }
 
 // AppArmorAllowedProtocols stores the rules for allowed networking protocols.
@@ -77,6 +141,26 @@ type AppArmorNetworkRules struct {
 	// allowedProtocols keeps the allowed networking protocols.
 	// +optional
 	Protocols *AppArmorAllowedProtocols `json:"allowedProtocols,omitempty"`
+	// rules is a list of network rules for specific address families and
+	// socket types.
+	// +optional
+	// +listType=atomic
+	Rules []AppArmorNetworkRule `json:"rules,omitempty"`
+}
+
+// AppArmorNetworkRule stores a network access rule.
+type AppArmorNetworkRule struct {
+	// family is the address family, for example `unix`, `inet6` or
+	// `netlink`. All families are matched if empty.
+	// +optional
+	Family string `json:"family,omitempty"`
+	// type is the socket type, for example `stream`, `dgram` or `raw`.
+	// All socket types are matched if empty.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// deny explicitly denies the access.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
 }
 
 // AppArmorCapabilityRules stores the rules of allowed Linux capabilities.
@@ -87,8 +171,145 @@ type AppArmorCapabilityRules struct {
 	AllowedCapabilities []string `json:"allowedCapabilities,omitempty"`
 }
 
+// AppArmorSignalRules stores the rules for sending and receiving signals.
+type AppArmorSignalRules struct {
+	// rules is a list of signal rules.
+	// +optional
+	// +listType=atomic
+	Rules []AppArmorSignalRule `json:"rules,omitempty"`
+}
+
+// AppArmorSignalRule stores a signal rule.
+type AppArmorSignalRule struct {
+	// access is a list of signal permissions, `send` and `receive`.
+	// Both are allowed if empty.
+	// +optional
+	// +listType=set
+	Access []string `json:"access,omitempty"`
+	// signals is a list of signals, for example `term` or `kill`. All
+	// signals are matched if empty.
+	// +optional
+	// +listType=set
+	Signals []string `json:"signals,omitempty"`
+	// peer is the profile name of the other process. All peers are matched
+	// if empty.
+	// +optional
+	Peer string `json:"peer,omitempty"`
+	// deny explicitly denies the access.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
+}
+
+// AppArmorPtraceRules stores the rules for tracing processes.
+type AppArmorPtraceRules struct {
+	// rules is a list of ptrace rules.
+	// +optional
+	// +listType=atomic
+	Rules []AppArmorPtraceRule `json:"rules,omitempty"`
+}
+
+// AppArmorPtraceRule stores a ptrace rule.
+type AppArmorPtraceRule struct {
+	// access is a list of ptrace permissions, `read`, `trace`, `readby`
+	// and `tracedby`. All are allowed if empty.
+	// +optional
+	// +listType=set
+	Access []string `json:"access,omitempty"`
+	// peer is the profile name of the other process. All peers are matched
+	// if empty.
+	// +optional
+	Peer string `json:"peer,omitempty"`
+	// deny explicitly denies the access.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
+}
+
+// AppArmorDbusRules stores the rules for D-Bus access.
+type AppArmorDbusRules struct {
+	// rules is a list of D-Bus rules.
+	// +optional
+	// +listType=atomic
+	Rules []AppArmorDbusRule `json:"rules,omitempty"`
+}
+
+// AppArmorDbusRule stores a D-Bus rule.
+type AppArmorDbusRule struct {
+	// access is a list of D-Bus permissions, `send`, `receive`, `bind` and
+	// `eavesdrop`. All are allowed if empty.
+	// +optional
+	// +listType=set
+	Access []string `json:"access,omitempty"`
+	// bus is the bus type, for example `system` or `session`.
+	// +optional
+	Bus string `json:"bus,omitempty"`
+	// name is the well-known name to bind to, only used for `bind`.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// path is the object path.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// interface is the interface name.
+	// +optional
+	Interface string `json:"interface,omitempty"`
+	// member is the method or signal name.
+	// +optional
+	Member string `json:"member,omitempty"`
+	// peerName is the bus name of the peer.
+	// +optional
+	PeerName string `json:"peerName,omitempty"`
+	// peerLabel is the profile name of the peer.
+	// +optional
+	PeerLabel string `json:"peerLabel,omitempty"`
+	// deny explicitly denies the access.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
+}
+
+// AppArmorMountOperation describes the operation of a mount rule.
+// +kubebuilder:validation:Enum=mount;remount;umount
+type AppArmorMountOperation string
+
+const (
+	AppArmorMountOperationMount   AppArmorMountOperation = "mount"
+	AppArmorMountOperationRemount AppArmorMountOperation = "remount"
+	AppArmorMountOperationUmount  AppArmorMountOperation = "umount"
+)
+
+// AppArmorMountRules stores the rules for mounting file systems.
+type AppArmorMountRules struct {
+	// rules is a list of mount rules.
+	// +optional
+	// +listType=atomic
+	Rules []AppArmorMountRule `json:"rules,omitempty"`
+}
+
+// AppArmorMountRule stores a mount rule.
+type AppArmorMountRule struct {
+	// operation is the mount operation.
+	Operation AppArmorMountOperation `json:"operation"`
+	// fsTypes is a list of file system types, only used for `mount`.
+	// +optional
+	// +listType=set
+	FsTypes []string `json:"fsTypes,omitempty"`
+	// options is a list of mount options, for example `ro` or `nosuid`.
+	// Not used for `umount`.
+	// +optional
+	// +listType=set
+	Options []string `json:"options,omitempty"`
+	// source is the mount source, only used for `mount`.
+	// +optional
+	Source string `json:"source,omitempty"`
+	// mountPoint is the mount point. All mount points are matched if empty.
+	// +optional
+	MountPoint string `json:"mountPoint,omitempty"`
+	// deny explicitly denies the access.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
+}
+
 // AppArmorAbstract AppArmor profile which stores various allowed list for
-// executable, file, network, capabilities access.
+// executable, file, network, capabilities, signal, ptrace, dbus and mount
+// access.
 type AppArmorAbstract struct {
 	// executable defines rules for allowed executables.
 	// +optional
@@ -102,6 +323,18 @@ type AppArmorAbstract struct {
 	// capability defines rules for Linux capabilities.
 	// +optional
 	Capability *AppArmorCapabilityRules `json:"capability,omitempty"`
+	// signal defines rules for sending and receiving signals.
+	// +optional
+	Signal *AppArmorSignalRules `json:"signal,omitempty"`
+	// ptrace defines rules for tracing processes.
+	// +optional
+	Ptrace *AppArmorPtraceRules `json:"ptrace,omitempty"`
+	// dbus defines rules for D-Bus access.
+	// +optional
+	Dbus *AppArmorDbusRules `json:"dbus,omitempty"`
+	// mount defines rules for mounting file systems.
+	// +optional
+	Mount *AppArmorMountRules `json:"mount,omitempty"`
 }
 
 // AppArmorMode describes the enforcement mode for an AppArmor profile.
@@ -118,10 +351,27 @@ type AppArmorProfileSpec struct {
 	// Common spec fields for all profiles.
 	profilebasev1.SpecBase `json:",inline"`
 
-	// abstract stores the apparmor profile allow lists for executable, file, network and capabilities access.
+	// baseProfileName is the name of a base AppArmorProfile whose abstract
+	// will be unioned into this profile. Base profiles can be referenced as
+	// remote OCI artifacts as well when prefixed with `oci://` or as local
+	// OCI image layouts when prefixed with `oci-layout://`.
+	// +optional
+	BaseProfileName string `json:"baseProfileName,omitempty"`
+
+	// abstract stores the apparmor profile rules for executable, file, network, capabilities,
+	// signal, ptrace, dbus and mount access.
 	// +optional
 	Abstract AppArmorAbstract `json:"abstract,omitempty"`
 
+	// raw is a complete AppArmor profile in the policy language which is
+	// loaded verbatim. It must define a single top level profile named
+	// after this resource, child profiles and hats are allowed. raw cannot
+	// be combined with abstract or baseProfileName and mode does not apply
+	// to it. The profile is validated on admission and by apparmor_parser
+	// on each node before being loaded.
+	// +optional
+	Raw string `json:"raw,omitempty"`
+
 	// mode controls the enforcement mode for the AppArmor profile.
 	// In "Complain" mode, violations are logged but allowed.
 	// In "Enforce" mode (the default), violations are denied.
@@ -133,6 +383,28 @@ type AppArmorProfileSpec struct {
 // AppArmorProfileStatus defines the observed state of AppArmorProfile.
 type AppArmorProfileStatus struct {
 	profilebasev1.StatusBase `json:",inline"`
+	// resolvedBaseProfiles lists the remote base profiles pinned to their
+	// digest, which are used by all nodes until they get re-resolved.
+	// +optional
+	// +listType=map
+	// +listMapKey=reference
+	ResolvedBaseProfiles []ResolvedBaseProfile `json:"resolvedBaseProfiles,omitempty"`
+	// observedResolveBaseProfiles is the value of the
+	// spo.x-k8s.io/resolve-base-profiles annotation which has been used for
+	// the last resolution of the remote base profiles.
+	// +optional
+	ObservedResolveBaseProfiles string `json:"observedResolveBaseProfiles,omitempty"`
+}
+
+// ResolvedBaseProfile is a remote base profile pinned to its digest.
+type ResolvedBaseProfile struct {
+	// reference of the base profile as used in the baseProfileName, without
+	// the `oci://` prefix.
+	// +required
+	Reference string `json:"reference"`
+	// digest the reference resolved to.
+	// +required
+	Digest string `json:"digest"`
 }
 
 // +kubebuilder:object:root=true
@@ -203,3 +475,41 @@ func init() { //nolint:gochecknoinits // required to init the scheme
 func (sp *AppArmorProfile) GetProfileName() string {
 	return sp.GetName()
 }
+
+// GetAuditProfileName returns the name of the complain mode variant of the
+// profile, which is used by profile bindings in Audit mode.
+func (sp *AppArmorProfile) GetAuditProfileName() string {
+	return sp.GetProfileName() + profilebasev1.AuditProfileSuffix
+}
+
+// IsRaw returns true if the profile is provided verbatim in the AppArmor
+// policy language instead of being generated from the abstract.
+func (sp *AppArmorProfile) IsRaw() bool {
+	return sp.Spec.Raw != ""
+}
+
+// ValidateRaw checks that a raw profile is not combined with the fields
+// which are used to generate profiles and that it is valid UTF-8 text.
+func (sp *AppArmorProfile) ValidateRaw() error {
+	if !sp.IsRaw() {
+		return nil
+	}
+
+	if sp.Spec.Abstract != (AppArmorAbstract{}) {
+		return errors.New("raw cannot be combined with abstract")
+	}
+
+	if sp.Spec.BaseProfileName != "" {
+		return errors.New("raw cannot be combined with baseProfileName")
+	}
+
+	if !utf8.ValidString(sp.Spec.Raw) {
+		return errors.New("raw must be valid UTF-8")
+	}
+
+	if strings.ContainsRune(sp.Spec.Raw, '\x00') {
+		return errors.New("raw must not contain null bytes")
+	}
+
+	return nil
+}
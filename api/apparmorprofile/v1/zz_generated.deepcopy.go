@@ -47,6 +47,26 @@ func (in *AppArmorAbstract) DeepCopyInto(out *AppArmorAbstract) {
 		*out = new(AppArmorCapabilityRules)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Signal != nil {
+		in, out := &in.Signal, &out.Signal
+		*out = new(AppArmorSignalRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ptrace != nil {
+		in, out := &in.Ptrace, &out.Ptrace
+		*out = new(AppArmorPtraceRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dbus != nil {
+		in, out := &in.Dbus, &out.Dbus
+		*out = new(AppArmorDbusRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mount != nil {
+		in, out := &in.Mount, &out.Mount
+		*out = new(AppArmorMountRules)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorAbstract.
@@ -104,6 +124,63 @@ func (in *AppArmorCapabilityRules) DeepCopy() *AppArmorCapabilityRules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorDbusRule) DeepCopyInto(out *AppArmorDbusRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorDbusRule.
+func (in *AppArmorDbusRule) DeepCopy() *AppArmorDbusRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorDbusRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorDbusRules) DeepCopyInto(out *AppArmorDbusRules) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppArmorDbusRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorDbusRules.
+func (in *AppArmorDbusRules) DeepCopy() *AppArmorDbusRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorDbusRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorExecTransition) DeepCopyInto(out *AppArmorExecTransition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorExecTransition.
+func (in *AppArmorExecTransition) DeepCopy() *AppArmorExecTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorExecTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorExecutablesRules) DeepCopyInto(out *AppArmorExecutablesRules) {
 	*out = *in
@@ -117,6 +194,11 @@ func (in *AppArmorExecutablesRules) DeepCopyInto(out *AppArmorExecutablesRules)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]AppArmorExecTransition, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorExecutablesRules.
@@ -129,6 +211,21 @@ func (in *AppArmorExecutablesRules) DeepCopy() *AppArmorExecutablesRules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorFileRule) DeepCopyInto(out *AppArmorFileRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorFileRule.
+func (in *AppArmorFileRule) DeepCopy() *AppArmorFileRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorFileRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorFsRules) DeepCopyInto(out *AppArmorFsRules) {
 	*out = *in
@@ -147,6 +244,11 @@ func (in *AppArmorFsRules) DeepCopyInto(out *AppArmorFsRules) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppArmorFileRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorFsRules.
@@ -159,6 +261,68 @@ func (in *AppArmorFsRules) DeepCopy() *AppArmorFsRules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorMountRule) DeepCopyInto(out *AppArmorMountRule) {
+	*out = *in
+	if in.FsTypes != nil {
+		in, out := &in.FsTypes, &out.FsTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorMountRule.
+func (in *AppArmorMountRule) DeepCopy() *AppArmorMountRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorMountRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorMountRules) DeepCopyInto(out *AppArmorMountRules) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppArmorMountRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorMountRules.
+func (in *AppArmorMountRules) DeepCopy() *AppArmorMountRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorMountRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorNetworkRule) DeepCopyInto(out *AppArmorNetworkRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorNetworkRule.
+func (in *AppArmorNetworkRule) DeepCopy() *AppArmorNetworkRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorNetworkRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorNetworkRules) DeepCopyInto(out *AppArmorNetworkRules) {
 	*out = *in
@@ -172,6 +336,11 @@ func (in *AppArmorNetworkRules) DeepCopyInto(out *AppArmorNetworkRules) {
 		*out = new(AppArmorAllowedProtocols)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppArmorNetworkRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorNetworkRules.
@@ -264,6 +433,11 @@ func (in *AppArmorProfileSpec) DeepCopy() *AppArmorProfileSpec {
 func (in *AppArmorProfileStatus) DeepCopyInto(out *AppArmorProfileStatus) {
 	*out = *in
 	in.StatusBase.DeepCopyInto(&out.StatusBase)
+	if in.ResolvedBaseProfiles != nil {
+		in, out := &in.ResolvedBaseProfiles, &out.ResolvedBaseProfiles
+		*out = make([]ResolvedBaseProfile, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorProfileStatus.
@@ -275,3 +449,107 @@ func (in *AppArmorProfileStatus) DeepCopy() *AppArmorProfileStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorPtraceRule) DeepCopyInto(out *AppArmorPtraceRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorPtraceRule.
+func (in *AppArmorPtraceRule) DeepCopy() *AppArmorPtraceRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorPtraceRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorPtraceRules) DeepCopyInto(out *AppArmorPtraceRules) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppArmorPtraceRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorPtraceRules.
+func (in *AppArmorPtraceRules) DeepCopy() *AppArmorPtraceRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorPtraceRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorSignalRule) DeepCopyInto(out *AppArmorSignalRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorSignalRule.
+func (in *AppArmorSignalRule) DeepCopy() *AppArmorSignalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorSignalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorSignalRules) DeepCopyInto(out *AppArmorSignalRules) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppArmorSignalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorSignalRules.
+func (in *AppArmorSignalRules) DeepCopy() *AppArmorSignalRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorSignalRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedBaseProfile) DeepCopyInto(out *ResolvedBaseProfile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedBaseProfile.
+func (in *ResolvedBaseProfile) DeepCopy() *ResolvedBaseProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedBaseProfile)
+	in.DeepCopyInto(out)
+	return out
+}
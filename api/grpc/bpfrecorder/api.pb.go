@@ -155,6 +155,7 @@ type SyscallsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Syscalls      []string               `protobuf:"bytes,1,rep,name=syscalls,proto3" json:"syscalls,omitempty"`
 	GoArch        string                 `protobuf:"bytes,2,opt,name=go_arch,json=goArch,proto3" json:"go_arch,omitempty"`
+	SyscallArgs   []*SyscallArgs         `protobuf:"bytes,3,rep,name=syscall_args,json=syscallArgs,proto3" json:"syscall_args,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -203,6 +204,65 @@ func (x *SyscallsResponse) GetGoArch() string {
 	return ""
 }
 
+func (x *SyscallsResponse) GetSyscallArgs() []*SyscallArgs {
+	if x != nil {
+		return x.SyscallArgs
+	}
+	return nil
+}
+
+type SyscallArgs struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Combinations  []*SyscallArgs_Combination `protobuf:"bytes,2,rep,name=combinations,proto3" json:"combinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyscallArgs) Reset() {
+	*x = SyscallArgs{}
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyscallArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyscallArgs) ProtoMessage() {}
+
+func (x *SyscallArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyscallArgs.ProtoReflect.Descriptor instead.
+func (*SyscallArgs) Descriptor() ([]byte, []int) {
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SyscallArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SyscallArgs) GetCombinations() []*SyscallArgs_Combination {
+	if x != nil {
+		return x.Combinations
+	}
+	return nil
+}
+
 type ApparmorResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Files         *ApparmorResponse_Files  `protobuf:"bytes,1,opt,name=files,proto3" json:"files,omitempty"`
@@ -214,7 +274,7 @@ type ApparmorResponse struct {
 
 func (x *ApparmorResponse) Reset() {
 	*x = ApparmorResponse{}
-	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[4]
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +286,7 @@ func (x *ApparmorResponse) String() string {
 func (*ApparmorResponse) ProtoMessage() {}
 
 func (x *ApparmorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[4]
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +299,7 @@ func (x *ApparmorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApparmorResponse.ProtoReflect.Descriptor instead.
 func (*ApparmorResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{4}
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ApparmorResponse) GetFiles() *ApparmorResponse_Files {
@@ -263,6 +323,162 @@ func (x *ApparmorResponse) GetCapabilities() []string {
 	return nil
 }
 
+type SelinuxResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Avc           []*SelinuxResponse_SelinuxAvc `protobuf:"bytes,1,rep,name=avc,proto3" json:"avc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelinuxResponse) Reset() {
+	*x = SelinuxResponse{}
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelinuxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelinuxResponse) ProtoMessage() {}
+
+func (x *SelinuxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelinuxResponse.ProtoReflect.Descriptor instead.
+func (*SelinuxResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SelinuxResponse) GetAvc() []*SelinuxResponse_SelinuxAvc {
+	if x != nil {
+		return x.Avc
+	}
+	return nil
+}
+
+type SyscallArgs_Arg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Value         uint64                 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	ValueTwo      uint64                 `protobuf:"varint,3,opt,name=value_two,json=valueTwo,proto3" json:"value_two,omitempty"`
+	Op            string                 `protobuf:"bytes,4,opt,name=op,proto3" json:"op,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyscallArgs_Arg) Reset() {
+	*x = SyscallArgs_Arg{}
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyscallArgs_Arg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyscallArgs_Arg) ProtoMessage() {}
+
+func (x *SyscallArgs_Arg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyscallArgs_Arg.ProtoReflect.Descriptor instead.
+func (*SyscallArgs_Arg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *SyscallArgs_Arg) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SyscallArgs_Arg) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SyscallArgs_Arg) GetValueTwo() uint64 {
+	if x != nil {
+		return x.ValueTwo
+	}
+	return 0
+}
+
+func (x *SyscallArgs_Arg) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+type SyscallArgs_Combination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Args          []*SyscallArgs_Arg     `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyscallArgs_Combination) Reset() {
+	*x = SyscallArgs_Combination{}
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyscallArgs_Combination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyscallArgs_Combination) ProtoMessage() {}
+
+func (x *SyscallArgs_Combination) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyscallArgs_Combination.ProtoReflect.Descriptor instead.
+func (*SyscallArgs_Combination) Descriptor() ([]byte, []int) {
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *SyscallArgs_Combination) GetArgs() []*SyscallArgs_Arg {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
 type ApparmorResponse_Files struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	AllowedExecutables []string               `protobuf:"bytes,1,rep,name=allowed_executables,json=allowedExecutables,proto3" json:"allowed_executables,omitempty"`
@@ -276,7 +492,7 @@ type ApparmorResponse_Files struct {
 
 func (x *ApparmorResponse_Files) Reset() {
 	*x = ApparmorResponse_Files{}
-	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[5]
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +504,7 @@ func (x *ApparmorResponse_Files) String() string {
 func (*ApparmorResponse_Files) ProtoMessage() {}
 
 func (x *ApparmorResponse_Files) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[5]
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +517,7 @@ func (x *ApparmorResponse_Files) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApparmorResponse_Files.ProtoReflect.Descriptor instead.
 func (*ApparmorResponse_Files) Descriptor() ([]byte, []int) {
-	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{4, 0}
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *ApparmorResponse_Files) GetAllowedExecutables() []string {
@@ -340,17 +556,18 @@ func (x *ApparmorResponse_Files) GetReadwritePaths() []string {
 }
 
 type ApparmorResponse_Socket struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UseRaw        bool                   `protobuf:"varint,1,opt,name=use_raw,json=useRaw,proto3" json:"use_raw,omitempty"`
-	UseTcp        bool                   `protobuf:"varint,2,opt,name=use_tcp,json=useTcp,proto3" json:"use_tcp,omitempty"`
-	UseUdp        bool                   `protobuf:"varint,3,opt,name=use_udp,json=useUdp,proto3" json:"use_udp,omitempty"`
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	UseRaw        bool                                   `protobuf:"varint,1,opt,name=use_raw,json=useRaw,proto3" json:"use_raw,omitempty"`
+	UseTcp        bool                                   `protobuf:"varint,2,opt,name=use_tcp,json=useTcp,proto3" json:"use_tcp,omitempty"`
+	UseUdp        bool                                   `protobuf:"varint,3,opt,name=use_udp,json=useUdp,proto3" json:"use_udp,omitempty"`
+	Rules         []*ApparmorResponse_Socket_NetworkRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApparmorResponse_Socket) Reset() {
 	*x = ApparmorResponse_Socket{}
-	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[6]
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +579,7 @@ func (x *ApparmorResponse_Socket) String() string {
 func (*ApparmorResponse_Socket) ProtoMessage() {}
 
 func (x *ApparmorResponse_Socket) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[6]
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +592,7 @@ func (x *ApparmorResponse_Socket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApparmorResponse_Socket.ProtoReflect.Descriptor instead.
 func (*ApparmorResponse_Socket) Descriptor() ([]byte, []int) {
-	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{4, 1}
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{5, 1}
 }
 
 func (x *ApparmorResponse_Socket) GetUseRaw() bool {
@@ -399,6 +616,133 @@ func (x *ApparmorResponse_Socket) GetUseUdp() bool {
 	return false
 }
 
+func (x *ApparmorResponse_Socket) GetRules() []*ApparmorResponse_Socket_NetworkRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ApparmorResponse_Socket_NetworkRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Family        string                 `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApparmorResponse_Socket_NetworkRule) Reset() {
+	*x = ApparmorResponse_Socket_NetworkRule{}
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApparmorResponse_Socket_NetworkRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApparmorResponse_Socket_NetworkRule) ProtoMessage() {}
+
+func (x *ApparmorResponse_Socket_NetworkRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApparmorResponse_Socket_NetworkRule.ProtoReflect.Descriptor instead.
+func (*ApparmorResponse_Socket_NetworkRule) Descriptor() ([]byte, []int) {
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{5, 1, 0}
+}
+
+func (x *ApparmorResponse_Socket_NetworkRule) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *ApparmorResponse_Socket_NetworkRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SelinuxResponse_SelinuxAvc struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Perm          string                 `protobuf:"bytes,1,opt,name=perm,proto3" json:"perm,omitempty"`
+	Scontext      string                 `protobuf:"bytes,2,opt,name=scontext,proto3" json:"scontext,omitempty"`
+	Tcontext      string                 `protobuf:"bytes,3,opt,name=tcontext,proto3" json:"tcontext,omitempty"`
+	Tclass        string                 `protobuf:"bytes,4,opt,name=tclass,proto3" json:"tclass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelinuxResponse_SelinuxAvc) Reset() {
+	*x = SelinuxResponse_SelinuxAvc{}
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelinuxResponse_SelinuxAvc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelinuxResponse_SelinuxAvc) ProtoMessage() {}
+
+func (x *SelinuxResponse_SelinuxAvc) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_bpfrecorder_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelinuxResponse_SelinuxAvc.ProtoReflect.Descriptor instead.
+func (*SelinuxResponse_SelinuxAvc) Descriptor() ([]byte, []int) {
+	return file_api_grpc_bpfrecorder_api_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SelinuxResponse_SelinuxAvc) GetPerm() string {
+	if x != nil {
+		return x.Perm
+	}
+	return ""
+}
+
+func (x *SelinuxResponse_SelinuxAvc) GetScontext() string {
+	if x != nil {
+		return x.Scontext
+	}
+	return ""
+}
+
+func (x *SelinuxResponse_SelinuxAvc) GetTcontext() string {
+	if x != nil {
+		return x.Tcontext
+	}
+	return ""
+}
+
+func (x *SelinuxResponse_SelinuxAvc) GetTclass() string {
+	if x != nil {
+		return x.Tclass
+	}
+	return ""
+}
+
 var File_api_grpc_bpfrecorder_api_proto protoreflect.FileDescriptor
 
 var file_api_grpc_bpfrecorder_api_proto_rawDesc = []byte{
@@ -409,64 +753,112 @@ var file_api_grpc_bpfrecorder_api_proto_rawDesc = []byte{
 	0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x22, 0x24, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x63,
-	0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f, 0x61,
-	0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x41, 0x72, 0x63,
-	0x68, 0x22, 0xed, 0x03, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xde, 0x01, 0x0a, 0x05,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x61,
-	0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x61,
-	0x74, 0x68, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
-	0x61, 0x64, 0x77, 0x72, 0x69, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x53, 0x0a, 0x06,
-	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x61,
-	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x61, 0x77, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x63, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f,
-	0x75, 0x64, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x55, 0x64,
-	0x70, 0x32, 0xd8, 0x02, 0x0a, 0x0b, 0x42, 0x70, 0x66, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x48, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x41, 0x72,
+	0x63, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62,
+	0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61,
+	0x6c, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0b, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x41,
+	0x72, 0x67, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5e, 0x0a, 0x03, 0x41, 0x72, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x77, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6f, 0x70, 0x1a, 0x43, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x41, 0x72, 0x67, 0x73,
+	0x2e, 0x41, 0x72, 0x67, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0xf5, 0x04, 0x0a, 0x10, 0x41,
+	0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x40,
+	0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x1a, 0xde, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x6f, 0x6e, 0x6c, 0x79, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x61, 0x64, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0xda, 0x01, 0x0a, 0x06, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x61, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x5f, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54,
+	0x63, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x75, 0x64, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x55, 0x64, 0x70, 0x12, 0x4a, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x03, 0x61, 0x76, 0x63, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x76, 0x63,
+	0x52, 0x03, 0x61, 0x76, 0x63, 0x1a, 0x70, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x41, 0x76, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x32, 0xb2, 0x03, 0x0a, 0x0b, 0x42, 0x70, 0x66, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f,
 	0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x04, 0x53,
-	0x74, 0x6f, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73,
-	0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x79,
-	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5a, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70,
-	0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62,
+	0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x12, 0x53, 0x79,
+	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x46, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70,
+	0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x62,
+	0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10,
 	0x2f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x70, 0x66, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x72,
 	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
@@ -483,32 +875,45 @@ func file_api_grpc_bpfrecorder_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_bpfrecorder_api_proto_rawDescData
 }
 
-var file_api_grpc_bpfrecorder_api_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_grpc_bpfrecorder_api_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_grpc_bpfrecorder_api_proto_goTypes = []any{
-	(*EmptyRequest)(nil),            // 0: api_bpfrecorder.EmptyRequest
-	(*EmptyResponse)(nil),           // 1: api_bpfrecorder.EmptyResponse
-	(*ProfileRequest)(nil),          // 2: api_bpfrecorder.ProfileRequest
-	(*SyscallsResponse)(nil),        // 3: api_bpfrecorder.SyscallsResponse
-	(*ApparmorResponse)(nil),        // 4: api_bpfrecorder.ApparmorResponse
-	(*ApparmorResponse_Files)(nil),  // 5: api_bpfrecorder.ApparmorResponse.Files
-	(*ApparmorResponse_Socket)(nil), // 6: api_bpfrecorder.ApparmorResponse.Socket
+	(*EmptyRequest)(nil),                        // 0: api_bpfrecorder.EmptyRequest
+	(*EmptyResponse)(nil),                       // 1: api_bpfrecorder.EmptyResponse
+	(*ProfileRequest)(nil),                      // 2: api_bpfrecorder.ProfileRequest
+	(*SyscallsResponse)(nil),                    // 3: api_bpfrecorder.SyscallsResponse
+	(*SyscallArgs)(nil),                         // 4: api_bpfrecorder.SyscallArgs
+	(*ApparmorResponse)(nil),                    // 5: api_bpfrecorder.ApparmorResponse
+	(*SelinuxResponse)(nil),                     // 6: api_bpfrecorder.SelinuxResponse
+	(*SyscallArgs_Arg)(nil),                     // 7: api_bpfrecorder.SyscallArgs.Arg
+	(*SyscallArgs_Combination)(nil),             // 8: api_bpfrecorder.SyscallArgs.Combination
+	(*ApparmorResponse_Files)(nil),              // 9: api_bpfrecorder.ApparmorResponse.Files
+	(*ApparmorResponse_Socket)(nil),             // 10: api_bpfrecorder.ApparmorResponse.Socket
+	(*ApparmorResponse_Socket_NetworkRule)(nil), // 11: api_bpfrecorder.ApparmorResponse.Socket.NetworkRule
+	(*SelinuxResponse_SelinuxAvc)(nil),          // 12: api_bpfrecorder.SelinuxResponse.SelinuxAvc
 }
 var file_api_grpc_bpfrecorder_api_proto_depIdxs = []int32{
-	5, // 0: api_bpfrecorder.ApparmorResponse.files:type_name -> api_bpfrecorder.ApparmorResponse.Files
-	6, // 1: api_bpfrecorder.ApparmorResponse.socket:type_name -> api_bpfrecorder.ApparmorResponse.Socket
-	0, // 2: api_bpfrecorder.BpfRecorder.Start:input_type -> api_bpfrecorder.EmptyRequest
-	0, // 3: api_bpfrecorder.BpfRecorder.Stop:input_type -> api_bpfrecorder.EmptyRequest
-	2, // 4: api_bpfrecorder.BpfRecorder.SyscallsForProfile:input_type -> api_bpfrecorder.ProfileRequest
-	2, // 5: api_bpfrecorder.BpfRecorder.ApparmorForProfile:input_type -> api_bpfrecorder.ProfileRequest
-	1, // 6: api_bpfrecorder.BpfRecorder.Start:output_type -> api_bpfrecorder.EmptyResponse
-	1, // 7: api_bpfrecorder.BpfRecorder.Stop:output_type -> api_bpfrecorder.EmptyResponse
-	3, // 8: api_bpfrecorder.BpfRecorder.SyscallsForProfile:output_type -> api_bpfrecorder.SyscallsResponse
-	4, // 9: api_bpfrecorder.BpfRecorder.ApparmorForProfile:output_type -> api_bpfrecorder.ApparmorResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4,  // 0: api_bpfrecorder.SyscallsResponse.syscall_args:type_name -> api_bpfrecorder.SyscallArgs
+	8,  // 1: api_bpfrecorder.SyscallArgs.combinations:type_name -> api_bpfrecorder.SyscallArgs.Combination
+	9,  // 2: api_bpfrecorder.ApparmorResponse.files:type_name -> api_bpfrecorder.ApparmorResponse.Files
+	10, // 3: api_bpfrecorder.ApparmorResponse.socket:type_name -> api_bpfrecorder.ApparmorResponse.Socket
+	12, // 4: api_bpfrecorder.SelinuxResponse.avc:type_name -> api_bpfrecorder.SelinuxResponse.SelinuxAvc
+	7,  // 5: api_bpfrecorder.SyscallArgs.Combination.args:type_name -> api_bpfrecorder.SyscallArgs.Arg
+	11, // 6: api_bpfrecorder.ApparmorResponse.Socket.rules:type_name -> api_bpfrecorder.ApparmorResponse.Socket.NetworkRule
+	0,  // 7: api_bpfrecorder.BpfRecorder.Start:input_type -> api_bpfrecorder.EmptyRequest
+	0,  // 8: api_bpfrecorder.BpfRecorder.Stop:input_type -> api_bpfrecorder.EmptyRequest
+	2,  // 9: api_bpfrecorder.BpfRecorder.SyscallsForProfile:input_type -> api_bpfrecorder.ProfileRequest
+	2,  // 10: api_bpfrecorder.BpfRecorder.ApparmorForProfile:input_type -> api_bpfrecorder.ProfileRequest
+	2,  // 11: api_bpfrecorder.BpfRecorder.SelinuxForProfile:input_type -> api_bpfrecorder.ProfileRequest
+	1,  // 12: api_bpfrecorder.BpfRecorder.Start:output_type -> api_bpfrecorder.EmptyResponse
+	1,  // 13: api_bpfrecorder.BpfRecorder.Stop:output_type -> api_bpfrecorder.EmptyResponse
+	3,  // 14: api_bpfrecorder.BpfRecorder.SyscallsForProfile:output_type -> api_bpfrecorder.SyscallsResponse
+	5,  // 15: api_bpfrecorder.BpfRecorder.ApparmorForProfile:output_type -> api_bpfrecorder.ApparmorResponse
+	6,  // 16: api_bpfrecorder.BpfRecorder.SelinuxForProfile:output_type -> api_bpfrecorder.SelinuxResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_bpfrecorder_api_proto_init() }
@@ -522,7 +927,7 @@ func file_api_grpc_bpfrecorder_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_bpfrecorder_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,7 @@ const (
 	BpfRecorder_Stop_FullMethodName               = "/api_bpfrecorder.BpfRecorder/Stop"
 	BpfRecorder_SyscallsForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/SyscallsForProfile"
 	BpfRecorder_ApparmorForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/ApparmorForProfile"
+	BpfRecorder_SelinuxForProfile_FullMethodName  = "/api_bpfrecorder.BpfRecorder/SelinuxForProfile"
 )
 
 // BpfRecorderClient is the client API for BpfRecorder service.
@@ -48,6 +49,7 @@ type BpfRecorderClient interface {
 	Stop(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	SyscallsForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
 	ApparmorForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ApparmorResponse, error)
+	SelinuxForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SelinuxResponse, error)
 }
 
 type bpfRecorderClient struct {
@@ -98,6 +100,16 @@ func (c *bpfRecorderClient) ApparmorForProfile(ctx context.Context, in *ProfileR
 	return out, nil
 }
 
+func (c *bpfRecorderClient) SelinuxForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SelinuxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelinuxResponse)
+	err := c.cc.Invoke(ctx, BpfRecorder_SelinuxForProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BpfRecorderServer is the server API for BpfRecorder service.
 // All implementations must embed UnimplementedBpfRecorderServer
 // for forward compatibility.
@@ -106,6 +118,7 @@ type BpfRecorderServer interface {
 	Stop(context.Context, *EmptyRequest) (*EmptyResponse, error)
 	SyscallsForProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error)
 	ApparmorForProfile(context.Context, *ProfileRequest) (*ApparmorResponse, error)
+	SelinuxForProfile(context.Context, *ProfileRequest) (*SelinuxResponse, error)
 	mustEmbedUnimplementedBpfRecorderServer()
 }
 
@@ -128,6 +141,9 @@ func (UnimplementedBpfRecorderServer) SyscallsForProfile(context.Context, *Profi
 func (UnimplementedBpfRecorderServer) ApparmorForProfile(context.Context, *ProfileRequest) (*ApparmorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApparmorForProfile not implemented")
 }
+func (UnimplementedBpfRecorderServer) SelinuxForProfile(context.Context, *ProfileRequest) (*SelinuxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelinuxForProfile not implemented")
+}
 func (UnimplementedBpfRecorderServer) mustEmbedUnimplementedBpfRecorderServer() {}
 func (UnimplementedBpfRecorderServer) testEmbeddedByValue()                     {}
 
@@ -221,6 +237,24 @@ func _BpfRecorder_ApparmorForProfile_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BpfRecorder_SelinuxForProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpfRecorderServer).SelinuxForProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BpfRecorder_SelinuxForProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpfRecorderServer).SelinuxForProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BpfRecorder_ServiceDesc is the grpc.ServiceDesc for BpfRecorder service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -244,6 +278,10 @@ var BpfRecorder_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApparmorForProfile",
 			Handler:    _BpfRecorder_ApparmorForProfile_Handler,
 		},
+		{
+			MethodName: "SelinuxForProfile",
+			Handler:    _BpfRecorder_SelinuxForProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/bpfrecorder/api.proto",
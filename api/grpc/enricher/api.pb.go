@@ -219,6 +219,94 @@ func (x *AvcResponse) GetAvc() []*AvcResponse_SelinuxAvc {
 	return nil
 }
 
+type ApparmorPathsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       string                 `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApparmorPathsRequest) Reset() {
+	*x = ApparmorPathsRequest{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApparmorPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApparmorPathsRequest) ProtoMessage() {}
+
+func (x *ApparmorPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApparmorPathsRequest.ProtoReflect.Descriptor instead.
+func (*ApparmorPathsRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApparmorPathsRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type ApparmorPathsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []string               `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApparmorPathsResponse) Reset() {
+	*x = ApparmorPathsResponse{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApparmorPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApparmorPathsResponse) ProtoMessage() {}
+
+func (x *ApparmorPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApparmorPathsResponse.ProtoReflect.Descriptor instead.
+func (*ApparmorPathsResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApparmorPathsResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -227,7 +315,7 @@ type EmptyResponse struct {
 
 func (x *EmptyResponse) Reset() {
 	*x = EmptyResponse{}
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +327,7 @@ func (x *EmptyResponse) String() string {
 func (*EmptyResponse) ProtoMessage() {}
 
 func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +340,199 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4}
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{6}
+}
+
+type WatchEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	AuditTypes    []string               `protobuf:"bytes,3,rep,name=audit_types,json=auditTypes,proto3" json:"audit_types,omitempty"`
+	Actions       []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetAuditTypes() []string {
+	if x != nil {
+		return x.AuditTypes
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pod           string                 `protobuf:"bytes,4,opt,name=pod,proto3" json:"pod,omitempty"`
+	Container     string                 `protobuf:"bytes,5,opt,name=container,proto3" json:"container,omitempty"`
+	AuditType     string                 `protobuf:"bytes,6,opt,name=audit_type,json=auditType,proto3" json:"audit_type,omitempty"`
+	Action        string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	Process       *AuditEvent_Process    `protobuf:"bytes,8,opt,name=process,proto3" json:"process,omitempty"`
+	Seccomp       *AuditEvent_Seccomp    `protobuf:"bytes,9,opt,name=seccomp,proto3" json:"seccomp,omitempty"`
+	Selinux       *AuditEvent_Selinux    `protobuf:"bytes,10,opt,name=selinux,proto3" json:"selinux,omitempty"`
+	Apparmor      *AuditEvent_Apparmor   `protobuf:"bytes,11,opt,name=apparmor,proto3" json:"apparmor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuditEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAuditType() string {
+	if x != nil {
+		return x.AuditType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetProcess() *AuditEvent_Process {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetSeccomp() *AuditEvent_Seccomp {
+	if x != nil {
+		return x.Seccomp
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetSelinux() *AuditEvent_Selinux {
+	if x != nil {
+		return x.Selinux
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetApparmor() *AuditEvent_Apparmor {
+	if x != nil {
+		return x.Apparmor
+	}
+	return nil
 }
 
 type AvcResponse_SelinuxAvc struct {
@@ -267,7 +547,7 @@ type AvcResponse_SelinuxAvc struct {
 
 func (x *AvcResponse_SelinuxAvc) Reset() {
 	*x = AvcResponse_SelinuxAvc{}
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +559,7 @@ func (x *AvcResponse_SelinuxAvc) String() string {
 func (*AvcResponse_SelinuxAvc) ProtoMessage() {}
 
 func (x *AvcResponse_SelinuxAvc) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,6 +603,262 @@ func (x *AvcResponse_SelinuxAvc) GetTclass() string {
 	return ""
 }
 
+type AuditEvent_Process struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pid           int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Executable    string                 `protobuf:"bytes,2,opt,name=executable,proto3" json:"executable,omitempty"`
+	CmdLine       string                 `protobuf:"bytes,3,opt,name=cmd_line,json=cmdLine,proto3" json:"cmd_line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent_Process) Reset() {
+	*x = AuditEvent_Process{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent_Process) ProtoMessage() {}
+
+func (x *AuditEvent_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent_Process.ProtoReflect.Descriptor instead.
+func (*AuditEvent_Process) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *AuditEvent_Process) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *AuditEvent_Process) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
+func (x *AuditEvent_Process) GetCmdLine() string {
+	if x != nil {
+		return x.CmdLine
+	}
+	return ""
+}
+
+type AuditEvent_Seccomp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyscallId     int32                  `protobuf:"varint,1,opt,name=syscall_id,json=syscallId,proto3" json:"syscall_id,omitempty"`
+	SyscallName   string                 `protobuf:"bytes,2,opt,name=syscall_name,json=syscallName,proto3" json:"syscall_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent_Seccomp) Reset() {
+	*x = AuditEvent_Seccomp{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent_Seccomp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent_Seccomp) ProtoMessage() {}
+
+func (x *AuditEvent_Seccomp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent_Seccomp.ProtoReflect.Descriptor instead.
+func (*AuditEvent_Seccomp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *AuditEvent_Seccomp) GetSyscallId() int32 {
+	if x != nil {
+		return x.SyscallId
+	}
+	return 0
+}
+
+func (x *AuditEvent_Seccomp) GetSyscallName() string {
+	if x != nil {
+		return x.SyscallName
+	}
+	return ""
+}
+
+type AuditEvent_Selinux struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Perm          string                 `protobuf:"bytes,1,opt,name=perm,proto3" json:"perm,omitempty"`
+	Scontext      string                 `protobuf:"bytes,2,opt,name=scontext,proto3" json:"scontext,omitempty"`
+	Tcontext      string                 `protobuf:"bytes,3,opt,name=tcontext,proto3" json:"tcontext,omitempty"`
+	Tclass        string                 `protobuf:"bytes,4,opt,name=tclass,proto3" json:"tclass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent_Selinux) Reset() {
+	*x = AuditEvent_Selinux{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent_Selinux) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent_Selinux) ProtoMessage() {}
+
+func (x *AuditEvent_Selinux) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent_Selinux.ProtoReflect.Descriptor instead.
+func (*AuditEvent_Selinux) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *AuditEvent_Selinux) GetPerm() string {
+	if x != nil {
+		return x.Perm
+	}
+	return ""
+}
+
+func (x *AuditEvent_Selinux) GetScontext() string {
+	if x != nil {
+		return x.Scontext
+	}
+	return ""
+}
+
+func (x *AuditEvent_Selinux) GetTcontext() string {
+	if x != nil {
+		return x.Tcontext
+	}
+	return ""
+}
+
+func (x *AuditEvent_Selinux) GetTclass() string {
+	if x != nil {
+		return x.Tclass
+	}
+	return ""
+}
+
+type AuditEvent_Apparmor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apparmor      string                 `protobuf:"bytes,1,opt,name=apparmor,proto3" json:"apparmor,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Profile       string                 `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ExtraInfo     string                 `protobuf:"bytes,5,opt,name=extra_info,json=extraInfo,proto3" json:"extra_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent_Apparmor) Reset() {
+	*x = AuditEvent_Apparmor{}
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent_Apparmor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent_Apparmor) ProtoMessage() {}
+
+func (x *AuditEvent_Apparmor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent_Apparmor.ProtoReflect.Descriptor instead.
+func (*AuditEvent_Apparmor) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *AuditEvent_Apparmor) GetApparmor() string {
+	if x != nil {
+		return x.Apparmor
+	}
+	return ""
+}
+
+func (x *AuditEvent_Apparmor) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEvent_Apparmor) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *AuditEvent_Apparmor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditEvent_Apparmor) GetExtraInfo() string {
+	if x != nil {
+		return x.ExtraInfo
+	}
+	return ""
+}
+
 var File_api_grpc_enricher_api_proto protoreflect.FileDescriptor
 
 var file_api_grpc_enricher_api_proto_rawDesc = []byte{
@@ -350,28 +886,108 @@ var file_api_grpc_enricher_api_proto_rawDesc = []byte{
 	0x1a, 0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
 	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xab, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
-	0x72, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73,
-	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
-	0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63,
-	0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
-	0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x04, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69,
-	0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41,
-	0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
-	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63,
-	0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xde, 0x06, 0x0a,
+	0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x75, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x63, 0x6f, 0x6d,
+	0x70, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x52, 0x07, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x12, 0x3d, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x08, 0x61, 0x70, 0x70,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x1a, 0x56, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6d, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x1a, 0x4b, 0x0a,
+	0x07, 0x53, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x63,
+	0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x79,
+	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x63, 0x61,
+	0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x6d, 0x0a, 0x07, 0x53, 0x65,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x91, 0x01, 0x0a, 0x08, 0x41, 0x70,
+	0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0xd6, 0x03,
+	0x0a, 0x08, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x79,
+	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72,
+	0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e,
+	0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x04, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x76,
+	0x63, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x41,
+	0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72,
+	0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -386,30 +1002,46 @@ func file_api_grpc_enricher_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_enricher_api_proto_rawDescData
 }
 
-var file_api_grpc_enricher_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_grpc_enricher_api_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_grpc_enricher_api_proto_goTypes = []any{
 	(*SyscallsRequest)(nil),        // 0: api_enricher.SyscallsRequest
 	(*SyscallsResponse)(nil),       // 1: api_enricher.SyscallsResponse
 	(*AvcRequest)(nil),             // 2: api_enricher.AvcRequest
 	(*AvcResponse)(nil),            // 3: api_enricher.AvcResponse
-	(*EmptyResponse)(nil),          // 4: api_enricher.EmptyResponse
-	(*AvcResponse_SelinuxAvc)(nil), // 5: api_enricher.AvcResponse.SelinuxAvc
+	(*ApparmorPathsRequest)(nil),   // 4: api_enricher.ApparmorPathsRequest
+	(*ApparmorPathsResponse)(nil),  // 5: api_enricher.ApparmorPathsResponse
+	(*EmptyResponse)(nil),          // 6: api_enricher.EmptyResponse
+	(*WatchEventsRequest)(nil),     // 7: api_enricher.WatchEventsRequest
+	(*AuditEvent)(nil),             // 8: api_enricher.AuditEvent
+	(*AvcResponse_SelinuxAvc)(nil), // 9: api_enricher.AvcResponse.SelinuxAvc
+	(*AuditEvent_Process)(nil),     // 10: api_enricher.AuditEvent.Process
+	(*AuditEvent_Seccomp)(nil),     // 11: api_enricher.AuditEvent.Seccomp
+	(*AuditEvent_Selinux)(nil),     // 12: api_enricher.AuditEvent.Selinux
+	(*AuditEvent_Apparmor)(nil),    // 13: api_enricher.AuditEvent.Apparmor
 }
 var file_api_grpc_enricher_api_proto_depIdxs = []int32{
-	5, // 0: api_enricher.AvcResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
-	0, // 1: api_enricher.Enricher.Syscalls:input_type -> api_enricher.SyscallsRequest
-	0, // 2: api_enricher.Enricher.ResetSyscalls:input_type -> api_enricher.SyscallsRequest
-	2, // 3: api_enricher.Enricher.Avcs:input_type -> api_enricher.AvcRequest
-	2, // 4: api_enricher.Enricher.ResetAvcs:input_type -> api_enricher.AvcRequest
-	1, // 5: api_enricher.Enricher.Syscalls:output_type -> api_enricher.SyscallsResponse
-	4, // 6: api_enricher.Enricher.ResetSyscalls:output_type -> api_enricher.EmptyResponse
-	3, // 7: api_enricher.Enricher.Avcs:output_type -> api_enricher.AvcResponse
-	4, // 8: api_enricher.Enricher.ResetAvcs:output_type -> api_enricher.EmptyResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: api_enricher.AvcResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
+	10, // 1: api_enricher.AuditEvent.process:type_name -> api_enricher.AuditEvent.Process
+	11, // 2: api_enricher.AuditEvent.seccomp:type_name -> api_enricher.AuditEvent.Seccomp
+	12, // 3: api_enricher.AuditEvent.selinux:type_name -> api_enricher.AuditEvent.Selinux
+	13, // 4: api_enricher.AuditEvent.apparmor:type_name -> api_enricher.AuditEvent.Apparmor
+	0,  // 5: api_enricher.Enricher.Syscalls:input_type -> api_enricher.SyscallsRequest
+	0,  // 6: api_enricher.Enricher.ResetSyscalls:input_type -> api_enricher.SyscallsRequest
+	2,  // 7: api_enricher.Enricher.Avcs:input_type -> api_enricher.AvcRequest
+	2,  // 8: api_enricher.Enricher.ResetAvcs:input_type -> api_enricher.AvcRequest
+	4,  // 9: api_enricher.Enricher.ApparmorPaths:input_type -> api_enricher.ApparmorPathsRequest
+	7,  // 10: api_enricher.Enricher.WatchEvents:input_type -> api_enricher.WatchEventsRequest
+	1,  // 11: api_enricher.Enricher.Syscalls:output_type -> api_enricher.SyscallsResponse
+	6,  // 12: api_enricher.Enricher.ResetSyscalls:output_type -> api_enricher.EmptyResponse
+	3,  // 13: api_enricher.Enricher.Avcs:output_type -> api_enricher.AvcResponse
+	6,  // 14: api_enricher.Enricher.ResetAvcs:output_type -> api_enricher.EmptyResponse
+	5,  // 15: api_enricher.Enricher.ApparmorPaths:output_type -> api_enricher.ApparmorPathsResponse
+	8,  // 16: api_enricher.Enricher.WatchEvents:output_type -> api_enricher.AuditEvent
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_enricher_api_proto_init() }
@@ -423,7 +1055,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_enricher_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,8 @@ const (
 	Enricher_ResetSyscalls_FullMethodName = "/api_enricher.Enricher/ResetSyscalls"
 	Enricher_Avcs_FullMethodName          = "/api_enricher.Enricher/Avcs"
 	Enricher_ResetAvcs_FullMethodName     = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_ApparmorPaths_FullMethodName = "/api_enricher.Enricher/ApparmorPaths"
+	Enricher_WatchEvents_FullMethodName   = "/api_enricher.Enricher/WatchEvents"
 )
 
 // EnricherClient is the client API for Enricher service.
@@ -48,6 +50,8 @@ type EnricherClient interface {
 	ResetSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Avcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error)
 	ResetAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ApparmorPaths(ctx context.Context, in *ApparmorPathsRequest, opts ...grpc.CallOption) (*ApparmorPathsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditEvent], error)
 }
 
 type enricherClient struct {
@@ -98,6 +102,35 @@ func (c *enricherClient) ResetAvcs(ctx context.Context, in *AvcRequest, opts ...
 	return out, nil
 }
 
+func (c *enricherClient) ApparmorPaths(ctx context.Context, in *ApparmorPathsRequest, opts ...grpc.CallOption) (*ApparmorPathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApparmorPathsResponse)
+	err := c.cc.Invoke(ctx, Enricher_ApparmorPaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Enricher_ServiceDesc.Streams[0], Enricher_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, AuditEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Enricher_WatchEventsClient = grpc.ServerStreamingClient[AuditEvent]
+
 // EnricherServer is the server API for Enricher service.
 // All implementations must embed UnimplementedEnricherServer
 // for forward compatibility.
@@ -106,6 +139,8 @@ type EnricherServer interface {
 	ResetSyscalls(context.Context, *SyscallsRequest) (*EmptyResponse, error)
 	Avcs(context.Context, *AvcRequest) (*AvcResponse, error)
 	ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error)
+	ApparmorPaths(context.Context, *ApparmorPathsRequest) (*ApparmorPathsResponse, error)
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[AuditEvent]) error
 	mustEmbedUnimplementedEnricherServer()
 }
 
@@ -128,6 +163,12 @@ func (UnimplementedEnricherServer) Avcs(context.Context, *AvcRequest) (*AvcRespo
 func (UnimplementedEnricherServer) ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetAvcs not implemented")
 }
+func (UnimplementedEnricherServer) ApparmorPaths(context.Context, *ApparmorPathsRequest) (*ApparmorPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApparmorPaths not implemented")
+}
+func (UnimplementedEnricherServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[AuditEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedEnricherServer) mustEmbedUnimplementedEnricherServer() {}
 func (UnimplementedEnricherServer) testEmbeddedByValue()                  {}
 
@@ -221,6 +262,35 @@ func _Enricher_ResetAvcs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Enricher_ApparmorPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApparmorPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).ApparmorPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_ApparmorPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).ApparmorPaths(ctx, req.(*ApparmorPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnricherServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, AuditEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Enricher_WatchEventsServer = grpc.ServerStreamingServer[AuditEvent]
+
 // Enricher_ServiceDesc is the grpc.ServiceDesc for Enricher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -244,7 +314,17 @@ var Enricher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetAvcs",
 			Handler:    _Enricher_ResetAvcs_Handler,
 		},
+		{
+			MethodName: "ApparmorPaths",
+			Handler:    _Enricher_ApparmorPaths_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Enricher_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/enricher/api.proto",
 }
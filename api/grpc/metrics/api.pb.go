@@ -195,6 +195,74 @@ func (x *BpfRequest) GetProfile() string {
 	return ""
 }
 
+type SeccompNotifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Profile       string                 `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Syscall       string                 `protobuf:"bytes,3,opt,name=syscall,proto3" json:"syscall,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeccompNotifyRequest) Reset() {
+	*x = SeccompNotifyRequest{}
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeccompNotifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeccompNotifyRequest) ProtoMessage() {}
+
+func (x *SeccompNotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeccompNotifyRequest.ProtoReflect.Descriptor instead.
+func (*SeccompNotifyRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SeccompNotifyRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SeccompNotifyRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *SeccompNotifyRequest) GetSyscall() string {
+	if x != nil {
+		return x.Syscall
+	}
+	return ""
+}
+
+func (x *SeccompNotifyRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -203,7 +271,7 @@ type EmptyResponse struct {
 
 func (x *EmptyResponse) Reset() {
 	*x = EmptyResponse{}
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +283,7 @@ func (x *EmptyResponse) String() string {
 func (*EmptyResponse) ProtoMessage() {}
 
 func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +296,7 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{2}
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{3}
 }
 
 type AuditRequest_SeccompAuditReq struct {
@@ -240,7 +308,7 @@ type AuditRequest_SeccompAuditReq struct {
 
 func (x *AuditRequest_SeccompAuditReq) Reset() {
 	*x = AuditRequest_SeccompAuditReq{}
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -252,7 +320,7 @@ func (x *AuditRequest_SeccompAuditReq) String() string {
 func (*AuditRequest_SeccompAuditReq) ProtoMessage() {}
 
 func (x *AuditRequest_SeccompAuditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +353,7 @@ type AuditRequest_SelinuxAuditReq struct {
 
 func (x *AuditRequest_SelinuxAuditReq) Reset() {
 	*x = AuditRequest_SelinuxAuditReq{}
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +365,7 @@ func (x *AuditRequest_SelinuxAuditReq) String() string {
 func (*AuditRequest_SelinuxAuditReq) ProtoMessage() {}
 
 func (x *AuditRequest_SelinuxAuditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +407,7 @@ type AuditRequest_ApparmorAuditReq struct {
 
 func (x *AuditRequest_ApparmorAuditReq) Reset() {
 	*x = AuditRequest_ApparmorAuditReq{}
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +419,7 @@ func (x *AuditRequest_ApparmorAuditReq) String() string {
 func (*AuditRequest_ApparmorAuditReq) ProtoMessage() {}
 
 func (x *AuditRequest_ApparmorAuditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,19 +513,32 @@ var file_api_grpc_metrics_api_proto_rawDesc = []byte{
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
 	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93, 0x01, 0x0a, 0x07, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x49,
-	0x6e, 0x63, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x41, 0x0a,
-	0x06, 0x42, 0x70, 0x66, 0x49, 0x6e, 0x63, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
-	0x42, 0x0e, 0x5a, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x76, 0x0a, 0x14, 0x53, 0x65, 0x63,
+	0x63, 0x6f, 0x6d, 0x70, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xea, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45,
+	0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x42, 0x70, 0x66, 0x49, 0x6e, 0x63, 0x12,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x55, 0x0a, 0x10, 0x53, 0x65, 0x63, 0x63,
+	0x6f, 0x6d, 0x70, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x63, 0x12, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x63, 0x6f,
+	0x6d, 0x70, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42,
+	0x0e, 0x5a, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -472,25 +553,28 @@ func file_api_grpc_metrics_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_metrics_api_proto_rawDescData
 }
 
-var file_api_grpc_metrics_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_grpc_metrics_api_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_grpc_metrics_api_proto_goTypes = []any{
 	(*AuditRequest)(nil),                  // 0: api_metrics.AuditRequest
 	(*BpfRequest)(nil),                    // 1: api_metrics.BpfRequest
-	(*EmptyResponse)(nil),                 // 2: api_metrics.EmptyResponse
-	(*AuditRequest_SeccompAuditReq)(nil),  // 3: api_metrics.AuditRequest.SeccompAuditReq
-	(*AuditRequest_SelinuxAuditReq)(nil),  // 4: api_metrics.AuditRequest.SelinuxAuditReq
-	(*AuditRequest_ApparmorAuditReq)(nil), // 5: api_metrics.AuditRequest.ApparmorAuditReq
+	(*SeccompNotifyRequest)(nil),          // 2: api_metrics.SeccompNotifyRequest
+	(*EmptyResponse)(nil),                 // 3: api_metrics.EmptyResponse
+	(*AuditRequest_SeccompAuditReq)(nil),  // 4: api_metrics.AuditRequest.SeccompAuditReq
+	(*AuditRequest_SelinuxAuditReq)(nil),  // 5: api_metrics.AuditRequest.SelinuxAuditReq
+	(*AuditRequest_ApparmorAuditReq)(nil), // 6: api_metrics.AuditRequest.ApparmorAuditReq
 }
 var file_api_grpc_metrics_api_proto_depIdxs = []int32{
-	3, // 0: api_metrics.AuditRequest.seccompReq:type_name -> api_metrics.AuditRequest.SeccompAuditReq
-	4, // 1: api_metrics.AuditRequest.selinuxReq:type_name -> api_metrics.AuditRequest.SelinuxAuditReq
-	5, // 2: api_metrics.AuditRequest.apparmorReq:type_name -> api_metrics.AuditRequest.ApparmorAuditReq
+	4, // 0: api_metrics.AuditRequest.seccompReq:type_name -> api_metrics.AuditRequest.SeccompAuditReq
+	5, // 1: api_metrics.AuditRequest.selinuxReq:type_name -> api_metrics.AuditRequest.SelinuxAuditReq
+	6, // 2: api_metrics.AuditRequest.apparmorReq:type_name -> api_metrics.AuditRequest.ApparmorAuditReq
 	0, // 3: api_metrics.Metrics.AuditInc:input_type -> api_metrics.AuditRequest
 	1, // 4: api_metrics.Metrics.BpfInc:input_type -> api_metrics.BpfRequest
-	2, // 5: api_metrics.Metrics.AuditInc:output_type -> api_metrics.EmptyResponse
-	2, // 6: api_metrics.Metrics.BpfInc:output_type -> api_metrics.EmptyResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
+	2, // 5: api_metrics.Metrics.SeccompNotifyInc:input_type -> api_metrics.SeccompNotifyRequest
+	3, // 6: api_metrics.Metrics.AuditInc:output_type -> api_metrics.EmptyResponse
+	3, // 7: api_metrics.Metrics.BpfInc:output_type -> api_metrics.EmptyResponse
+	3, // 8: api_metrics.Metrics.SeccompNotifyInc:output_type -> api_metrics.EmptyResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -507,7 +591,7 @@ func file_api_grpc_metrics_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_metrics_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
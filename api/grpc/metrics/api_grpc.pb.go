@@ -34,8 +34,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Metrics_AuditInc_FullMethodName = "/api_metrics.Metrics/AuditInc"
-	Metrics_BpfInc_FullMethodName   = "/api_metrics.Metrics/BpfInc"
+	Metrics_AuditInc_FullMethodName         = "/api_metrics.Metrics/AuditInc"
+	Metrics_BpfInc_FullMethodName           = "/api_metrics.Metrics/BpfInc"
+	Metrics_SeccompNotifyInc_FullMethodName = "/api_metrics.Metrics/SeccompNotifyInc"
 )
 
 // MetricsClient is the client API for Metrics service.
@@ -44,6 +45,7 @@ const (
 type MetricsClient interface {
 	AuditInc(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AuditRequest, EmptyResponse], error)
 	BpfInc(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BpfRequest, EmptyResponse], error)
+	SeccompNotifyInc(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SeccompNotifyRequest, EmptyResponse], error)
 }
 
 type metricsClient struct {
@@ -80,12 +82,26 @@ func (c *metricsClient) BpfInc(ctx context.Context, opts ...grpc.CallOption) (gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Metrics_BpfIncClient = grpc.ClientStreamingClient[BpfRequest, EmptyResponse]
 
+func (c *metricsClient) SeccompNotifyInc(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SeccompNotifyRequest, EmptyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[2], Metrics_SeccompNotifyInc_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SeccompNotifyRequest, EmptyResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Metrics_SeccompNotifyIncClient = grpc.ClientStreamingClient[SeccompNotifyRequest, EmptyResponse]
+
 // MetricsServer is the server API for Metrics service.
 // All implementations must embed UnimplementedMetricsServer
 // for forward compatibility.
 type MetricsServer interface {
 	AuditInc(grpc.ClientStreamingServer[AuditRequest, EmptyResponse]) error
 	BpfInc(grpc.ClientStreamingServer[BpfRequest, EmptyResponse]) error
+	SeccompNotifyInc(grpc.ClientStreamingServer[SeccompNotifyRequest, EmptyResponse]) error
 	mustEmbedUnimplementedMetricsServer()
 }
 
@@ -102,6 +118,9 @@ func (UnimplementedMetricsServer) AuditInc(grpc.ClientStreamingServer[AuditReque
 func (UnimplementedMetricsServer) BpfInc(grpc.ClientStreamingServer[BpfRequest, EmptyResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method BpfInc not implemented")
 }
+func (UnimplementedMetricsServer) SeccompNotifyInc(grpc.ClientStreamingServer[SeccompNotifyRequest, EmptyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SeccompNotifyInc not implemented")
+}
 func (UnimplementedMetricsServer) mustEmbedUnimplementedMetricsServer() {}
 func (UnimplementedMetricsServer) testEmbeddedByValue()                 {}
 
@@ -137,6 +156,13 @@ func _Metrics_BpfInc_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Metrics_BpfIncServer = grpc.ClientStreamingServer[BpfRequest, EmptyResponse]
 
+func _Metrics_SeccompNotifyInc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetricsServer).SeccompNotifyInc(&grpc.GenericServerStream[SeccompNotifyRequest, EmptyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Metrics_SeccompNotifyIncServer = grpc.ClientStreamingServer[SeccompNotifyRequest, EmptyResponse]
+
 // Metrics_ServiceDesc is the grpc.ServiceDesc for Metrics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -155,6 +181,11 @@ var Metrics_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Metrics_BpfInc_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SeccompNotifyInc",
+			Handler:       _Metrics_SeccompNotifyInc_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api/grpc/metrics/api.proto",
 }
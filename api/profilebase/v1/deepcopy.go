@@ -20,6 +20,12 @@ package v1
 func (in *StatusBase) DeepCopyInto(out *StatusBase) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(ProfileDrift)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusBase.
@@ -33,3 +39,34 @@ func (in *StatusBase) DeepCopy() *StatusBase {
 
 	return out
 }
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileDrift) DeepCopyInto(out *ProfileDrift) {
+	*out = *in
+
+	if in.Denials != nil {
+		in, out := &in.Denials, &out.Denials
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+
+	in.LastObservedTime.DeepCopyInto(&out.LastObservedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileDrift.
+func (in *ProfileDrift) DeepCopy() *ProfileDrift {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ProfileDrift)
+	in.DeepCopyInto(out)
+
+	return out
+}
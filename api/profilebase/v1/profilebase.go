@@ -32,6 +32,11 @@ import (
 
 const ProfilePartialLabel = "spo.x-k8s.io/partial"
 
+// AuditProfileSuffix is appended to the name of the non-enforcing profile
+// variants used by profile bindings in Audit mode. Profile names are not
+// allowed to contain underscores, which avoids collisions with other profiles.
+const AuditProfileSuffix = "_audit"
+
 type SecurityProfileBase interface {
 	client.Object
 
@@ -98,6 +103,34 @@ type StatusBase struct {
 	// status is the current state of the profile across nodes.
 	// +optional
 	Status secprofnodestatusv1.ProfileState `json:"status,omitempty"`
+	// drift contains the behavior of workloads running with the profile which
+	// would have been denied by it. Only populated if drift detection is
+	// enabled.
+	// +optional
+	Drift *ProfileDrift `json:"drift,omitempty"`
+}
+
+// ProfileDrift contains the observed behavior of workloads which is not
+// allowed by the profile.
+type ProfileDrift struct {
+	// denials are the observed operations not allowed by the profile, for
+	// example syscall names for seccomp, "<label> <class> <permission>"
+	// tuples for SELinux or file system paths for AppArmor.
+	// +optional
+	// +listType=set
+	Denials []string `json:"denials,omitempty"`
+	// nodes are the names of the nodes on which the denials got observed.
+	// +optional
+	// +listType=set
+	Nodes []string `json:"nodes,omitempty"`
+	// observedGeneration is the generation of the profile the denials refer
+	// to. Denials of previous generations get discarded.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// lastObservedTime is the time when new denials got observed the last
+	// time.
+	// +optional
+	LastObservedTime metav1.Time `json:"lastObservedTime,omitempty"`
 }
 
 type StatusBaseUser interface {
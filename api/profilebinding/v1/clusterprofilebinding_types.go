@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterProfileBindingSpec defines the desired state of ClusterProfileBinding.
+type ClusterProfileBindingSpec struct {
+	ProfileBindingSpec `json:",inline"`
+	// namespaceSelector selects the namespaces in which the profile gets bound
+	// to the pods. An empty or missing selector selects all namespaces. Only
+	// namespaces labeled with spo.x-k8s.io/enable-binding are considered.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProfileBinding is the Schema for the clusterprofilebindings API.
+// A ProfileBinding within the namespace of a pod takes precedence over a
+// ClusterProfileBinding matching the same container and profile kind.
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cpb,scope=Cluster
+type ClusterProfileBinding struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata contains the object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of the ClusterProfileBinding.
+	// +required
+	Spec ClusterProfileBindingSpec `json:"spec,omitzero"`
+	// status contains the observed state of the ClusterProfileBinding.
+	// +optional
+	Status ProfileBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProfileBindingList contains a list of ClusterProfileBinding.
+type ClusterProfileBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProfileBinding `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to register the scheme
+	SchemeBuilder.Register(&ClusterProfileBinding{}, &ClusterProfileBindingList{})
+}
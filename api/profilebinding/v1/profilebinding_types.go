@@ -29,6 +29,42 @@ const (
 	SelectAllContainersImage          string             = "*"
 )
 
+// ImageMatchType defines how the image of a ProfileBinding gets compared to
+// the image of a container.
+type ImageMatchType string
+
+const (
+	// ImageMatchExact matches the image string as it is.
+	ImageMatchExact ImageMatchType = "Exact"
+	// ImageMatchRepository matches the image repository, ignoring the tag and
+	// digest. For example "nginx" matches "docker.io/library/nginx:1.19.1".
+	ImageMatchRepository ImageMatchType = "Repository"
+	// ImageMatchGlob matches the image against a shell file name pattern,
+	// where "*" does not match the "/" separator.
+	ImageMatchGlob ImageMatchType = "Glob"
+	// ImageMatchRegex matches the image against an anchored regular expression.
+	ImageMatchRegex ImageMatchType = "Regex"
+)
+
+// EnforcementMode defines whether a ProfileBinding applies the profile to the
+// selected containers or only reports what it would do.
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce sets the profile in the security context of the
+	// selected containers.
+	EnforcementModeEnforce EnforcementMode = "Enforce"
+	// EnforcementModeAudit keeps the security context unchanged and only
+	// annotates the pod with the profiles which would have been applied. The
+	// non-enforcing variant of the profile gets used instead if available.
+	EnforcementModeAudit EnforcementMode = "Audit"
+)
+
+// AuditProfilesAnnotation is set on pods selected by ProfileBindings in Audit
+// mode. The value is a JSON object which maps the container name (or "*" for
+// the whole pod) to the profiles by kind which would have been applied.
+const AuditProfilesAnnotation = "spo.x-k8s.io/audit-profiles"
+
 // ProfileBindingSpec defines the desired state of ProfileBinding.
 type ProfileBindingSpec struct {
 	// profileRef references a SeccompProfile or other profile type in the current namespace.
@@ -39,6 +75,26 @@ type ProfileBindingSpec struct {
 	// +required
 	// +kubebuilder:validation:MinLength=1
 	Image string `json:"image,omitempty"`
+	// imageMatch defines how the image gets compared to the container images.
+	// Defaults to Exact. The "*" image matches all containers regardless of this field.
+	// +optional
+	// +kubebuilder:validation:Enum=Exact;Repository;Glob;Regex
+	ImageMatch ImageMatchType `json:"imageMatch,omitempty"`
+	// containerNames restricts the binding to the containers with one of the given names.
+	// If set together with the "*" image, the profile gets bound to the matching containers
+	// instead of the pod.
+	// +optional
+	// +listType=set
+	ContainerNames []string `json:"containerNames,omitempty"`
+	// podSelector restricts the binding to the pods matching the label selector.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// enforcementMode defines whether the profile gets applied to the matching
+	// containers (Enforce) or if the pods only get annotated with the profiles
+	// which would have been applied (Audit). Defaults to Enforce.
+	// +optional
+	// +kubebuilder:validation:Enum=Enforce;Audit
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // ProfileRef contains information that points to the profile being used.
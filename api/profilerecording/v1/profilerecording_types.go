@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 
@@ -46,8 +47,28 @@ type ProfileMergeStrategy string
 const (
 	ProfileMergeNone       ProfileMergeStrategy = "None"
 	ProfileMergeContainers ProfileMergeStrategy = "Containers"
+	ProfileMergeContinuous ProfileMergeStrategy = "Continuous"
 )
 
+type ProfileNamingStrategy string
+
+const (
+	ProfileNamingRecording ProfileNamingStrategy = "Recording"
+	ProfileNamingWorkload  ProfileNamingStrategy = "Workload"
+)
+
+type ExecPolicy string
+
+const (
+	ExecPolicyInclude  ExecPolicy = "Include"
+	ExecPolicyExclude  ExecPolicy = "Exclude"
+	ExecPolicySeparate ExecPolicy = "Separate"
+)
+
+// DebugProfileSuffix is appended to the container name of the profiles which
+// record exec sessions and ephemeral containers separately.
+const DebugProfileSuffix = "-debug"
+
 const (
 	// ProfileToRecordingLabel is the name of the ProfileRecording CR that produced this profile.
 	ProfileToRecordingLabel = "spo.x-k8s.io/recording-id"
@@ -56,11 +77,92 @@ const (
 	ProfileToRecordingNamespaceLabel = "spo.x-k8s.io/recording-namespace"
 	// ProfileToContainerLabel is the name of the container that produced this profile.
 	ProfileToContainerLabel = "spo.x-k8s.io/container-id"
+	// ProfileToWorkloadKindLabel is the kind of the top-level controller of the pod that produced this profile,
+	// for example Deployment or CronJob.
+	ProfileToWorkloadKindLabel = "spo.x-k8s.io/workload-kind"
+	// ProfileToWorkloadNameLabel is the name of the top-level controller of the pod that produced this profile.
+	ProfileToWorkloadNameLabel = "spo.x-k8s.io/workload-name"
 	// RecordingHasUnmergedProfiles is a finalizer that indicates that the recording has partial policies. Its
 	// main use is to hold off the deletion of the recording until all partial profiles are merged.
 	RecordingHasUnmergedProfiles = "spo.x-k8s.io/has-unmerged-profiles"
+	// ProfileMergeHistoryAnnotation lists the latest merges into a merged
+	// profile as JSON.
+	ProfileMergeHistoryAnnotation = "spo.x-k8s.io/merge-history"
+	// ProfileContainerImagesAnnotation lists the comma separated images of
+	// the containers which produced the profile.
+	ProfileContainerImagesAnnotation = "spo.x-k8s.io/container-images"
 )
 
+const (
+	// ProfileRecordingConditionCompleted indicates that the recorded profiles
+	// of a pod got collected because a bound of the recording was reached.
+	ProfileRecordingConditionCompleted = "Completed"
+
+	// ProfileRecordingReasonDurationReached is set if the recording stopped
+	// because of the duration bound.
+	ProfileRecordingReasonDurationReached = "DurationReached"
+	// ProfileRecordingReasonIdle is set if the recording stopped because no
+	// new events were recorded for the stopAfterIdle duration.
+	ProfileRecordingReasonIdle = "Idle"
+	// ProfileRecordingReasonPodReady is set if the recording stopped because
+	// of the untilPodReady bound.
+	ProfileRecordingReasonPodReady = "PodReady"
+
+	// ProfileRecordingConditionRecording indicates that at least one
+	// container is being recorded.
+	ProfileRecordingConditionRecording = "Recording"
+	// ProfileRecordingConditionCollecting indicates that the profiles of at
+	// least one container are being collected.
+	ProfileRecordingConditionCollecting = "Collecting"
+	// ProfileRecordingConditionFailed indicates that the profiles of at least
+	// one container could not be collected.
+	ProfileRecordingConditionFailed = "Failed"
+	// ProfileRecordingConditionMerged indicates that the partial profiles of
+	// the recording got merged.
+	ProfileRecordingConditionMerged = "Merged"
+
+	// ProfileRecordingReasonContainersRecording is set if containers are
+	// being recorded.
+	ProfileRecordingReasonContainersRecording = "ContainersRecording"
+	// ProfileRecordingReasonContainersCollecting is set if the profiles of
+	// containers are being collected.
+	ProfileRecordingReasonContainersCollecting = "ContainersCollecting"
+	// ProfileRecordingReasonContainersFailed is set if the profiles of
+	// containers could not be collected.
+	ProfileRecordingReasonContainersFailed = "ContainersFailed"
+	// ProfileRecordingReasonNoContainers is set if no container is in the
+	// phase of the condition.
+	ProfileRecordingReasonNoContainers = "NoContainers"
+	// ProfileRecordingReasonProfilesMerged is set if the partial profiles got
+	// merged.
+	ProfileRecordingReasonProfilesMerged = "ProfilesMerged"
+	// ProfileRecordingReasonMergeFailed is set if the partial profiles could
+	// not be merged.
+	ProfileRecordingReasonMergeFailed = "MergeFailed"
+)
+
+// RecordedContainerPhase is the phase of a recorded container.
+type RecordedContainerPhase string
+
+const (
+	// RecordedContainerPhaseRecording means that the container is being
+	// recorded.
+	RecordedContainerPhaseRecording RecordedContainerPhase = "Recording"
+	// RecordedContainerPhaseCollecting means that the recorded profile of the
+	// container is being collected.
+	RecordedContainerPhaseCollecting RecordedContainerPhase = "Collecting"
+	// RecordedContainerPhaseCollected means that the recorded profile of the
+	// container got collected.
+	RecordedContainerPhaseCollected RecordedContainerPhase = "Collected"
+	// RecordedContainerPhaseFailed means that the recorded profile of the
+	// container could not be collected.
+	RecordedContainerPhaseFailed RecordedContainerPhase = "Failed"
+)
+
+// MaxRecordedContainers is the maximum number of containers kept in the
+// status of a recording. The oldest finished containers get removed first.
+const MaxRecordedContainers = 100
+
 // ProfileRecordingSpec defines the desired state of ProfileRecording.
 type ProfileRecordingSpec struct {
 	// kind specifies the type of object to be recorded.
@@ -74,10 +176,13 @@ type ProfileRecordingSpec struct {
 	Recorder ProfileRecorder `json:"recorder,omitempty"`
 
 	// mergeStrategy controls whether or how to merge recorded profiles.
-	// Can be one of "None" or "Containers". Default is "None".
+	// Can be one of "None", "Containers" or "Continuous". Default is "None".
+	// "Containers" merges the profiles of all instances of a container once
+	// the recording gets deleted, while "Continuous" merges them into the
+	// final profile as soon as they got recorded.
 	// +optional
 	// +default="None"
-	// +kubebuilder:validation:Enum=None;Containers
+	// +kubebuilder:validation:Enum=None;Containers;Continuous
 	MergeStrategy ProfileMergeStrategy `json:"mergeStrategy,omitempty"`
 
 	// podSelector selects the pods to record. This field follows standard
@@ -100,6 +205,111 @@ type ProfileRecordingSpec struct {
 	// +optional
 	// +default=false
 	DisableProfileAfterRecording bool `json:"disableProfileAfterRecording,omitempty"`
+
+	// recordSyscallArgs is a set of syscalls for which the distinct argument
+	// values get recorded in addition to the syscall itself. The resulting
+	// profile allows those syscalls only for the recorded argument values.
+	// Only supported for the SeccompProfile kind together with the Bpf
+	// recorder.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=clone;fcntl;ioctl;personality;prctl;socket;unshare
+	RecordSyscallArgs []string `json:"recordSyscallArgs,omitempty"`
+
+	// seccompNotify makes the Logs recorder trace the syscalls via seccomp
+	// user notifications instead of a profile logging every syscall to the
+	// kernel audit log. Requires the seccomp notify recorder to be enabled
+	// in the SPOD configuration and a container runtime which supports
+	// seccomp agents. Only supported for the SeccompProfile kind together
+	// with the Logs recorder.
+	// +optional
+	// +default=false
+	SeccompNotify bool `json:"seccompNotify,omitempty"`
+
+	// duration stops the recording of a pod once it runs for the given time
+	// and collects the recorded profiles without deleting the pod.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// stopAfterIdle stops the recording of a pod once no new syscalls or
+	// AVCs were recorded for the given time and collects the recorded
+	// profiles without deleting the pod. Only supported for the Logs
+	// recorder.
+	// +optional
+	StopAfterIdle *metav1.Duration `json:"stopAfterIdle,omitempty"`
+
+	// untilPodReady stops the recording of a pod the given time after the
+	// pod became ready and collects the recorded profiles without deleting
+	// the pod. A zero duration stops the recording as soon as the pod is
+	// ready.
+	// +optional
+	UntilPodReady *metav1.Duration `json:"untilPodReady,omitempty"`
+
+	// mergeOnCompletion merges the recorded profiles as soon as one of the
+	// duration, stopAfterIdle or untilPodReady bounds got reached, instead
+	// of waiting for the recording to be deleted. Requires the "Containers"
+	// or "Continuous" merge strategy.
+	// +optional
+	// +default=false
+	MergeOnCompletion bool `json:"mergeOnCompletion,omitempty"`
+
+	// appendToExistingProfile merges the recorded profiles into an already
+	// existing merged profile instead of replacing it, which lets repeated
+	// recordings of the same workload accumulate into one profile. Requires
+	// the "Containers" or "Continuous" merge strategy.
+	// +optional
+	// +default=false
+	AppendToExistingProfile bool `json:"appendToExistingProfile,omitempty"`
+
+	// profileNaming selects how the recorded profiles get named. "Recording"
+	// names them after the recording and the container, "Workload"
+	// additionally adds the name of the top-level controller of the
+	// recorded pod, like its Deployment, StatefulSet, DaemonSet or CronJob.
+	// Pods without a controller are always named after the recording.
+	// +optional
+	// +default="Recording"
+	// +kubebuilder:validation:Enum=Recording;Workload
+	ProfileNaming ProfileNamingStrategy `json:"profileNaming,omitempty"`
+
+	// bindAfterRecording creates a ProfileBinding for every image of the
+	// recorded containers once their profiles got merged. The bindings
+	// start in the Audit enforcement mode and get promoted to Enforce as
+	// soon as the merged profile is installed on all nodes. Requires the
+	// "Containers" or "Continuous" merge strategy.
+	// +optional
+	// +default=false
+	BindAfterRecording bool `json:"bindAfterRecording,omitempty"`
+
+	// execPolicy controls how the processes of exec sessions and of
+	// ephemeral containers targeting a recorded container get recorded.
+	// "Include" records them into the profile of the container, "Exclude"
+	// drops them and "Separate" records them into a profile of their own,
+	// named after the container with a "-debug" suffix. Exec sessions are
+	// only detected with the execmetadata webhook enabled and on a
+	// best-effort basis: processes which exited before the log enricher
+	// inspected them are recorded into the profile of the container. Only
+	// supported for the Logs recorder without seccompNotify.
+	// +optional
+	// +default="Include"
+	// +kubebuilder:validation:Enum=Include;Exclude;Separate
+	ExecPolicy ExecPolicy `json:"execPolicy,omitempty"`
+}
+
+// MergesProfiles returns true if the recorded profiles get merged.
+func (s *ProfileRecordingSpec) MergesProfiles() bool {
+	return s.MergeStrategy == ProfileMergeContainers || s.MergeStrategy == ProfileMergeContinuous
+}
+
+// MergesIntoExistingProfile returns true if the recorded profiles get merged
+// into the already existing merged profile.
+func (s *ProfileRecordingSpec) MergesIntoExistingProfile() bool {
+	return s.MergeStrategy == ProfileMergeContinuous || s.MergeOnCompletion || s.AppendToExistingProfile
+}
+
+// IsBounded returns true if the recording of a pod stops on its own before
+// the pod gets deleted.
+func (s *ProfileRecordingSpec) IsBounded() bool {
+	return s.Duration != nil || s.StopAfterIdle != nil || s.UntilPodReady != nil
 }
 
 // ProfileRecordingStatus contains status of the ProfileRecording.
@@ -108,6 +318,177 @@ type ProfileRecordingStatus struct {
 	// +optional
 	// +listType=set
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+
+	// conditions of the recording, like whether profiles got collected
+	// because a bound of the recording was reached.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// containers lists the recorded containers and their progress.
+	// +optional
+	// +listType=map
+	// +listMapKey=podName
+	// +listMapKey=containerName
+	// +kubebuilder:validation:MaxItems=100
+	Containers []RecordedContainer `json:"containers,omitempty"`
+}
+
+// RecordedContainer is the recording progress of a single container.
+type RecordedContainer struct {
+	// podName is the name of the recorded pod.
+	// +required
+	PodName string `json:"podName"`
+
+	// containerName is the name of the recorded container.
+	// +required
+	ContainerName string `json:"containerName"`
+
+	// nodeName is the name of the node the pod runs on.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// recorder is the recorder used for the container.
+	// +optional
+	Recorder ProfileRecorder `json:"recorder,omitempty"`
+
+	// phase is the recording phase of the container.
+	// +optional
+	// +kubebuilder:validation:Enum=Recording;Collecting;Collected;Failed
+	Phase RecordedContainerPhase `json:"phase,omitempty"`
+
+	// startTime is the time when the recording of the container started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// recordedEvents is the number of syscalls, SELinux permissions or
+	// AppArmor paths in the collected profile.
+	// +optional
+	RecordedEvents int32 `json:"recordedEvents,omitempty"`
+
+	// profile references the profile produced for the container.
+	// +optional
+	Profile *RecordedProfileReference `json:"profile,omitempty"`
+
+	// message contains details about the phase, like the collection error.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RecordedProfileReference references a recorded profile.
+type RecordedProfileReference struct {
+	// kind is the kind of the profile.
+	// +required
+	Kind ProfileRecordingKind `json:"kind"`
+
+	// name is the name of the profile.
+	// +required
+	Name string `json:"name"`
+}
+
+// SetContainer adds or updates the container in the status. The oldest
+// finished containers get removed if the status exceeds
+// MaxRecordedContainers.
+func (s *ProfileRecordingStatus) SetContainer(container RecordedContainer) {
+	found := false
+
+	for i := range s.Containers {
+		if s.Containers[i].PodName == container.PodName &&
+			s.Containers[i].ContainerName == container.ContainerName {
+			s.Containers[i] = container
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		s.Containers = append(s.Containers, container)
+	}
+
+	for len(s.Containers) > MaxRecordedContainers {
+		oldest := -1
+
+		for i := range s.Containers {
+			if s.Containers[i].Phase != RecordedContainerPhaseCollected &&
+				s.Containers[i].Phase != RecordedContainerPhaseFailed {
+				continue
+			}
+
+			if oldest == -1 || s.Containers[i].StartTime.Before(s.Containers[oldest].StartTime) {
+				oldest = i
+			}
+		}
+
+		if oldest == -1 {
+			oldest = 0
+		}
+
+		s.Containers = append(s.Containers[:oldest], s.Containers[oldest+1:]...)
+	}
+}
+
+// FindContainer returns the container of the status, or nil if it does not
+// exist.
+func (s *ProfileRecordingStatus) FindContainer(podName, containerName string) *RecordedContainer {
+	for i := range s.Containers {
+		if s.Containers[i].PodName == podName && s.Containers[i].ContainerName == containerName {
+			return &s.Containers[i]
+		}
+	}
+
+	return nil
+}
+
+// UpdateContainerConditions sets the Recording, Collecting and Failed
+// conditions from the phases of the containers.
+func (s *ProfileRecordingStatus) UpdateContainerConditions(generation int64) {
+	for _, c := range []struct {
+		conditionType string
+		phase         RecordedContainerPhase
+		reason        string
+	}{
+		{
+			ProfileRecordingConditionRecording,
+			RecordedContainerPhaseRecording,
+			ProfileRecordingReasonContainersRecording,
+		},
+		{
+			ProfileRecordingConditionCollecting,
+			RecordedContainerPhaseCollecting,
+			ProfileRecordingReasonContainersCollecting,
+		},
+		{
+			ProfileRecordingConditionFailed,
+			RecordedContainerPhaseFailed,
+			ProfileRecordingReasonContainersFailed,
+		},
+	} {
+		count := 0
+
+		for i := range s.Containers {
+			if s.Containers[i].Phase == c.phase {
+				count++
+			}
+		}
+
+		condition := metav1.Condition{
+			Type:               c.conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             ProfileRecordingReasonNoContainers,
+			Message:            fmt.Sprintf("No container is in phase %s", c.phase),
+		}
+
+		if count > 0 {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = c.reason
+			condition.Message = fmt.Sprintf("%d container(s) in phase %s", count, c.phase)
+		}
+
+		meta.SetStatusCondition(&s.Conditions, condition)
+	}
 }
 
 // +kubebuilder:object:root=true
@@ -115,6 +496,12 @@ type ProfileRecordingStatus struct {
 // ProfileRecording is the Schema for the profilerecordings API.
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Recording",type=string,JSONPath=`.status.conditions[?(@.type=="Recording")].status`
+// +kubebuilder:printcolumn:name="Completed",type=string,JSONPath=`.status.conditions[?(@.type=="Completed")].status`
+// +kubebuilder:printcolumn:name="Merged",type=string,priority=1,JSONPath=`.status.conditions[?(@.type=="Merged")].status`
+// +kubebuilder:printcolumn:name="Failed",type=string,priority=1,JSONPath=`.status.conditions[?(@.type=="Failed")].status`
+// +kubebuilder:printcolumn:name="Profiles",type=string,priority=1,JSONPath=`.status.containers[*].profile.name`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="PodSelector",type=string,priority=10,JSONPath=`.spec.podSelector`
 type ProfileRecording struct {
 	metav1.TypeMeta `json:",inline"`
@@ -159,12 +546,7 @@ func (pr *ProfileRecording) IsKindSupported() bool {
 func (pr *ProfileRecording) ValidateRecorderKindCombination() error {
 	switch pr.Spec.Kind {
 	case ProfileRecordingKindSelinuxProfile:
-		if pr.Spec.Recorder != ProfileRecorderLogs {
-			return fmt.Errorf(
-				"recorder %q is not supported for %s, only %q is supported",
-				pr.Spec.Recorder, pr.Spec.Kind, ProfileRecorderLogs,
-			)
-		}
+		// All recorders are supported.
 	case ProfileRecordingKindAppArmorProfile:
 		if pr.Spec.Recorder != ProfileRecorderBpf {
 			return fmt.Errorf(
@@ -174,10 +556,73 @@ func (pr *ProfileRecording) ValidateRecorderKindCombination() error {
 		}
 	case ProfileRecordingKindSeccompProfile:
 		// All recorders are supported.
+		if len(pr.Spec.RecordSyscallArgs) > 0 && pr.Spec.Recorder != ProfileRecorderBpf {
+			return fmt.Errorf(
+				"recording syscall arguments is not supported for recorder %q, only %q is supported",
+				pr.Spec.Recorder, ProfileRecorderBpf,
+			)
+		}
+
+		if pr.Spec.SeccompNotify && pr.Spec.Recorder != ProfileRecorderLogs {
+			return fmt.Errorf(
+				"seccomp notify is not supported for recorder %q, only %q is supported",
+				pr.Spec.Recorder, ProfileRecorderLogs,
+			)
+		}
 	default:
 		return fmt.Errorf("unsupported kind: %s", pr.Spec.Kind)
 	}
 
+	if pr.Spec.StopAfterIdle != nil && pr.Spec.Recorder != ProfileRecorderLogs {
+		return fmt.Errorf(
+			"stopping after idle is not supported for recorder %q, only %q is supported",
+			pr.Spec.Recorder, ProfileRecorderLogs,
+		)
+	}
+
+	if pr.Spec.MergeOnCompletion && !pr.Spec.MergesProfiles() {
+		return fmt.Errorf(
+			"merging on completion requires the %q or %q merge strategy",
+			ProfileMergeContainers, ProfileMergeContinuous,
+		)
+	}
+
+	if pr.Spec.AppendToExistingProfile && !pr.Spec.MergesProfiles() {
+		return fmt.Errorf(
+			"appending to the existing profile requires the %q or %q merge strategy",
+			ProfileMergeContainers, ProfileMergeContinuous,
+		)
+	}
+
+	if pr.Spec.BindAfterRecording && !pr.Spec.MergesProfiles() {
+		return fmt.Errorf(
+			"binding after recording requires the %q or %q merge strategy",
+			ProfileMergeContainers, ProfileMergeContinuous,
+		)
+	}
+
+	if pr.Spec.ExecPolicy != "" && pr.Spec.ExecPolicy != ExecPolicyInclude &&
+		(pr.Spec.Recorder != ProfileRecorderLogs || pr.Spec.SeccompNotify) {
+		return fmt.Errorf(
+			"exec policy %q is only supported for recorder %q without seccomp notify",
+			pr.Spec.ExecPolicy, ProfileRecorderLogs,
+		)
+	}
+
+	if len(pr.Spec.RecordSyscallArgs) > 0 && pr.Spec.Kind != ProfileRecordingKindSeccompProfile {
+		return fmt.Errorf(
+			"recording syscall arguments is not supported for %s, only %s is supported",
+			pr.Spec.Kind, ProfileRecordingKindSeccompProfile,
+		)
+	}
+
+	if pr.Spec.SeccompNotify && pr.Spec.Kind != ProfileRecordingKindSeccompProfile {
+		return fmt.Errorf(
+			"seccomp notify is not supported for %s, only %s is supported",
+			pr.Spec.Kind, ProfileRecordingKindSeccompProfile,
+		)
+	}
+
 	return nil
 }
 
@@ -220,12 +665,10 @@ func (pr *ProfileRecording) ctrAnnotationSelinux(ctrName string) (key, value str
 	case ProfileRecorderLogs:
 		annotationPrefix = config.SelinuxProfileRecordLogsAnnotationKey
 	case ProfileRecorderBpf:
-		return "", "", fmt.Errorf(
-			"invalid recorder: %s, only %s is supported", pr.Spec.Recorder, ProfileRecorderLogs,
-		)
+		annotationPrefix = config.SelinuxProfileRecordBpfAnnotationKey
 	default:
 		return "", "", fmt.Errorf(
-			"invalid recorder: %s, only %s is supported", pr.Spec.Recorder, ProfileRecorderLogs,
+			"invalid recorder: %s", pr.Spec.Recorder,
 		)
 	}
 
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetContainer(t *testing.T) {
+	t.Parallel()
+
+	status := ProfileRecordingStatus{}
+	status.SetContainer(RecordedContainer{
+		PodName:       "pod",
+		ContainerName: "container",
+		Phase:         RecordedContainerPhaseRecording,
+	})
+	status.SetContainer(RecordedContainer{
+		PodName:       "pod",
+		ContainerName: "container",
+		Phase:         RecordedContainerPhaseCollected,
+	})
+
+	require.Len(t, status.Containers, 1)
+	require.Equal(t, RecordedContainerPhaseCollected, status.Containers[0].Phase)
+	require.NotNil(t, status.FindContainer("pod", "container"))
+	require.Nil(t, status.FindContainer("pod", "other"))
+}
+
+func TestSetContainerLimit(t *testing.T) {
+	t.Parallel()
+
+	status := ProfileRecordingStatus{}
+	start := time.Now()
+
+	for i := range MaxRecordedContainers {
+		phase := RecordedContainerPhaseRecording
+		if i == MaxRecordedContainers/2 {
+			phase = RecordedContainerPhaseCollected
+		}
+
+		status.SetContainer(RecordedContainer{
+			PodName:       fmt.Sprintf("pod-%d", i),
+			ContainerName: "container",
+			Phase:         phase,
+			StartTime:     &metav1.Time{Time: start.Add(time.Duration(i) * time.Second)},
+		})
+	}
+
+	status.SetContainer(RecordedContainer{
+		PodName:       "new",
+		ContainerName: "container",
+		Phase:         RecordedContainerPhaseRecording,
+	})
+
+	require.Len(t, status.Containers, MaxRecordedContainers)
+	require.Nil(t, status.FindContainer(fmt.Sprintf("pod-%d", MaxRecordedContainers/2), "container"))
+	require.NotNil(t, status.FindContainer("pod-0", "container"))
+	require.NotNil(t, status.FindContainer("new", "container"))
+}
+
+func TestUpdateContainerConditions(t *testing.T) {
+	t.Parallel()
+
+	status := ProfileRecordingStatus{
+		Containers: []RecordedContainer{
+			{PodName: "pod-1", ContainerName: "container", Phase: RecordedContainerPhaseRecording},
+			{PodName: "pod-2", ContainerName: "container", Phase: RecordedContainerPhaseFailed},
+		},
+	}
+	status.UpdateContainerConditions(2)
+
+	recording := meta.FindStatusCondition(status.Conditions, ProfileRecordingConditionRecording)
+	require.NotNil(t, recording)
+	require.Equal(t, metav1.ConditionTrue, recording.Status)
+	require.Equal(t, ProfileRecordingReasonContainersRecording, recording.Reason)
+	require.Equal(t, int64(2), recording.ObservedGeneration)
+
+	require.True(t, meta.IsStatusConditionFalse(status.Conditions, ProfileRecordingConditionCollecting))
+	require.True(t, meta.IsStatusConditionTrue(status.Conditions, ProfileRecordingConditionFailed))
+
+	status.Containers[0].Phase = RecordedContainerPhaseCollected
+	status.UpdateContainerConditions(2)
+
+	require.True(t, meta.IsStatusConditionFalse(status.Conditions, ProfileRecordingConditionRecording))
+}
@@ -97,6 +97,26 @@ func (in *ProfileRecordingSpec) DeepCopyInto(out *ProfileRecordingSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecordSyscallArgs != nil {
+		in, out := &in.RecordSyscallArgs, &out.RecordSyscallArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StopAfterIdle != nil {
+		in, out := &in.StopAfterIdle, &out.StopAfterIdle
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UntilPodReady != nil {
+		in, out := &in.UntilPodReady, &out.UntilPodReady
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingSpec.
@@ -117,6 +137,20 @@ func (in *ProfileRecordingStatus) DeepCopyInto(out *ProfileRecordingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]RecordedContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingStatus.
@@ -128,3 +162,42 @@ func (in *ProfileRecordingStatus) DeepCopy() *ProfileRecordingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordedContainer) DeepCopyInto(out *RecordedContainer) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(RecordedProfileReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordedContainer.
+func (in *RecordedContainer) DeepCopy() *RecordedContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordedContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordedProfileReference) DeepCopyInto(out *RecordedProfileReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordedProfileReference.
+func (in *RecordedProfileReference) DeepCopy() *RecordedProfileReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordedProfileReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
@@ -24,6 +25,17 @@ import (
 	profilerecordingv1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
 )
 
+// conversionDataAnnotation stores the v1 spec and status of a profile
+// recording converted to v1alpha1, to restore the fields which cannot be
+// represented in v1alpha1 when converting it back.
+const conversionDataAnnotation = "spo.x-k8s.io/conversion-data"
+
+// conversionData is the content of the conversion data annotation.
+type conversionData struct {
+	Spec   profilerecordingv1.ProfileRecordingSpec   `json:"spec"`
+	Status profilerecordingv1.ProfileRecordingStatus `json:"status"`
+}
+
 var (
 	recorderToV1 = map[ProfileRecorder]profilerecordingv1.ProfileRecorder{
 		"":                  profilerecordingv1.ProfileRecorderLogs,
@@ -44,6 +56,7 @@ var (
 		"":                                  ProfileMergeNone,
 		profilerecordingv1.ProfileMergeNone: ProfileMergeNone,
 		profilerecordingv1.ProfileMergeContainers: ProfileMergeContainers,
+		profilerecordingv1.ProfileMergeContinuous: ProfileMergeContainers,
 	}
 )
 
@@ -53,11 +66,33 @@ func (src *ProfileRecording) ConvertTo(dstRaw conversion.Hub) error {
 		return fmt.Errorf("expected *profilerecordingv1.ProfileRecording, got %T", dstRaw)
 	}
 
-	dst.ObjectMeta = src.ObjectMeta
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	if data, ok := dst.Annotations[conversionDataAnnotation]; ok {
+		restored := &conversionData{}
+		if err := json.Unmarshal([]byte(data), restored); err != nil {
+			return fmt.Errorf("unmarshal conversion data: %w", err)
+		}
+
+		dst.Spec = restored.Spec
+		dst.Status = restored.Status
+
+		delete(dst.Annotations, conversionDataAnnotation)
+
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	// Continuous is represented as Containers in v1alpha1.
+	mergeStrategy := mergeToV1[src.Spec.MergeStrategy]
+	if mergeStrategy != profilerecordingv1.ProfileMergeContainers ||
+		dst.Spec.MergeStrategy != profilerecordingv1.ProfileMergeContinuous {
+		dst.Spec.MergeStrategy = mergeStrategy
+	}
 
 	dst.Spec.Kind = profilerecordingv1.ProfileRecordingKind(src.Spec.Kind)
 	dst.Spec.Recorder = recorderToV1[src.Spec.Recorder]
-	dst.Spec.MergeStrategy = mergeToV1[src.Spec.MergeStrategy]
 	dst.Spec.PodSelector = src.Spec.PodSelector
 	dst.Spec.Containers = src.Spec.Containers
 	dst.Spec.DisableProfileAfterRecording = src.Spec.DisableProfileAfterRecording
@@ -73,7 +108,7 @@ func (dst *ProfileRecording) ConvertFrom(srcRaw conversion.Hub) error {
 		return fmt.Errorf("expected *profilerecordingv1.ProfileRecording, got %T", srcRaw)
 	}
 
-	dst.ObjectMeta = src.ObjectMeta
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
 
 	dst.Spec.Kind = ProfileRecordingKind(src.Spec.Kind)
 	dst.Spec.Recorder = recorderFromV1[src.Spec.Recorder]
@@ -84,5 +119,18 @@ func (dst *ProfileRecording) ConvertFrom(srcRaw conversion.Hub) error {
 
 	dst.Status.ActiveWorkloads = src.Status.ActiveWorkloads
 
+	// Keep the v1 only fields, like the merge strategy Continuous or
+	// appendToExistingProfile, for converting the recording back.
+	data, err := json.Marshal(&conversionData{Spec: src.Spec, Status: src.Status})
+	if err != nil {
+		return fmt.Errorf("marshal conversion data: %w", err)
+	}
+
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+
+	dst.Annotations[conversionDataAnnotation] = string(data)
+
 	return nil
 }
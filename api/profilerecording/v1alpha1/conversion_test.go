@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	profilerecordingv1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+)
+
+func testRecordingV1() *profilerecordingv1.ProfileRecording {
+	return &profilerecordingv1.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "recording",
+			Namespace:   "namespace",
+			Annotations: map[string]string{"key": "value"},
+		},
+		Spec: profilerecordingv1.ProfileRecordingSpec{
+			Kind:                         profilerecordingv1.ProfileRecordingKindSeccompProfile,
+			Recorder:                     profilerecordingv1.ProfileRecorderLogs,
+			MergeStrategy:                profilerecordingv1.ProfileMergeContinuous,
+			PodSelector:                  &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			Containers:                   []string{"nginx"},
+			DisableProfileAfterRecording: true,
+			Duration:                     &metav1.Duration{Duration: time.Hour},
+			StopAfterIdle:                &metav1.Duration{Duration: time.Minute},
+			MergeOnCompletion:            true,
+			AppendToExistingProfile:      true,
+			ProfileNaming:                profilerecordingv1.ProfileNamingWorkload,
+			BindAfterRecording:           true,
+			ExecPolicy:                   profilerecordingv1.ExecPolicySeparate,
+		},
+		Status: profilerecordingv1.ProfileRecordingStatus{
+			ActiveWorkloads: []string{"nginx"},
+			Containers: []profilerecordingv1.RecordedContainer{{
+				PodName:       "pod",
+				ContainerName: "nginx",
+				Phase:         profilerecordingv1.RecordedContainerPhaseRecording,
+			}},
+		},
+	}
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	want := testRecordingV1()
+
+	alpha := &ProfileRecording{}
+	require.NoError(t, alpha.ConvertFrom(want.DeepCopy()))
+	require.Equal(t, ProfileMergeContainers, alpha.Spec.MergeStrategy)
+	require.Contains(t, alpha.Annotations, conversionDataAnnotation)
+
+	got := &profilerecordingv1.ProfileRecording{}
+	require.NoError(t, alpha.ConvertTo(got))
+	require.Equal(t, want, got)
+}
+
+func TestConversionRoundTripChanged(t *testing.T) {
+	t.Parallel()
+
+	alpha := &ProfileRecording{}
+	require.NoError(t, alpha.ConvertFrom(testRecordingV1()))
+
+	// Changes of the v1alpha1 fields take precedence over the stored ones.
+	alpha.Spec.MergeStrategy = ProfileMergeNone
+	alpha.Spec.Containers = []string{"redis"}
+
+	got := &profilerecordingv1.ProfileRecording{}
+	require.NoError(t, alpha.ConvertTo(got))
+	require.Equal(t, profilerecordingv1.ProfileMergeNone, got.Spec.MergeStrategy)
+	require.Equal(t, []string{"redis"}, got.Spec.Containers)
+	require.True(t, got.Spec.AppendToExistingProfile)
+	require.Equal(t, profilerecordingv1.ExecPolicySeparate, got.Spec.ExecPolicy)
+}
+
+func TestConversionWithoutData(t *testing.T) {
+	t.Parallel()
+
+	alpha := &ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{Name: "recording"},
+		Spec: ProfileRecordingSpec{
+			Kind:          ProfileRecordingKindSelinuxProfile,
+			MergeStrategy: ProfileMergeContainers,
+		},
+	}
+
+	got := &profilerecordingv1.ProfileRecording{}
+	require.NoError(t, alpha.ConvertTo(got))
+	require.Nil(t, got.Annotations)
+	require.Equal(t, profilerecordingv1.ProfileRecordingKindSelinuxProfile, got.Spec.Kind)
+	require.Equal(t, profilerecordingv1.ProfileRecorderLogs, got.Spec.Recorder)
+	require.Equal(t, profilerecordingv1.ProfileMergeContainers, got.Spec.MergeStrategy)
+	require.False(t, got.Spec.AppendToExistingProfile)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNotify(t *testing.T) {
+	t.Parallel()
+
+	patternArgs := []NotifyArg{{Index: 1, Patterns: []string{"/etc/*"}}}
+
+	tests := []struct {
+		name        string
+		notify      *NotifyPolicy
+		errContains string
+	}{
+		{
+			name: "No notify policy",
+		},
+		{
+			name: "Allow rule with values",
+			notify: &NotifyPolicy{Rules: []NotifyRule{{
+				Names:  []string{"ioctl"},
+				Action: NotifyActionAllow,
+				Args:   []NotifyArg{{Index: 1, Values: []int64{21505}}},
+			}}},
+		},
+		{
+			name: "Deny rule with patterns",
+			notify: &NotifyPolicy{Rules: []NotifyRule{{
+				Names:  []string{"openat"},
+				Action: NotifyActionDeny,
+				Args:   patternArgs,
+			}}},
+		},
+		{
+			name: "Allow rule with patterns",
+			notify: &NotifyPolicy{Rules: []NotifyRule{{
+				Names:  []string{"openat"},
+				Action: NotifyActionAllow,
+				Args:   patternArgs,
+			}}},
+			errContains: "notify rule 0: patterns are only supported by rules with the Deny action",
+		},
+		{
+			name: "Log rule with patterns",
+			notify: &NotifyPolicy{Rules: []NotifyRule{
+				{Names: []string{"mount"}, Action: NotifyActionDeny, Args: patternArgs},
+				{Names: []string{"openat"}, Action: NotifyActionLog, Args: patternArgs},
+			}},
+			errContains: "notify rule 1: patterns are only supported by rules with the Deny action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &SeccompProfile{Spec: SeccompProfileSpec{Notify: tt.notify}}
+
+			err := sp.ValidateNotify()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"path"
 	"path/filepath"
 	"strings"
@@ -38,6 +39,10 @@ var (
 
 const ExtJSON = ".json"
 
+// ResolveBaseProfilesAnnotation can be set to a new value to re-resolve the
+// tags of remote base profiles to their current digests.
+const ResolveBaseProfilesAnnotation = "spo.x-k8s.io/resolve-base-profiles"
+
 type Action = seccompapi.Action
 
 const (
@@ -71,7 +76,10 @@ type SeccompProfileSpec struct {
 
 	// baseProfileName is the name of base profile (in the same namespace) that
 	// will be unioned into this profile. Base profiles can be references as
-	// remote OCI artifacts as well when prefixed with `oci://`.
+	// remote OCI artifacts as well when prefixed with `oci://` or as local
+	// OCI image layouts when prefixed with `oci-layout://`. Tags of remote
+	// base profiles are resolved once and pinned to their digest in the
+	// status.
 	// +optional
 	BaseProfileName string `json:"baseProfileName,omitempty"`
 
@@ -109,6 +117,13 @@ type SeccompProfileSpec struct {
 	// +optional
 	// +listType=set
 	Flags []Flag `json:"flags,omitempty"`
+
+	// notify contains the rules evaluated by the seccomp agent of the
+	// operator for all syscalls using the SCMP_ACT_NOTIFY action. If set,
+	// listenerPath defaults to the socket of the seccomp agent and
+	// listenerMetadata to the name of the profile.
+	// +optional
+	Notify *NotifyPolicy `json:"notify,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=SCMP_ARCH_NATIVE;SCMP_ARCH_X86;SCMP_ARCH_X86_64;SCMP_ARCH_X32;SCMP_ARCH_ARM;SCMP_ARCH_AARCH64;SCMP_ARCH_MIPS;SCMP_ARCH_MIPS64;SCMP_ARCH_MIPS64N32;SCMP_ARCH_MIPSEL;SCMP_ARCH_MIPSEL64;SCMP_ARCH_MIPSEL64N32;SCMP_ARCH_PPC;SCMP_ARCH_PPC64;SCMP_ARCH_PPC64LE;SCMP_ARCH_S390;SCMP_ARCH_S390X;SCMP_ARCH_PARISC;SCMP_ARCH_PARISC64;SCMP_ARCH_RISCV64
@@ -172,6 +187,77 @@ type Arg struct {
 	Op Operator `json:"op,omitempty"`
 }
 
+// NotifyAction is the decision of the seccomp agent about a notified syscall.
+// +kubebuilder:validation:Enum=Allow;Deny;Log
+type NotifyAction string
+
+const (
+	// NotifyActionAllow lets the kernel continue the syscall.
+	NotifyActionAllow NotifyAction = "Allow"
+
+	// NotifyActionDeny fails the syscall with an errno.
+	NotifyActionDeny NotifyAction = "Deny"
+
+	// NotifyActionLog lets the kernel continue the syscall and logs it.
+	NotifyActionLog NotifyAction = "Log"
+)
+
+// NotifyPolicy is the rule set evaluated by the seccomp agent.
+type NotifyPolicy struct {
+	// defaultAction is the action taken if no rule matches the notified
+	// syscall. Denied syscalls fail with EPERM.
+	// +optional
+	// +kubebuilder:default=Deny
+	DefaultAction NotifyAction `json:"defaultAction,omitempty"`
+	// rules are evaluated in order and the first matching rule decides
+	// about the notified syscall.
+	// +optional
+	// +listType=atomic
+	Rules []NotifyRule `json:"rules,omitempty"`
+}
+
+// NotifyRule matches notified syscalls by their name and arguments.
+type NotifyRule struct {
+	// names specifies the names of the syscalls.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Names []string `json:"names,omitempty"`
+	// action is the action taken if the rule matches.
+	// +required
+	Action NotifyAction `json:"action,omitempty"`
+	// errnoRet is the errno returned by denied syscalls, defaults to EPERM.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ErrnoRet int32 `json:"errnoRet,omitempty"`
+	// args restricts the rule to syscalls where all arguments match.
+	// +optional
+	// +kubebuilder:validation:MaxItems=6
+	// +listType=atomic
+	Args []NotifyArg `json:"args,omitempty"`
+}
+
+// NotifyArg matches a single syscall argument.
+type NotifyArg struct {
+	// index is the index of the syscall argument.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=5
+	Index int32 `json:"index"`
+	// values matches the argument if it is equal to one of the values.
+	// +optional
+	// +listType=set
+	Values []int64 `json:"values,omitempty"`
+	// patterns matches the argument if it points to a string in the memory
+	// of the calling process, like a path, which matches one of the
+	// patterns. The patterns use the syntax of Go's path.Match. Patterns are
+	// only supported by rules with the Deny action, because the process can
+	// change the string before the kernel continues the syscall.
+	// +optional
+	// +listType=set
+	Patterns []string `json:"patterns,omitempty"`
+}
+
 // SeccompProfileStatus contains status of the deployed SeccompProfile.
 type SeccompProfileStatus struct {
 	profilebasev1.StatusBase `json:",inline"`
@@ -187,6 +273,28 @@ type SeccompProfileStatus struct {
 	// or container spec.
 	// +optional
 	LocalhostProfile string `json:"localhostProfile,omitempty"`
+	// resolvedBaseProfiles lists the remote base profiles pinned to their
+	// digest, which are used by all nodes until they get re-resolved.
+	// +optional
+	// +listType=map
+	// +listMapKey=reference
+	ResolvedBaseProfiles []ResolvedBaseProfile `json:"resolvedBaseProfiles,omitempty"`
+	// observedResolveBaseProfiles is the value of the
+	// spo.x-k8s.io/resolve-base-profiles annotation which has been used for
+	// the last resolution of the remote base profiles.
+	// +optional
+	ObservedResolveBaseProfiles string `json:"observedResolveBaseProfiles,omitempty"`
+}
+
+// ResolvedBaseProfile is a remote base profile pinned to its digest.
+type ResolvedBaseProfile struct {
+	// reference of the base profile as used in the baseProfileName, without
+	// the `oci://` prefix.
+	// +required
+	Reference string `json:"reference"`
+	// digest the reference resolved to.
+	// +required
+	Digest string `json:"digest"`
 }
 
 // +kubebuilder:object:root=true
@@ -245,6 +353,22 @@ func (sp *SeccompProfile) GetProfilePath() string {
 	)
 }
 
+// GetAuditProfilePath returns the path of the SCMP_ACT_LOG variant of the
+// profile, which is used by profile bindings in Audit mode.
+func (sp *SeccompProfile) GetAuditProfilePath() string {
+	return auditProfileFile(sp.GetProfilePath())
+}
+
+// GetAuditLocalhostProfile returns the localhost profile reference of the
+// SCMP_ACT_LOG variant of the installed profile.
+func (sp *SeccompProfile) GetAuditLocalhostProfile() string {
+	return auditProfileFile(sp.Status.LocalhostProfile)
+}
+
+func auditProfileFile(profileFile string) string {
+	return strings.TrimSuffix(profileFile, ExtJSON) + profilebasev1.AuditProfileSuffix + ExtJSON
+}
+
 func (sp *SeccompProfile) GetProfileOperatorPath() string {
 	pfile := sp.GetProfileFile()
 
@@ -275,6 +399,33 @@ func (sp *SeccompProfile) IsReconcilable() bool {
 	return profilebasev1.IsReconcilable(sp)
 }
 
+// ValidateNotify checks that only rules denying syscalls match string
+// arguments. The seccomp agent cannot safely continue a syscall after
+// reading its arguments from the memory of the calling process.
+func (sp *SeccompProfile) ValidateNotify() error {
+	if sp.Spec.Notify == nil {
+		return nil
+	}
+
+	for i := range sp.Spec.Notify.Rules {
+		rule := &sp.Spec.Notify.Rules[i]
+		if rule.Action == NotifyActionDeny {
+			continue
+		}
+
+		for j := range rule.Args {
+			if len(rule.Args[j].Patterns) > 0 {
+				return fmt.Errorf(
+					"notify rule %d: patterns are only supported by rules with the %s action",
+					i, NotifyActionDeny,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
 // +kubebuilder:object:root=true
 
 // SeccompProfileList contains a list of SeccompProfile.
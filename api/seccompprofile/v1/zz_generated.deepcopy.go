@@ -44,6 +44,95 @@ func (in *Arg) DeepCopy() *Arg {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifyArg) DeepCopyInto(out *NotifyArg) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifyArg.
+func (in *NotifyArg) DeepCopy() *NotifyArg {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifyArg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifyPolicy) DeepCopyInto(out *NotifyPolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]NotifyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifyPolicy.
+func (in *NotifyPolicy) DeepCopy() *NotifyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifyRule) DeepCopyInto(out *NotifyRule) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]NotifyArg, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifyRule.
+func (in *NotifyRule) DeepCopy() *NotifyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedBaseProfile) DeepCopyInto(out *ResolvedBaseProfile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedBaseProfile.
+func (in *ResolvedBaseProfile) DeepCopy() *ResolvedBaseProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedBaseProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeccompProfile) DeepCopyInto(out *SeccompProfile) {
 	*out = *in
@@ -124,6 +213,11 @@ func (in *SeccompProfileSpec) DeepCopyInto(out *SeccompProfileSpec) {
 		*out = make([]Flag, len(*in))
 		copy(*out, *in)
 	}
+	if in.Notify != nil {
+		in, out := &in.Notify, &out.Notify
+		*out = new(NotifyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompProfileSpec.
@@ -145,6 +239,11 @@ func (in *SeccompProfileStatus) DeepCopyInto(out *SeccompProfileStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResolvedBaseProfiles != nil {
+		in, out := &in.ResolvedBaseProfiles, &out.ResolvedBaseProfiles
+		*out = make([]ResolvedBaseProfile, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompProfileStatus.
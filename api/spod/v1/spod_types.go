@@ -141,6 +141,13 @@ type SPODSpec struct {
 	// +optional
 	// +default=false
 	EnableAppArmor *bool `json:"enableAppArmor,omitempty"`
+	// enableSeccompAgent tells the operator whether or not to run the seccomp
+	// agent, which decides about syscalls with the SCMP_ACT_NOTIFY action
+	// based on the notify rules of seccomp profiles. Requires a container
+	// runtime which supports seccomp agents.
+	// +optional
+	// +default=false
+	EnableSeccompAgent *bool `json:"enableSeccompAgent,omitempty"`
 	// hostProcVolumePath is the path for specifying a custom host /proc
 	// volume, which is required for the log-enricher as well as bpf-recorder
 	// to retrieve the container ID for a process ID. This can be helpful for
@@ -229,6 +236,21 @@ type SPODEnricherConfig struct {
 	// +optional
 	// +default=false
 	EnableBpfRecorder *bool `json:"enableBpfRecorder,omitempty"`
+	// enableDriftDetection tells the operator whether or not to compare the
+	// behavior of workloads running with installed profiles against those
+	// profiles, and to report the operations which would have been denied in
+	// the profile status. Requires the log enricher to be enabled.
+	// +optional
+	// +default=false
+	EnableDriftDetection *bool `json:"enableDriftDetection,omitempty"`
+	// enableSeccompNotifyRecorder tells the operator whether or not to let
+	// the log enricher record seccomp profiles via seccomp user notifications
+	// instead of audit logs, for profile recordings setting seccompNotify.
+	// Requires the log enricher to be enabled and a container runtime which
+	// supports seccomp agents.
+	// +optional
+	// +default=false
+	EnableSeccompNotifyRecorder *bool `json:"enableSeccompNotifyRecorder,omitempty"`
 }
 
 // SPODWebhookConfig contains webhook configuration.
@@ -290,6 +312,85 @@ type SPODSecurityConfig struct {
 	// +optional
 	// +default=".*"
 	AllowedOidcIssuerRegexp string `json:"allowedOidcIssuerRegexp,omitempty"`
+
+	// signatureVerificationKeys references the public keys used to verify the
+	// signature of OCI images used to distribute the base profiles in the
+	// cluster. If set, the signature gets verified against these keys instead
+	// of the keyless certificate identity and Oidc issuer.
+	// +optional
+	SignatureVerificationKeys *SignatureVerificationKeysRef `json:"signatureVerificationKeys,omitempty"`
+
+	// ignoreTransparencyLog skips the verification of the transparency log
+	// entry of the OCI image signature, which allows verifying signatures in
+	// clusters without access to the transparency log.
+	// +optional
+	// +default=false
+	IgnoreTransparencyLog *bool `json:"ignoreTransparencyLog,omitempty"`
+
+	// requireBaseProfileDigest rejects remote base profiles which are not
+	// referenced by their digest, like
+	// `oci://ghcr.io/security-profiles/runc@sha256:…`.
+	// +optional
+	// +default=false
+	RequireBaseProfileDigest *bool `json:"requireBaseProfileDigest,omitempty"`
+
+	// ociRegistries configures the connection to OCI registries used to
+	// distribute the base profiles in the cluster, like local registries
+	// serving plain HTTP or using a custom certificate authority.
+	// +optional
+	// +listType=map
+	// +listMapKey=host
+	OCIRegistries []OCIRegistryConfig `json:"ociRegistries,omitempty"`
+}
+
+// OCIRegistryConfig configures the connection to a single OCI registry.
+type OCIRegistryConfig struct {
+	// host of the registry including an optional port, like
+	// "registry.local:5000".
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// plainHTTP connects to the registry via HTTP instead of HTTPS.
+	// +optional
+	// +default=false
+	PlainHTTP *bool `json:"plainHTTP,omitempty"`
+	// insecureSkipTLSVerify skips the verification of the registry TLS
+	// certificate.
+	// +optional
+	// +default=false
+	InsecureSkipTLSVerify *bool `json:"insecureSkipTLSVerify,omitempty"`
+	// caBundle references a key of a ConfigMap in the operator namespace
+	// containing PEM encoded CA certificates to verify the registry TLS
+	// certificate.
+	// +optional
+	CABundle *corev1.ConfigMapKeySelector `json:"caBundle,omitempty"`
+}
+
+// SignatureVerificationKeysKind is the kind of object containing the public
+// keys for verifying OCI image signatures.
+type SignatureVerificationKeysKind string
+
+const (
+	SignatureVerificationKeysKindSecret    SignatureVerificationKeysKind = "Secret"
+	SignatureVerificationKeysKindConfigMap SignatureVerificationKeysKind = "ConfigMap"
+)
+
+// SignatureVerificationKeysRef references a Secret or ConfigMap in the
+// operator namespace containing PEM encoded public keys.
+type SignatureVerificationKeysRef struct {
+	// kind of the referenced object, either Secret or ConfigMap.
+	// +required
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind SignatureVerificationKeysKind `json:"kind,omitempty"`
+	// name of the referenced object in the operator namespace.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+	// key within the data of the referenced object. The public keys of all
+	// keys are used if not set. Each value may contain multiple PEM encoded
+	// public keys, where the signature has to be verified by one of them.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // SPODState defines the state that the spod is in.
@@ -68,6 +68,36 @@ func (in *JsonEnricherOptions) DeepCopy() *JsonEnricherOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistryConfig) DeepCopyInto(out *OCIRegistryConfig) {
+	*out = *in
+	if in.PlainHTTP != nil {
+		in, out := &in.PlainHTTP, &out.PlainHTTP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InsecureSkipTLSVerify != nil {
+		in, out := &in.InsecureSkipTLSVerify, &out.InsecureSkipTLSVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistryConfig.
+func (in *OCIRegistryConfig) DeepCopy() *OCIRegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SPODEnricherConfig) DeepCopyInto(out *SPODEnricherConfig) {
 	*out = *in
@@ -91,6 +121,16 @@ func (in *SPODEnricherConfig) DeepCopyInto(out *SPODEnricherConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnableDriftDetection != nil {
+		in, out := &in.EnableDriftDetection, &out.EnableDriftDetection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSeccompNotifyRecorder != nil {
+		in, out := &in.EnableSeccompNotifyRecorder, &out.EnableSeccompNotifyRecorder
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPODEnricherConfig.
@@ -148,6 +188,28 @@ func (in *SPODSecurityConfig) DeepCopyInto(out *SPODSecurityConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SignatureVerificationKeys != nil {
+		in, out := &in.SignatureVerificationKeys, &out.SignatureVerificationKeys
+		*out = new(SignatureVerificationKeysRef)
+		**out = **in
+	}
+	if in.IgnoreTransparencyLog != nil {
+		in, out := &in.IgnoreTransparencyLog, &out.IgnoreTransparencyLog
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireBaseProfileDigest != nil {
+		in, out := &in.RequireBaseProfileDigest, &out.RequireBaseProfileDigest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OCIRegistries != nil {
+		in, out := &in.OCIRegistries, &out.OCIRegistries
+		*out = make([]OCIRegistryConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPODSecurityConfig.
@@ -204,6 +266,11 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnableSeccompAgent != nil {
+		in, out := &in.EnableSeccompAgent, &out.EnableSeccompAgent
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]corev1.LocalObjectReference, len(*in))
@@ -368,6 +435,21 @@ func (in *SelinuxOptions) DeepCopy() *SelinuxOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureVerificationKeysRef) DeepCopyInto(out *SignatureVerificationKeysRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerificationKeysRef.
+func (in *SignatureVerificationKeysRef) DeepCopy() *SignatureVerificationKeysRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureVerificationKeysRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookOptions) DeepCopyInto(out *WebhookOptions) {
 	*out = *in
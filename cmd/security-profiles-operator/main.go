@@ -68,13 +68,17 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profiledrift"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompagent"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/selinuxprofile"
 	nodestatus "sigs.k8s.io/security-profiles-operator/internal/pkg/manager/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingbinder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
@@ -95,6 +99,7 @@ const (
 	spodControllerFlag           string = "with-spod-controller"
 	workloadAnnotatorFlag        string = "with-workload-annotator"
 	recordingMergerFlag          string = "with-recording-merger"
+	recordingBinderFlag          string = "with-recording-binder"
 	recordingFlag                string = "with-recording"
 	seccompFlag                  string = "with-seccomp"
 	selinuxFlag                  string = "with-selinux"
@@ -102,17 +107,19 @@ const (
 	rawSelinuxFlag               string = "with-raw-selinux"
 	webhookFlag                  string = "webhook"
 	memOptimFlag                 string = "with-mem-optim"
+	driftDetectionFlag           string = "with-drift-detection"
 	defaultWebhookPort           int    = 9443
 	auditLogIntervalSecondsParam string = "audit-log-interval-seconds"
 	auditLogPathParam            string = "audit-log-path"
 	auditLogMaxSizeParam         string = "audit-log-maxsize"
 	// The plural form is not used for audit-log-file-maxbackup to match the k8s api server audit log options.
-	auditLogMaxBackupParam   string = "audit-log-maxbackup"
-	auditLogMaxAgeParam      string = "audit-log-maxage"
-	enricherFiltersJsonParam string = "enricher-filters-json"
-	enricherLogSourceParam   string = "enricher-log-source"
-	tlsMinVersionParam       string = "tls-min-version"
-	defaultTlsMinVersion     string = "1.2"
+	auditLogMaxBackupParam     string = "audit-log-maxbackup"
+	auditLogMaxAgeParam        string = "audit-log-maxage"
+	enricherFiltersJsonParam   string = "enricher-filters-json"
+	enricherLogSourceParam     string = "enricher-log-source"
+	seccompNotifyRecorderParam string = "seccomp-notify-recorder"
+	tlsMinVersionParam         string = "tls-min-version"
+	defaultTlsMinVersion       string = "1.2"
 )
 
 var (
@@ -182,6 +189,11 @@ func main() {
 					Value: true,
 					Usage: "Enable the recording merger.",
 				},
+				&cli.BoolFlag{
+					Name:  recordingBinderFlag,
+					Value: true,
+					Usage: "Enable the recording binder.",
+				},
 			},
 		},
 		&cli.Command{
@@ -224,6 +236,11 @@ func main() {
 					Usage: "Enable memory optimization by watching only labeled pods",
 					Value: false,
 				},
+				&cli.BoolFlag{
+					Name:  driftDetectionFlag,
+					Usage: "Report operations observed by the log enricher which are not covered by the installed profiles",
+					Value: false,
+				},
 			},
 		},
 		&cli.Command{
@@ -292,6 +309,11 @@ func main() {
 					Value: "",
 					Usage: "Log source to ingest (`Bpf` or `Auditd`)",
 				},
+				&cli.BoolFlag{
+					Name:  seccompNotifyRecorderParam,
+					Value: false,
+					Usage: "Record seccomp profiles via seccomp user notifications.",
+				},
 			},
 			Action: func(ctx *cli.Context) error {
 				return runLogEnricher(ctx, info)
@@ -370,6 +392,15 @@ func main() {
 				return runBPFRecorder(ctx, info)
 			},
 		},
+		&cli.Command{
+			Before:  initialize,
+			Name:    "seccomp-agent",
+			Aliases: []string{"a"},
+			Usage:   "run the seccomp agent",
+			Action: func(ctx *cli.Context) error {
+				return runSeccompAgent(ctx, info)
+			},
+		},
 		&cli.Command{
 			Name:     spocCmd,
 			Aliases:  []string{"s"},
@@ -377,6 +408,16 @@ func main() {
 			Action:   runCLI,
 			HideHelp: true,
 		},
+		&cli.Command{
+			Name:            crd2armor.SandboxCommand,
+			Usage:           "run apparmor_parser in a sandbox, used by the operator itself",
+			Hidden:          true,
+			HideHelp:        true,
+			SkipFlagParsing: true,
+			Action: func(ctx *cli.Context) error {
+				return crd2armor.RunSandboxed(ctx.Args().Slice())
+			},
+		},
 	)
 
 	app.Flags = []cli.Flag{
@@ -563,6 +604,10 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 		enabledControllers = append(enabledControllers, recordingmerger.NewController())
 	}
 
+	if ctx.Bool(recordingBinderFlag) {
+		enabledControllers = append(enabledControllers, recordingbinder.NewController())
+	}
+
 	setupLog.Info("enabled controllers", "controllers", enabledControllers)
 
 	if err := setupEnabledControllers(
@@ -643,6 +688,10 @@ func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 		controllers = append(controllers, apparmorprofile.NewController())
 	}
 
+	if ctx.Bool(driftDetectionFlag) {
+		controllers = append(controllers, profiledrift.NewController())
+	}
+
 	return controllers
 }
 
@@ -758,7 +807,15 @@ func runBPFRecorder(_ *cli.Context, info *version.Info) error {
 
 	printInfo(component, info)
 
-	return bpfrecorder.New("", ctrl.Log.WithName(component), true, true).Run()
+	return bpfrecorder.New("", ctrl.Log.WithName(component), true, true, true).Run()
+}
+
+func runSeccompAgent(_ *cli.Context, info *version.Info) error {
+	const component = "seccomp-agent"
+
+	printInfo(component, info)
+
+	return seccompagent.New(ctrl.Log.WithName(component)).Run()
 }
 
 func runLogEnricher(ctx *cli.Context, info *version.Info) error {
@@ -767,8 +824,9 @@ func runLogEnricher(ctx *cli.Context, info *version.Info) error {
 	printInfo(component, info)
 
 	opts := &enricher.LogEnricherOptions{
-		EnricherFiltersJson: ctx.String(enricherFiltersJsonParam),
-		AuditSource:         ctx.String(enricherLogSourceParam),
+		EnricherFiltersJson:   ctx.String(enricherFiltersJsonParam),
+		AuditSource:           ctx.String(enricherLogSourceParam),
+		SeccompNotifyRecorder: ctx.Bool(seccompNotifyRecorderParam),
 	}
 
 	logEnricher, err := enricher.New(ctrl.Log.WithName(component), opts)
@@ -929,7 +987,7 @@ func runWebhook(ctx *cli.Context, info *version.Info) error {
 	setupLog.Info("registering webhooks")
 
 	hookserver := mgr.GetWebhookServer()
-	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient())
+	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetEventRecorderFor("binding-webhook"), mgr.GetClient())
 
 	//nolint:staticcheck,nolintlint // TODO: migrate to GetEventRecorder
 	recording.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetEventRecorderFor("recording-webhook"), mgr.GetClient())
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -28,6 +29,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/cmd"
 	spocli "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/converter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/differ"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/installer"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/merger"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/puller"
@@ -117,6 +119,27 @@ func main() {
 				},
 			},
 		},
+		&cli.Command{
+			Name:    "diff",
+			Aliases: []string{"d"},
+			Usage:   "show the semantic differences between two security profiles",
+			Description: "Compare two security profiles of the same kind. " +
+				"Exits with 0 if both profiles are equal, with 1 if they differ and with 2 on failure.",
+			Action:    diff,
+			ArgsUsage: "FROM TO",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    differ.FlagOutputFormat,
+					Aliases: []string{"f"},
+					Usage:   "the output format of the diff",
+					DefaultText: fmt.Sprintf(
+						"%s [alternative: %s]",
+						differ.OutputFormatText,
+						differ.OutputFormatJSON,
+					),
+				},
+			},
+		},
 		&cli.Command{
 			Name:      "convert",
 			Aliases:   []string{"c"},
@@ -144,6 +167,28 @@ func main() {
 			Usage:     "install a security profile on the local machine",
 			Action:    install,
 			ArgsUsage: "PROFILE EXECUTABLE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        installer.FlagSeccompProfileDir,
+					Usage:       "seccomp only: the directory to install the profile into",
+					DefaultText: installer.DefaultSeccompProfileDir,
+					TakesFile:   true,
+				},
+				&cli.BoolFlag{
+					Name:  installer.FlagDisableSignatureVerification,
+					Usage: "seccomp only: disable signature verification of base profiles from OCI registries",
+				},
+				&cli.BoolFlag{
+					Name:    installer.FlagPlainHTTP,
+					EnvVars: []string{"PLAIN_HTTP"},
+					Usage:   "seccomp only: connect to the registry of base profiles via HTTP instead of HTTPS",
+				},
+				&cli.BoolFlag{
+					Name:    installer.FlagInsecure,
+					EnvVars: []string{"INSECURE"},
+					Usage:   "seccomp only: skip the verification of the registry TLS certificate",
+				},
+			},
 		},
 		&cli.Command{
 			Name:      "remove",
@@ -151,6 +196,14 @@ func main() {
 			Usage:     "remove a security profile from the local machine",
 			Action:    remove,
 			ArgsUsage: "PROFILE EXECUTABLE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        installer.FlagSeccompProfileDir,
+					Usage:       "seccomp only: the directory to remove the profile from",
+					DefaultText: installer.DefaultSeccompProfileDir,
+					TakesFile:   true,
+				},
+			},
 		},
 		&cli.Command{
 			Name:      "run",
@@ -160,10 +213,15 @@ func main() {
 			ArgsUsage: "COMMAND",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:        runner.FlagType,
-					Aliases:     []string{"t"},
-					Usage:       "the run type",
-					DefaultText: string(runner.TypeSeccomp),
+					Name:    runner.FlagType,
+					Aliases: []string{"t"},
+					Usage:   "the run type",
+					DefaultText: fmt.Sprintf(
+						"%s [alternative: %s %s]",
+						runner.TypeSeccomp,
+						runner.TypeApparmor,
+						runner.TypeSelinux,
+					),
 				},
 				&cli.StringFlag{
 					Name:        runner.FlagProfile,
@@ -177,7 +235,7 @@ func main() {
 		&cli.Command{
 			Name:      "push",
 			Aliases:   []string{"p"},
-			Usage:     "push a profile to a container registry",
+			Usage:     "push a profile to a container registry or OCI layout",
 			Action:    push,
 			ArgsUsage: "FILE",
 			Flags: []cli.Flag{
@@ -207,12 +265,28 @@ func main() {
 					Aliases: []string{"p"},
 					Usage:   "the platforms to be used in format: os[/arch][/variant][:os_version]",
 				},
+				&cli.BoolFlag{
+					Name:    pusher.FlagPlainHTTP,
+					EnvVars: []string{"PLAIN_HTTP"},
+					Usage:   "connect to the registry via HTTP instead of HTTPS",
+				},
+				&cli.BoolFlag{
+					Name:    pusher.FlagInsecure,
+					EnvVars: []string{"INSECURE"},
+					Usage:   "skip the verification of the registry TLS certificate",
+				},
+				&cli.StringFlag{
+					Name:      pusher.FlagCAFile,
+					EnvVars:   []string{"CA_FILE"},
+					Usage:     "file with PEM encoded CA certificates to verify the registry TLS certificate",
+					TakesFile: true,
+				},
 			},
 		},
 		&cli.Command{
 			Name:      "pull",
 			Aliases:   []string{"l"},
-			Usage:     "pull a profile from a container registry",
+			Usage:     "pull a profile from a container registry or OCI layout",
 			Action:    pull,
 			ArgsUsage: "IMAGE",
 			Flags: []cli.Flag{
@@ -255,6 +329,34 @@ func main() {
 					EnvVars: []string{"ALLOWED_OIDC_ISSUER_REGEXP"},
 					Usage:   "regexp for allowed Oidc issuer in signature verification",
 				},
+				&cli.StringFlag{
+					Name:      puller.FlagPublicKey,
+					Aliases:   []string{"k"},
+					EnvVars:   []string{"PUBLIC_KEY"},
+					Usage:     "file with PEM encoded public keys to verify the signature instead of keyless",
+					TakesFile: true,
+				},
+				&cli.BoolFlag{
+					Name:    puller.FlagIgnoreTlog,
+					EnvVars: []string{"IGNORE_TLOG"},
+					Usage:   "skip the transparency log verification of the signature",
+				},
+				&cli.BoolFlag{
+					Name:    puller.FlagPlainHTTP,
+					EnvVars: []string{"PLAIN_HTTP"},
+					Usage:   "connect to the registry via HTTP instead of HTTPS",
+				},
+				&cli.BoolFlag{
+					Name:    puller.FlagInsecure,
+					EnvVars: []string{"INSECURE"},
+					Usage:   "skip the verification of the registry TLS certificate",
+				},
+				&cli.StringFlag{
+					Name:      puller.FlagCAFile,
+					EnvVars:   []string{"CA_FILE"},
+					Usage:     "file with PEM encoded CA certificates to verify the registry TLS certificate",
+					TakesFile: true,
+				},
 			},
 		},
 	)
@@ -292,6 +394,24 @@ func merge(ctx *cli.Context) error {
 	return nil
 }
 
+// diff runs the `spoc diff` subcommand.
+func diff(ctx *cli.Context) error {
+	options, err := differ.FromContext(ctx)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("build options: %v", err), differ.ExitCodeError)
+	}
+
+	if err := differ.New(options).Run(); err != nil {
+		if errors.Is(err, differ.ErrProfilesDiffer) {
+			return cli.Exit("", differ.ExitCodeDifferent)
+		}
+
+		return cli.Exit(fmt.Sprintf("launch differ: %v", err), differ.ExitCodeError)
+	}
+
+	return nil
+}
+
 // convert runs the `spoc convert` subcommand.
 func convert(ctx *cli.Context) error {
 	options, err := converter.FromContext(ctx)
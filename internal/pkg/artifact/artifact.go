@@ -18,6 +18,7 @@ package artifact
 
 import (
 	"context"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"maps"
@@ -32,9 +33,8 @@ import (
 	"github.com/sigstore/cosign/v2/cmd/cosign/cli/options"
 	"github.com/sigstore/cosign/v2/cmd/cosign/cli/verify"
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
 	"oras.land/oras-go/v2/registry/remote"
-	"oras.land/oras-go/v2/registry/remote/auth"
-	"oras.land/oras-go/v2/registry/remote/retry"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
@@ -52,6 +52,7 @@ type PullResult struct {
 	apparmorProfile *apparmorprofileapi.AppArmorProfile
 
 	content []byte
+	digest  digest.Digest
 }
 
 // Type returns the PullResultType of the PullResult.
@@ -79,6 +80,11 @@ func (p *PullResult) Content() []byte {
 	return p.content
 }
 
+// Digest returns the digest the pulled reference resolved to.
+func (p *PullResult) Digest() digest.Digest {
+	return p.digest
+}
+
 // Artifact is the main structure of this package.
 type Artifact struct {
 	impl
@@ -95,6 +101,13 @@ type PullSignatureOptions struct {
 
 	// AllowedOidcIssuerRegexp regexp for allowed Oidc issuer for signature verification.
 	AllowedOidcIssuerRegexp string
+
+	// PublicKeys are one or more PEM encoded public keys. If set, the signature
+	// has to be verified by one of them instead of keyless verification.
+	PublicKeys []byte
+
+	// IgnoreTlog skips the transparency log verification of the signature.
+	IgnoreTlog bool
 }
 
 // New returns a new Artifact instance.
@@ -105,11 +118,12 @@ func New(logger logr.Logger) *Artifact {
 	}
 }
 
-// Push a profile to a remote location.
+// Push a profile to a remote location or a local OCI layout.
 func (a *Artifact) Push(
 	files map[*v1.Platform]string,
 	to, username, password string,
 	annotations map[string]string,
+	regOpts *RegistryOptions,
 ) error {
 	dir, err := a.MkdirTemp("", "push-")
 	if err != nil {
@@ -183,9 +197,13 @@ func (a *Artifact) Push(
 		return fmt.Errorf("pack files: %w", err)
 	}
 
+	if layout, tag, ok := parseOCILayoutReference(to); ok {
+		return a.pushOCILayout(ctx, store, manifestDescriptor, layout, tag)
+	}
+
 	a.logger.Info("Verifying reference: " + to)
 
-	parsedRef, err := a.ParseReference(to)
+	parsedRef, err := a.ParseReference(to, regOpts.nameOptions()...)
 	if err != nil {
 		return fmt.Errorf("parse reference: %w", err)
 	}
@@ -201,24 +219,11 @@ func (a *Artifact) Push(
 	ref := parsedRef.Context().Name()
 	a.logger.Info("Creating repository for " + ref)
 
-	repo, err := a.NewRepository(ref)
+	repo, err := a.newRepository(ref, username, password, regOpts)
 	if err != nil {
 		return fmt.Errorf("create repository: %w", err)
 	}
 
-	if username != "" && password != "" {
-		a.logger.Info("Using username and password")
-
-		repo.Client = &auth.Client{
-			Client: retry.DefaultClient,
-			Cache:  auth.DefaultCache,
-			Credential: auth.StaticCredential(
-				repo.Reference.Registry,
-				auth.Credential{Username: username, Password: password},
-			),
-		}
-	}
-
 	a.logger.Info("Copying profile to repository")
 
 	descriptor, err := a.Copy(ctx, store, tag, repo, tag, oras.DefaultCopyOptions)
@@ -226,12 +231,18 @@ func (a *Artifact) Push(
 		return fmt.Errorf("copy to repository: %w", err)
 	}
 
+	registryOpts, err := a.cosignRegistryOptions(dir, regOpts)
+	if err != nil {
+		return fmt.Errorf("get registry options: %w", err)
+	}
+
 	a.logger.Info("Signing OCI artifact")
 
 	o := &options.SignOptions{
 		Upload:           true,
 		TlogUpload:       true,
 		SkipConfirmation: true,
+		Registry:         registryOpts,
 		Rekor:            options.RekorOptions{URL: options.DefaultRekorURL},
 		Fulcio:           options.FulcioOptions{URL: options.DefaultFulcioURL},
 		OIDC: options.OIDCOptions{
@@ -275,29 +286,47 @@ func (a *Artifact) Push(
 	return nil
 }
 
-// Pull a profile from a remote location.
+// pushOCILayout copies the packed profiles from the store into the local OCI
+// layout. Signing is not supported for OCI layouts, which means that the
+// signature verification has to be disabled when pulling the profiles again.
+func (a *Artifact) pushOCILayout(
+	ctx context.Context, store *file.Store, manifestDescriptor v1.Descriptor, layout, tag string,
+) error {
+	a.logger.Info("Using tag: " + tag)
+
+	if err := a.StoreTag(ctx, store, manifestDescriptor, tag); err != nil {
+		return fmt.Errorf("creating tag: %w", err)
+	}
+
+	a.logger.Info("Creating OCI layout in " + layout)
+
+	dst, err := a.NewOCILayout(layout)
+	if err != nil {
+		return fmt.Errorf("create OCI layout: %w", err)
+	}
+
+	a.logger.Info("Copying profile to OCI layout")
+
+	if _, err := a.Copy(ctx, store, tag, dst, tag, oras.DefaultCopyOptions); err != nil {
+		return fmt.Errorf("copy to OCI layout: %w", err)
+	}
+
+	a.logger.Info("Skipping signing, which is not supported for OCI layouts")
+
+	return nil
+}
+
+// Pull a profile from a remote location or a local OCI layout.
 func (a *Artifact) Pull(
 	c context.Context,
 	from, username, password string,
 	platform *v1.Platform,
 	signOpts *PullSignatureOptions,
+	regOpts *RegistryOptions,
 ) (*PullResult, error) {
 	ctx, cancel := context.WithTimeout(c, defaultTimeout)
 	defer cancel()
 
-	originalImage := from
-
-	a.logger.Info("Resolving digest of image: " + originalImage)
-
-	// Retrieve the immutable image digest before doing any verification to
-	// prevent a TOCTOU attack on the mutable tag of the base image, which
-	// might lead to a malicious base profile being injected between
-	// verification and copying the content.
-	from, repo, sha, err := a.imageWithDigest(ctx, originalImage, username, password)
-	if err != nil {
-		return nil, fmt.Errorf("resolving digest for image %q: %w", originalImage, err)
-	}
-
 	if signOpts == nil {
 		signOpts = &PullSignatureOptions{
 			AllowedIdentityRegexp:   allowAllRegexp,
@@ -305,18 +334,52 @@ func (a *Artifact) Pull(
 		}
 	}
 
-	if !signOpts.DisableSignatureVerification {
-		a.logger.Info("Verifying signature")
+	var (
+		src oras.ReadOnlyTarget
+		sha digest.Digest
+	)
 
-		v := verify.VerifyCommand{
-			CertVerifyOptions: options.CertVerifyOptions{
-				CertIdentityRegexp:   signOpts.AllowedIdentityRegexp,
-				CertOidcIssuerRegexp: signOpts.AllowedOidcIssuerRegexp,
-			},
+	if layout, reference, ok := parseOCILayoutReference(from); ok {
+		if !signOpts.DisableSignatureVerification {
+			return nil, ErrOCILayoutSignatureVerification
 		}
-		if err := a.VerifyCmd(ctx, v, from); err != nil {
-			return nil, fmt.Errorf("verify signature: %w", err)
+
+		a.logger.Info("Opening OCI layout: " + layout)
+
+		store, err := a.OpenOCILayout(ctx, layout)
+		if err != nil {
+			return nil, fmt.Errorf("open OCI layout %q: %w", layout, err)
 		}
+
+		desc, err := a.ResolveOCILayout(ctx, store, reference)
+		if err != nil {
+			return nil, fmt.Errorf("resolving reference %q in OCI layout: %w", reference, err)
+		}
+
+		src, sha = store, desc.Digest
+	} else {
+		originalImage := from
+
+		a.logger.Info("Resolving digest of image: " + originalImage)
+
+		// Retrieve the immutable image digest before doing any verification to
+		// prevent a TOCTOU attack on the mutable tag of the base image, which
+		// might lead to a malicious base profile being injected between
+		// verification and copying the content.
+		image, repo, imageSHA, err := a.imageWithDigest(ctx, originalImage, username, password, regOpts)
+		if err != nil {
+			return nil, fmt.Errorf("resolving digest for image %q: %w", originalImage, err)
+		}
+
+		if !signOpts.DisableSignatureVerification {
+			a.logger.Info("Verifying signature")
+
+			if err := a.verifySignature(ctx, image, signOpts, regOpts); err != nil {
+				return nil, fmt.Errorf("verify signature: %w", err)
+			}
+		}
+
+		from, src, sha = image, repo, imageSHA
 	}
 
 	dir, err := a.MkdirTemp("", "pull-")
@@ -347,7 +410,7 @@ func (a *Artifact) Pull(
 	a.logger.Info("Source image", "image", from)
 
 	if _, err := a.Copy(
-		ctx, repo, sha.String(), store, sha.String(), oras.DefaultCopyOptions,
+		ctx, src, sha.String(), store, sha.String(), oras.DefaultCopyOptions,
 	); err != nil {
 		return nil, fmt.Errorf("copy from repository: %w", err)
 	}
@@ -381,54 +444,133 @@ func (a *Artifact) Pull(
 			typ:            PullResultTypeSeccompProfile,
 			seccompProfile: obj,
 			content:        content,
+			digest:         sha,
 		}, nil
 	case *selinuxprofileapi.SelinuxProfile:
 		return &PullResult{
 			typ:            PullResultTypeSelinuxProfile,
 			selinuxProfile: obj,
 			content:        content,
+			digest:         sha,
 		}, nil
 	case *apparmorprofileapi.AppArmorProfile:
 		return &PullResult{
 			typ:             PullResultTypeAppArmorProfile,
 			apparmorProfile: obj,
 			content:         content,
+			digest:          sha,
 		}, nil
 	default:
 		return nil, fmt.Errorf("cannot process %T to PullResult", obj)
 	}
 }
 
+// verifySignature verifies the signature of the image either against the
+// provided public keys or keyless against the certificate identity and issuer.
+func (a *Artifact) verifySignature(
+	ctx context.Context, image string, signOpts *PullSignatureOptions, regOpts *RegistryOptions,
+) error {
+	dir, err := a.MkdirTemp("", "verify-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+
+	defer func() {
+		if err := a.RemoveAll(dir); err != nil {
+			a.logger.Info("Unable to remove temp dir: " + err.Error())
+		}
+	}()
+
+	registryOpts, err := a.cosignRegistryOptions(dir, regOpts)
+	if err != nil {
+		return fmt.Errorf("get registry options: %w", err)
+	}
+
+	if len(signOpts.PublicKeys) == 0 {
+		return a.VerifyCmd(ctx, verify.VerifyCommand{
+			RegistryOptions: registryOpts,
+			CertVerifyOptions: options.CertVerifyOptions{
+				CertIdentityRegexp:   signOpts.AllowedIdentityRegexp,
+				CertOidcIssuerRegexp: signOpts.AllowedOidcIssuerRegexp,
+			},
+			IgnoreTlog:  signOpts.IgnoreTlog,
+			NameOptions: regOpts.nameOptions(),
+		}, image)
+	}
+
+	keys, err := splitPublicKeys(signOpts.PublicKeys)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0, len(keys))
+
+	for i, key := range keys {
+		keyRef := filepath.Join(dir, fmt.Sprintf("key-%d.pem", i))
+		if err := a.WriteFile(keyRef, key, keyFileMode); err != nil {
+			return fmt.Errorf("write public key: %w", err)
+		}
+
+		// Offline verifies a possible transparency log entry against the
+		// bundle stored with the signature instead of querying the log.
+		err := a.VerifyCmd(ctx, verify.VerifyCommand{
+			RegistryOptions: registryOpts,
+			KeyRef:          keyRef,
+			IgnoreTlog:      signOpts.IgnoreTlog,
+			Offline:         true,
+			NameOptions:     regOpts.nameOptions(),
+		}, image)
+		if err == nil {
+			a.logger.Info("Signature verified by public key", "index", i)
+
+			return nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrNoMatchingPublicKey, errors.Join(errs...))
+}
+
+// splitPublicKeys splits the PEM encoded public keys into separate ones.
+func splitPublicKeys(data []byte) ([][]byte, error) {
+	keys := [][]byte{}
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		keys = append(keys, pem.EncodeToMemory(block))
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrNoPublicKey
+	}
+
+	return keys, nil
+}
+
 // imageWithDigest transforms the given image into an image with digest instead of a tag.
 // It retrieves the digest from the remote repository. Returns the updated image with
 // digest and the repository and the digest as separate return arguments.
-func (a *Artifact) imageWithDigest(ctx context.Context, image, username, password string) (
+func (a *Artifact) imageWithDigest(ctx context.Context, image, username, password string, regOpts *RegistryOptions) (
 	string, *remote.Repository, digest.Digest, error,
 ) {
-	ref, err := a.ParseReference(image)
+	ref, err := a.ParseReference(image, regOpts.nameOptions()...)
 	if err != nil {
 		return "", nil, "", fmt.Errorf("parsing ref for image %q: %w", image, err)
 	}
 
-	repo, err := a.NewRepository(ref.Context().Name())
+	repo, err := a.newRepository(ref.Context().Name(), username, password, regOpts)
 	if err != nil {
 		return "", nil, "", fmt.Errorf("creating repository for %q: %w",
 			ref.Name(), err)
 	}
 
-	if username != "" && password != "" {
-		a.logger.Info("Using username and password")
-
-		repo.Client = &auth.Client{
-			Client: retry.DefaultClient,
-			Cache:  auth.DefaultCache,
-			Credential: auth.StaticCredential(
-				repo.Reference.Registry,
-				auth.Credential{Username: username, Password: password},
-			),
-		}
-	}
-
 	desc, err := a.ResolveRepository(ctx, repo, ref.Identifier())
 	if err != nil {
 		return "", nil, "",
@@ -17,14 +17,17 @@ limitations under the License.
 package artifact
 
 import (
+	"encoding/pem"
 	"errors"
 	"runtime"
+	"slices"
 	"testing"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/name"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content/oci"
 	"oras.land/oras-go/v2/registry/remote"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
@@ -214,6 +217,7 @@ func TestPush(t *testing.T) {
 				"foo",
 				"bar",
 				map[string]string{"foo": "bar"},
+				nil,
 			)
 			assert(err)
 		})
@@ -427,8 +431,247 @@ func TestPull(t *testing.T) {
 			sut := New(logr.Discard())
 			sut.impl = mock
 
-			res, err := sut.Pull(t.Context(), "", "foo", "bar", nil, &PullSignatureOptions{})
+			res, err := sut.Pull(t.Context(), "", "foo", "bar", nil, &PullSignatureOptions{}, nil)
 			assert(res, err)
 		})
 	}
 }
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	testKey := func(content string) []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte(content)})
+	}
+	keys := slices.Concat(testKey("first"), testKey("second"))
+
+	for _, tc := range []struct {
+		name     string
+		signOpts *PullSignatureOptions
+		regOpts  *RegistryOptions
+		prepare  func(*artifactfakes.FakeImpl)
+		assert   func(*artifactfakes.FakeImpl, error)
+	}{
+		{
+			name: "success keyless",
+			signOpts: &PullSignatureOptions{
+				AllowedIdentityRegexp:   "identity",
+				AllowedOidcIssuerRegexp: "issuer",
+				IgnoreTlog:              true,
+			},
+			prepare: func(*artifactfakes.FakeImpl) {},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.VerifyCmdCallCount())
+				_, cmd, image := mock.VerifyCmdArgsForCall(0)
+				require.Equal(t, "image", image)
+				require.Empty(t, cmd.KeyRef)
+				require.Equal(t, "identity", cmd.CertIdentityRegexp)
+				require.Equal(t, "issuer", cmd.CertOidcIssuerRegexp)
+				require.True(t, cmd.IgnoreTlog)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name:     "success second public key",
+			signOpts: &PullSignatureOptions{PublicKeys: keys},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.MkdirTempReturns("dir", nil)
+				mock.VerifyCmdReturnsOnCall(0, errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.WriteFileCallCount())
+				name, content, _ := mock.WriteFileArgsForCall(1)
+				require.Equal(t, "dir/key-1.pem", name)
+				require.Equal(t, testKey("second"), content)
+
+				require.Equal(t, 2, mock.VerifyCmdCallCount())
+				_, cmd, _ := mock.VerifyCmdArgsForCall(1)
+				require.Equal(t, "dir/key-1.pem", cmd.KeyRef)
+				require.True(t, cmd.Offline)
+				require.False(t, cmd.IgnoreTlog)
+				require.Equal(t, 1, mock.RemoveAllCallCount())
+			},
+		},
+		{
+			name:     "success keyless with registry options",
+			signOpts: &PullSignatureOptions{},
+			regOpts: &RegistryOptions{
+				PlainHTTP:             true,
+				InsecureSkipTLSVerify: true,
+				CABundle:              []byte("ca"),
+			},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.MkdirTempReturns("dir", nil)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+				name, content, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, "dir/ca.pem", name)
+				require.Equal(t, []byte("ca"), content)
+
+				_, cmd, _ := mock.VerifyCmdArgsForCall(0)
+				require.Equal(t, "dir/ca.pem", cmd.RegistryCACert)
+				require.True(t, cmd.AllowHTTPRegistry)
+				require.True(t, cmd.AllowInsecure)
+				require.Len(t, cmd.NameOptions, 1)
+			},
+		},
+		{
+			name:     "failure no public key verifies",
+			signOpts: &PullSignatureOptions{PublicKeys: keys},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.VerifyCmdReturns(errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, ErrNoMatchingPublicKey)
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 2, mock.VerifyCmdCallCount())
+			},
+		},
+		{
+			name:     "failure no PEM encoded public key",
+			signOpts: &PullSignatureOptions{PublicKeys: []byte("invalid")},
+			prepare:  func(*artifactfakes.FakeImpl) {},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, ErrNoPublicKey)
+				require.Zero(t, mock.VerifyCmdCallCount())
+			},
+		},
+		{
+			name:     "failure on WriteFile",
+			signOpts: &PullSignatureOptions{PublicKeys: keys},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.VerifyCmdCallCount())
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+		signOpts := tc.signOpts
+		regOpts := tc.regOpts
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &artifactfakes.FakeImpl{}
+			prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+
+			assert(mock, sut.verifySignature(t.Context(), "image", signOpts, regOpts))
+		})
+	}
+}
+
+func TestPushOCILayout(t *testing.T) {
+	t.Parallel()
+
+	mock := &artifactfakes.FakeImpl{}
+	mock.NewOCILayoutReturns(&oci.Store{}, nil)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+
+	err := sut.Push(
+		map[*ocispec.Platform]string{{OS: runtime.GOOS}: "test"},
+		"oci-layout:///tmp/layout:v1", "", "", nil, nil,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "/tmp/layout", mock.NewOCILayoutArgsForCall(0))
+	_, _, _, tag := mock.StoreTagArgsForCall(0)
+	require.Equal(t, "v1", tag)
+	require.Zero(t, mock.ParseReferenceCallCount())
+	require.Zero(t, mock.NewRepositoryCallCount())
+	require.Zero(t, mock.SignCmdCallCount())
+}
+
+func TestPullOCILayout(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		signOpts *PullSignatureOptions
+		prepare  func(*artifactfakes.FakeImpl)
+		assert   func(*artifactfakes.FakeImpl, *PullResult, error)
+	}{
+		{
+			name:     "success",
+			signOpts: &PullSignatureOptions{DisableSignatureVerification: true},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.ResolveOCILayoutReturns(ocispec.Descriptor{Digest: "sha256:123"}, nil)
+				mock.ReadProfileReturns(&seccompprofileapi.SeccompProfile{}, nil)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, res *PullResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, PullResultTypeSeccompProfile, res.Type())
+
+				_, path := mock.OpenOCILayoutArgsForCall(0)
+				require.Equal(t, "/tmp/layout", path)
+				_, _, reference := mock.ResolveOCILayoutArgsForCall(0)
+				require.Equal(t, "v1", reference)
+				_, _, srcRef, _, _, _ := mock.CopyArgsForCall(0)
+				require.Equal(t, "sha256:123", srcRef)
+				require.Zero(t, mock.NewRepositoryCallCount())
+				require.Zero(t, mock.VerifyCmdCallCount())
+			},
+		},
+		{
+			name:     "failure with signature verification",
+			signOpts: &PullSignatureOptions{},
+			prepare:  func(*artifactfakes.FakeImpl) {},
+			assert: func(mock *artifactfakes.FakeImpl, res *PullResult, err error) {
+				require.ErrorIs(t, err, ErrOCILayoutSignatureVerification)
+				require.Nil(t, res)
+				require.Zero(t, mock.OpenOCILayoutCallCount())
+			},
+		},
+		{
+			name:     "failure on OpenOCILayout",
+			signOpts: &PullSignatureOptions{DisableSignatureVerification: true},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.OpenOCILayoutReturns(nil, errTest)
+			},
+			assert: func(_ *artifactfakes.FakeImpl, res *PullResult, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Nil(t, res)
+			},
+		},
+		{
+			name:     "failure on ResolveOCILayout",
+			signOpts: &PullSignatureOptions{DisableSignatureVerification: true},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.ResolveOCILayoutReturns(ocispec.Descriptor{}, errTest)
+			},
+			assert: func(_ *artifactfakes.FakeImpl, res *PullResult, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Nil(t, res)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+		signOpts := tc.signOpts
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &artifactfakes.FakeImpl{}
+			prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+
+			res, err := sut.Pull(t.Context(), "oci-layout:///tmp/layout:v1", "", "", nil, signOpts, nil)
+			assert(mock, res, err)
+		})
+	}
+}
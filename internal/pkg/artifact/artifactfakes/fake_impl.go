@@ -19,6 +19,7 @@ package artifactfakes
 
 import (
 	"context"
+	"os"
 	"sync"
 
 	"github.com/google/go-containerregistry/pkg/name"
@@ -28,6 +29,7 @@ import (
 	oras "oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/content/oci"
 	"oras.land/oras-go/v2/registry/remote"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -115,6 +117,19 @@ type FakeImpl struct {
 		result1 string
 		result2 error
 	}
+	NewOCILayoutStub        func(string) (*oci.Store, error)
+	newOCILayoutMutex       sync.RWMutex
+	newOCILayoutArgsForCall []struct {
+		arg1 string
+	}
+	newOCILayoutReturns struct {
+		result1 *oci.Store
+		result2 error
+	}
+	newOCILayoutReturnsOnCall map[int]struct {
+		result1 *oci.Store
+		result2 error
+	}
 	NewRepositoryStub        func(string) (*remote.Repository, error)
 	newRepositoryMutex       sync.RWMutex
 	newRepositoryArgsForCall []struct {
@@ -128,6 +143,20 @@ type FakeImpl struct {
 		result1 *remote.Repository
 		result2 error
 	}
+	OpenOCILayoutStub        func(context.Context, string) (*oci.ReadOnlyStore, error)
+	openOCILayoutMutex       sync.RWMutex
+	openOCILayoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	openOCILayoutReturns struct {
+		result1 *oci.ReadOnlyStore
+		result2 error
+	}
+	openOCILayoutReturnsOnCall map[int]struct {
+		result1 *oci.ReadOnlyStore
+		result2 error
+	}
 	PackManifestStub        func(context.Context, content.Pusher, oras.PackManifestVersion, string, oras.PackManifestOptions) (v1.Descriptor, error)
 	packManifestMutex       sync.RWMutex
 	packManifestArgsForCall []struct {
@@ -196,6 +225,21 @@ type FakeImpl struct {
 	removeAllReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResolveOCILayoutStub        func(context.Context, *oci.ReadOnlyStore, string) (v1.Descriptor, error)
+	resolveOCILayoutMutex       sync.RWMutex
+	resolveOCILayoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 *oci.ReadOnlyStore
+		arg3 string
+	}
+	resolveOCILayoutReturns struct {
+		result1 v1.Descriptor
+		result2 error
+	}
+	resolveOCILayoutReturnsOnCall map[int]struct {
+		result1 v1.Descriptor
+		result2 error
+	}
 	ResolveRepositoryStub        func(context.Context, *remote.Repository, string) (v1.Descriptor, error)
 	resolveRepositoryMutex       sync.RWMutex
 	resolveRepositoryArgsForCall []struct {
@@ -269,6 +313,19 @@ type FakeImpl struct {
 	verifyCmdReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteFileStub        func(string, []byte, os.FileMode) error
+	writeFileMutex       sync.RWMutex
+	writeFileArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 os.FileMode
+	}
+	writeFileReturns struct {
+		result1 error
+	}
+	writeFileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -660,6 +717,70 @@ func (fake *FakeImpl) MkdirTempReturnsOnCall(i int, result1 string, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) NewOCILayout(arg1 string) (*oci.Store, error) {
+	fake.newOCILayoutMutex.Lock()
+	ret, specificReturn := fake.newOCILayoutReturnsOnCall[len(fake.newOCILayoutArgsForCall)]
+	fake.newOCILayoutArgsForCall = append(fake.newOCILayoutArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NewOCILayoutStub
+	fakeReturns := fake.newOCILayoutReturns
+	fake.recordInvocation("NewOCILayout", []interface{}{arg1})
+	fake.newOCILayoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewOCILayoutCallCount() int {
+	fake.newOCILayoutMutex.RLock()
+	defer fake.newOCILayoutMutex.RUnlock()
+	return len(fake.newOCILayoutArgsForCall)
+}
+
+func (fake *FakeImpl) NewOCILayoutCalls(stub func(string) (*oci.Store, error)) {
+	fake.newOCILayoutMutex.Lock()
+	defer fake.newOCILayoutMutex.Unlock()
+	fake.NewOCILayoutStub = stub
+}
+
+func (fake *FakeImpl) NewOCILayoutArgsForCall(i int) string {
+	fake.newOCILayoutMutex.RLock()
+	defer fake.newOCILayoutMutex.RUnlock()
+	argsForCall := fake.newOCILayoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewOCILayoutReturns(result1 *oci.Store, result2 error) {
+	fake.newOCILayoutMutex.Lock()
+	defer fake.newOCILayoutMutex.Unlock()
+	fake.NewOCILayoutStub = nil
+	fake.newOCILayoutReturns = struct {
+		result1 *oci.Store
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewOCILayoutReturnsOnCall(i int, result1 *oci.Store, result2 error) {
+	fake.newOCILayoutMutex.Lock()
+	defer fake.newOCILayoutMutex.Unlock()
+	fake.NewOCILayoutStub = nil
+	if fake.newOCILayoutReturnsOnCall == nil {
+		fake.newOCILayoutReturnsOnCall = make(map[int]struct {
+			result1 *oci.Store
+			result2 error
+		})
+	}
+	fake.newOCILayoutReturnsOnCall[i] = struct {
+		result1 *oci.Store
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) NewRepository(arg1 string) (*remote.Repository, error) {
 	fake.newRepositoryMutex.Lock()
 	ret, specificReturn := fake.newRepositoryReturnsOnCall[len(fake.newRepositoryArgsForCall)]
@@ -724,6 +845,71 @@ func (fake *FakeImpl) NewRepositoryReturnsOnCall(i int, result1 *remote.Reposito
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) OpenOCILayout(arg1 context.Context, arg2 string) (*oci.ReadOnlyStore, error) {
+	fake.openOCILayoutMutex.Lock()
+	ret, specificReturn := fake.openOCILayoutReturnsOnCall[len(fake.openOCILayoutArgsForCall)]
+	fake.openOCILayoutArgsForCall = append(fake.openOCILayoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.OpenOCILayoutStub
+	fakeReturns := fake.openOCILayoutReturns
+	fake.recordInvocation("OpenOCILayout", []interface{}{arg1, arg2})
+	fake.openOCILayoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) OpenOCILayoutCallCount() int {
+	fake.openOCILayoutMutex.RLock()
+	defer fake.openOCILayoutMutex.RUnlock()
+	return len(fake.openOCILayoutArgsForCall)
+}
+
+func (fake *FakeImpl) OpenOCILayoutCalls(stub func(context.Context, string) (*oci.ReadOnlyStore, error)) {
+	fake.openOCILayoutMutex.Lock()
+	defer fake.openOCILayoutMutex.Unlock()
+	fake.OpenOCILayoutStub = stub
+}
+
+func (fake *FakeImpl) OpenOCILayoutArgsForCall(i int) (context.Context, string) {
+	fake.openOCILayoutMutex.RLock()
+	defer fake.openOCILayoutMutex.RUnlock()
+	argsForCall := fake.openOCILayoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) OpenOCILayoutReturns(result1 *oci.ReadOnlyStore, result2 error) {
+	fake.openOCILayoutMutex.Lock()
+	defer fake.openOCILayoutMutex.Unlock()
+	fake.OpenOCILayoutStub = nil
+	fake.openOCILayoutReturns = struct {
+		result1 *oci.ReadOnlyStore
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) OpenOCILayoutReturnsOnCall(i int, result1 *oci.ReadOnlyStore, result2 error) {
+	fake.openOCILayoutMutex.Lock()
+	defer fake.openOCILayoutMutex.Unlock()
+	fake.OpenOCILayoutStub = nil
+	if fake.openOCILayoutReturnsOnCall == nil {
+		fake.openOCILayoutReturnsOnCall = make(map[int]struct {
+			result1 *oci.ReadOnlyStore
+			result2 error
+		})
+	}
+	fake.openOCILayoutReturnsOnCall[i] = struct {
+		result1 *oci.ReadOnlyStore
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) PackManifest(arg1 context.Context, arg2 content.Pusher, arg3 oras.PackManifestVersion, arg4 string, arg5 oras.PackManifestOptions) (v1.Descriptor, error) {
 	fake.packManifestMutex.Lock()
 	ret, specificReturn := fake.packManifestReturnsOnCall[len(fake.packManifestArgsForCall)]
@@ -1051,6 +1237,72 @@ func (fake *FakeImpl) RemoveAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) ResolveOCILayout(arg1 context.Context, arg2 *oci.ReadOnlyStore, arg3 string) (v1.Descriptor, error) {
+	fake.resolveOCILayoutMutex.Lock()
+	ret, specificReturn := fake.resolveOCILayoutReturnsOnCall[len(fake.resolveOCILayoutArgsForCall)]
+	fake.resolveOCILayoutArgsForCall = append(fake.resolveOCILayoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 *oci.ReadOnlyStore
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResolveOCILayoutStub
+	fakeReturns := fake.resolveOCILayoutReturns
+	fake.recordInvocation("ResolveOCILayout", []interface{}{arg1, arg2, arg3})
+	fake.resolveOCILayoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ResolveOCILayoutCallCount() int {
+	fake.resolveOCILayoutMutex.RLock()
+	defer fake.resolveOCILayoutMutex.RUnlock()
+	return len(fake.resolveOCILayoutArgsForCall)
+}
+
+func (fake *FakeImpl) ResolveOCILayoutCalls(stub func(context.Context, *oci.ReadOnlyStore, string) (v1.Descriptor, error)) {
+	fake.resolveOCILayoutMutex.Lock()
+	defer fake.resolveOCILayoutMutex.Unlock()
+	fake.ResolveOCILayoutStub = stub
+}
+
+func (fake *FakeImpl) ResolveOCILayoutArgsForCall(i int) (context.Context, *oci.ReadOnlyStore, string) {
+	fake.resolveOCILayoutMutex.RLock()
+	defer fake.resolveOCILayoutMutex.RUnlock()
+	argsForCall := fake.resolveOCILayoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ResolveOCILayoutReturns(result1 v1.Descriptor, result2 error) {
+	fake.resolveOCILayoutMutex.Lock()
+	defer fake.resolveOCILayoutMutex.Unlock()
+	fake.ResolveOCILayoutStub = nil
+	fake.resolveOCILayoutReturns = struct {
+		result1 v1.Descriptor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ResolveOCILayoutReturnsOnCall(i int, result1 v1.Descriptor, result2 error) {
+	fake.resolveOCILayoutMutex.Lock()
+	defer fake.resolveOCILayoutMutex.Unlock()
+	fake.ResolveOCILayoutStub = nil
+	if fake.resolveOCILayoutReturnsOnCall == nil {
+		fake.resolveOCILayoutReturnsOnCall = make(map[int]struct {
+			result1 v1.Descriptor
+			result2 error
+		})
+	}
+	fake.resolveOCILayoutReturnsOnCall[i] = struct {
+		result1 v1.Descriptor
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ResolveRepository(arg1 context.Context, arg2 *remote.Repository, arg3 string) (v1.Descriptor, error) {
 	fake.resolveRepositoryMutex.Lock()
 	ret, specificReturn := fake.resolveRepositoryReturnsOnCall[len(fake.resolveRepositoryArgsForCall)]
@@ -1381,6 +1633,74 @@ func (fake *FakeImpl) VerifyCmdReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 os.FileMode) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeFileMutex.Lock()
+	ret, specificReturn := fake.writeFileReturnsOnCall[len(fake.writeFileArgsForCall)]
+	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 os.FileMode
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteFileStub
+	fakeReturns := fake.writeFileReturns
+	fake.recordInvocation("WriteFile", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) WriteFileCallCount() int {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	return len(fake.writeFileArgsForCall)
+}
+
+func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, os.FileMode) error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = stub
+}
+
+func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, os.FileMode) {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	argsForCall := fake.writeFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) WriteFileReturns(result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	fake.writeFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WriteFileReturnsOnCall(i int, result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	if fake.writeFileReturnsOnCall == nil {
+		fake.writeFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -27,12 +27,40 @@ const (
 
 	// defaultTimeout is the default timeout for push and pull operations.
 	defaultTimeout = 5 * time.Minute
+
+	// keyFileMode is the file mode for temporarily stored public keys.
+	keyFileMode = 0o600
+
+	// defaultOCILayoutTag is the tag used for OCI layout references without
+	// a tag or digest.
+	defaultOCILayoutTag = "latest"
 )
 
 // ErrDecodeYAML is the error returned if no matching type could be decoded on
 // artifact pull.
 var ErrDecodeYAML = errors.New("unable to decode YAML into seccomp, selinux or apparmor profile")
 
+var (
+	// ErrNoPublicKey is the error returned if the signature verification
+	// public keys do not contain any PEM encoded key.
+	ErrNoPublicKey = errors.New("no PEM encoded public key found")
+
+	// ErrNoMatchingPublicKey is the error returned if none of the provided
+	// public keys verifies the signature.
+	ErrNoMatchingPublicKey = errors.New("signature not verified by any public key")
+
+	// ErrNoCACertificate is the error returned if the registry CA bundle
+	// does not contain any PEM encoded certificate.
+	ErrNoCACertificate = errors.New("no PEM encoded CA certificate found")
+
+	// ErrOCILayoutSignatureVerification is the error returned if a profile
+	// should be pulled from a local OCI layout with signature verification
+	// enabled, which is not supported.
+	ErrOCILayoutSignatureVerification = errors.New(
+		"signature verification is not supported for OCI layouts",
+	)
+)
+
 // PullResultType are the different types returned for a PullResult.
 type PullResultType string
 
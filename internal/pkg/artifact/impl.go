@@ -29,6 +29,7 @@ import (
 	"oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/content/oci"
 	"oras.land/oras-go/v2/registry/remote"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -47,6 +48,7 @@ type impl interface {
 	NewRepository(string) (*remote.Repository, error)
 	Copy(context.Context, oras.ReadOnlyTarget, string, oras.Target, string, oras.CopyOptions) (ocispec.Descriptor, error)
 	ReadFile(string) ([]byte, error)
+	WriteFile(string, []byte, os.FileMode) error
 	ReadProfile([]byte) (client.Object, error)
 	StoreAdd(context.Context, *file.Store, string, string, string) (ocispec.Descriptor, error)
 	StoreTag(context.Context, *file.Store, ocispec.Descriptor, string) error
@@ -57,6 +59,9 @@ type impl interface {
 	SignCmd(*options.RootOptions, options.KeyOpts, options.SignOptions, []string) error
 	VerifyCmd(context.Context, verify.VerifyCommand, string) error
 	ResolveRepository(context.Context, *remote.Repository, string) (ocispec.Descriptor, error)
+	NewOCILayout(string) (*oci.Store, error)
+	OpenOCILayout(context.Context, string) (*oci.ReadOnlyStore, error)
+	ResolveOCILayout(context.Context, *oci.ReadOnlyStore, string) (ocispec.Descriptor, error)
 }
 
 func (*defaultImpl) ParseReference(s string, opts ...ggcrname.Option) (ggcrname.Reference, error) {
@@ -98,6 +103,10 @@ func (*defaultImpl) ReadFile(name string) ([]byte, error) {
 	return os.ReadFile(name)
 }
 
+func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
 func (*defaultImpl) ReadProfile(raw []byte) (client.Object, error) {
 	return ReadProfile(raw)
 }
@@ -148,3 +157,17 @@ func (*defaultImpl) ResolveRepository(ctx context.Context,
 ) (ocispec.Descriptor, error) {
 	return repo.Resolve(ctx, reference)
 }
+
+func (*defaultImpl) NewOCILayout(path string) (*oci.Store, error) {
+	return oci.New(path)
+}
+
+func (*defaultImpl) OpenOCILayout(ctx context.Context, path string) (*oci.ReadOnlyStore, error) {
+	return oci.NewFromFS(ctx, os.DirFS(path))
+}
+
+func (*defaultImpl) ResolveOCILayout(ctx context.Context,
+	store *oci.ReadOnlyStore, reference string,
+) (ocispec.Descriptor, error) {
+	return store.Resolve(ctx, reference)
+}
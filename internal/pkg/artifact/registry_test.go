@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOCIReference(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name, baseProfileName, expected string
+		ok                              bool
+	}{
+		{"registry", "oci://ghcr.io/foo/bar:v1", "ghcr.io/foo/bar:v1", true},
+		{"OCI layout", "oci-layout:///tmp/layout:v1", "oci-layout:///tmp/layout:v1", true},
+		{"local profile", "runc-v1.4.2", "", false},
+	} {
+		baseProfileName := tc.baseProfileName
+		expected := tc.expected
+		ok := tc.ok
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, resOk := OCIReference(baseProfileName)
+			require.Equal(t, expected, res)
+			require.Equal(t, ok, resOk)
+		})
+	}
+}
+
+func TestParseOCILayoutReference(t *testing.T) {
+	t.Parallel()
+
+	const dgst = "sha256:0a4b1e4ad4b4c1a0ed5d2f4b4d5b9a9d0d4e1b4a3f3f8a5a8c1c4e2b6e4b2f1a"
+
+	for _, tc := range []struct {
+		name, ref, path, reference string
+		ok                         bool
+	}{
+		{"tag", "oci-layout:///tmp/layout:v1", "/tmp/layout", "v1", true},
+		{"digest", "oci-layout://layout@" + dgst, "layout", dgst, true},
+		{"digest with at in path", "oci-layout:///tmp/a@b/layout@" + dgst, "/tmp/a@b/layout", dgst, true},
+		{"at in path", "oci-layout:///tmp/a@b/layout", "/tmp/a@b/layout", defaultOCILayoutTag, true},
+		{"tag with at in path", "oci-layout:///tmp/a@b/layout:v1", "/tmp/a@b/layout", "v1", true},
+		{"default tag", "oci-layout:///tmp/layout", "/tmp/layout", defaultOCILayoutTag, true},
+		{"colon in path", "oci-layout:///tmp/a:b/layout", "/tmp/a:b/layout", defaultOCILayoutTag, true},
+		{"no OCI layout", "ghcr.io/foo/bar:v1", "", "", false},
+	} {
+		ref := tc.ref
+		path := tc.path
+		reference := tc.reference
+		ok := tc.ok
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resPath, resReference, resOk := parseOCILayoutReference(ref)
+			require.Equal(t, path, resPath)
+			require.Equal(t, reference, resReference)
+			require.Equal(t, ok, resOk)
+		})
+	}
+}
+
+func TestRegistryOptionsTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := (*RegistryOptions)(nil).tlsConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	cfg, err = (&RegistryOptions{PlainHTTP: true}).tlsConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	cfg, err = (&RegistryOptions{InsecureSkipTLSVerify: true}).tlsConfig()
+	require.NoError(t, err)
+	require.True(t, cfg.InsecureSkipVerify)
+	require.Nil(t, cfg.RootCAs)
+
+	_, err = (&RegistryOptions{CABundle: []byte("invalid")}).tlsConfig()
+	require.ErrorIs(t, err, ErrNoCACertificate)
+}
+
+func TestIsDigestReference(t *testing.T) {
+	t.Parallel()
+
+	const dgst = "sha256:0a4b1e4ad4b4c1a0ed5d2f4b4d5b9a9d0d4e1b4a3f3f8a5a8c1c4e2b6e4b2f1a"
+
+	require.True(t, IsDigestReference("ghcr.io/foo/bar@"+dgst))
+	require.True(t, IsDigestReference("oci-layout:///tmp/layout@"+dgst))
+	require.False(t, IsDigestReference("ghcr.io/foo/bar:v1"))
+	require.False(t, IsDigestReference("oci-layout:///tmp/layout:v1"))
+}
+
+func TestDigestReference(t *testing.T) {
+	t.Parallel()
+
+	const dgst = "sha256:0a4b1e4ad4b4c1a0ed5d2f4b4d5b9a9d0d4e1b4a3f3f8a5a8c1c4e2b6e4b2f1a"
+
+	ref, err := DigestReference("ghcr.io/foo/bar:v1", dgst)
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io/foo/bar@"+dgst, ref)
+
+	ref, err = DigestReference("oci-layout:///tmp/layout:v1", dgst)
+	require.NoError(t, err)
+	require.Equal(t, "oci-layout:///tmp/layout@"+dgst, ref)
+
+	_, err = DigestReference("INVALID:::", dgst)
+	require.Error(t, err)
+}
@@ -29,6 +29,18 @@ const (
 	// authentication.
 	FlagUsername string = "username"
 
+	// FlagPlainHTTP is the flag for connecting to the registry via HTTP
+	// instead of HTTPS.
+	FlagPlainHTTP string = "plain-http"
+
+	// FlagInsecure is the flag for skipping the verification of the registry
+	// TLS certificate.
+	FlagInsecure string = "insecure"
+
+	// FlagCAFile is the flag for defining the file containing the PEM encoded
+	// CA certificates to verify the registry TLS certificate.
+	FlagCAFile string = "ca-file"
+
 	// EnvKeyPassword is the environment variable key for defining the password
 	// for registry authentication.
 	EnvKeyPassword string = "PASSWORD"
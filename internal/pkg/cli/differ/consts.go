@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+const (
+	// FlagOutputFormat is the flag for defining the output format of the diff.
+	FlagOutputFormat string = "output-format"
+
+	// OutputFormatText prints a human-readable diff.
+	OutputFormatText string = "text"
+
+	// OutputFormatJSON prints the diff as JSON.
+	OutputFormatJSON string = "json"
+
+	// ExitCodeDifferent is the exit code of the command if the profiles
+	// differ.
+	ExitCodeDifferent int = 1
+
+	// ExitCodeError is the exit code of the command if the profiles could not
+	// be compared.
+	ExitCodeError int = 2
+)
@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+)
+
+// ChangeType is the type of a single profile change.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded indicates that a permission got added.
+	ChangeTypeAdded ChangeType = "added"
+
+	// ChangeTypeRemoved indicates that a permission got removed.
+	ChangeTypeRemoved ChangeType = "removed"
+
+	// ChangeTypeChanged indicates that a value got modified.
+	ChangeTypeChanged ChangeType = "changed"
+)
+
+// unconditional is the textual representation of a seccomp rule without
+// arguments.
+const unconditional = "unconditional"
+
+// Change is a single semantic difference between two profiles.
+type Change struct {
+	// Type is the type of the change.
+	Type ChangeType `json:"type"`
+
+	// Path identifies the changed part of the profile, for example
+	// "syscalls[SCMP_ACT_ALLOW]" or "filesystem.readOnlyPaths".
+	Path string `json:"path"`
+
+	// Value is the added, removed or changed entry, like a syscall name or a
+	// file system path.
+	Value string `json:"value,omitempty"`
+
+	// Old is the previous value of a changed entry.
+	Old string `json:"old,omitempty"`
+
+	// New is the current value of a changed entry.
+	New string `json:"new,omitempty"`
+}
+
+// Diff returns the semantic changes required to get from one profile to
+// another. Both profiles have to be of the same kind.
+func Diff(from, to client.Object) ([]Change, error) {
+	switch fromProfile := from.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		if toProfile, ok := to.(*seccompprofileapi.SeccompProfile); ok {
+			return diffSeccomp(&fromProfile.Spec, &toProfile.Spec), nil
+		}
+
+	case *apparmorprofileapi.AppArmorProfile:
+		if toProfile, ok := to.(*apparmorprofileapi.AppArmorProfile); ok {
+			return diffAppArmor(&fromProfile.Spec.Abstract, &toProfile.Spec.Abstract), nil
+		}
+
+	case *selinuxprofileapi.SelinuxProfile:
+		if toProfile, ok := to.(*selinuxprofileapi.SelinuxProfile); ok {
+			return diffSelinux(fromProfile.Spec.Allow, toProfile.Spec.Allow), nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported profile kind: %T", from)
+	}
+
+	return nil, fmt.Errorf("cannot compare %T with %T", from, to)
+}
+
+func diffSeccomp(from, to *seccompprofileapi.SeccompProfileSpec) []Change {
+	changes := []Change{}
+
+	if from.DefaultAction != to.DefaultAction {
+		changes = append(changes, Change{
+			Type: ChangeTypeChanged,
+			Path: "defaultAction",
+			Old:  string(from.DefaultAction),
+			New:  string(to.DefaultAction),
+		})
+	}
+
+	fromRules := seccompRules(from.Syscalls)
+	toRules := seccompRules(to.Syscalls)
+
+	actions := sets.New[seccompprofileapi.Action]()
+	for action := range fromRules {
+		actions.Insert(action)
+	}
+
+	for action := range toRules {
+		actions.Insert(action)
+	}
+
+	for _, action := range sets.List(actions) {
+		path := fmt.Sprintf("syscalls[%s]", action)
+		fromSyscalls := fromRules[action]
+		toSyscalls := toRules[action]
+
+		changes = append(changes, diffSets(path, keys(fromSyscalls), keys(toSyscalls))...)
+
+		for _, name := range sets.List(keys(fromSyscalls).Intersection(keys(toSyscalls))) {
+			if fromSyscalls[name].Equal(toSyscalls[name]) {
+				continue
+			}
+
+			changes = append(changes, Change{
+				Type:  ChangeTypeChanged,
+				Path:  path + ".args",
+				Value: name,
+				Old:   strings.Join(sets.List(fromSyscalls[name]), " | "),
+				New:   strings.Join(sets.List(toSyscalls[name]), " | "),
+			})
+		}
+	}
+
+	return changes
+}
+
+// seccompRules returns the argument conditions for every syscall name per
+// action.
+func seccompRules(syscalls []seccompprofileapi.Syscall) map[seccompprofileapi.Action]map[string]sets.Set[string] {
+	res := map[seccompprofileapi.Action]map[string]sets.Set[string]{}
+
+	for i := range syscalls {
+		syscall := &syscalls[i]
+
+		if _, ok := res[syscall.Action]; !ok {
+			res[syscall.Action] = map[string]sets.Set[string]{}
+		}
+
+		condition := seccompCondition(syscall)
+
+		for _, name := range syscall.Names {
+			if _, ok := res[syscall.Action][name]; !ok {
+				res[syscall.Action][name] = sets.New[string]()
+			}
+
+			res[syscall.Action][name].Insert(condition)
+		}
+	}
+
+	return res
+}
+
+// seccompCondition returns a textual representation of the arguments and the
+// errno return code of a syscall rule.
+func seccompCondition(syscall *seccompprofileapi.Syscall) string {
+	conditions := []string{}
+
+	for _, arg := range syscall.Args {
+		index := "?"
+		if arg.Index != nil {
+			index = strconv.Itoa(int(*arg.Index))
+		}
+
+		condition := fmt.Sprintf("arg%s %s %d", index, arg.Op, arg.Value)
+		if arg.Op == seccompprofileapi.OpMaskedEqual {
+			condition += fmt.Sprintf(" %d", arg.ValueTwo)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	if syscall.ErrnoRet != 0 {
+		conditions = append(conditions, fmt.Sprintf("errnoRet %d", syscall.ErrnoRet))
+	}
+
+	if len(conditions) == 0 {
+		return unconditional
+	}
+
+	return strings.Join(conditions, " && ")
+}
+
+func diffAppArmor(from, to *apparmorprofileapi.AppArmorAbstract) []Change {
+	changes := []Change{}
+
+	fromExecutable := ptrOrZero(from.Executable)
+	toExecutable := ptrOrZero(to.Executable)
+	changes = append(changes, diffSets(
+		"executable.allowedExecutables",
+		sets.New(fromExecutable.AllowedExecutables...),
+		sets.New(toExecutable.AllowedExecutables...),
+	)...)
+	changes = append(changes, diffSets(
+		"executable.allowedLibraries",
+		sets.New(fromExecutable.AllowedLibraries...),
+		sets.New(toExecutable.AllowedLibraries...),
+	)...)
+
+	fromFilesystem := ptrOrZero(from.Filesystem)
+	toFilesystem := ptrOrZero(to.Filesystem)
+	changes = append(changes, diffSets(
+		"filesystem.readOnlyPaths",
+		sets.New(fromFilesystem.ReadOnlyPaths...),
+		sets.New(toFilesystem.ReadOnlyPaths...),
+	)...)
+	changes = append(changes, diffSets(
+		"filesystem.writeOnlyPaths",
+		sets.New(fromFilesystem.WriteOnlyPaths...),
+		sets.New(toFilesystem.WriteOnlyPaths...),
+	)...)
+	changes = append(changes, diffSets(
+		"filesystem.readWritePaths",
+		sets.New(fromFilesystem.ReadWritePaths...),
+		sets.New(toFilesystem.ReadWritePaths...),
+	)...)
+
+	changes = append(changes, diffSets(
+		"capability.allowedCapabilities",
+		sets.New(ptrOrZero(from.Capability).AllowedCapabilities...),
+		sets.New(ptrOrZero(to.Capability).AllowedCapabilities...),
+	)...)
+
+	fromNetwork := ptrOrZero(from.Network)
+	toNetwork := ptrOrZero(to.Network)
+	fromProtocols := ptrOrZero(fromNetwork.Protocols)
+	toProtocols := ptrOrZero(toNetwork.Protocols)
+	changes = append(changes, diffBool("network.allowRaw", fromNetwork.AllowRaw, toNetwork.AllowRaw)...)
+	changes = append(changes, diffBool("network.allowedProtocols.allowTcp", fromProtocols.AllowTCP, toProtocols.AllowTCP)...)
+	changes = append(changes, diffBool("network.allowedProtocols.allowUdp", fromProtocols.AllowUDP, toProtocols.AllowUDP)...)
+
+	changes = append(changes, diffSets("executable.transitions",
+		ruleSet(fromExecutable.Transitions), ruleSet(toExecutable.Transitions))...)
+	changes = append(changes, diffSets("filesystem.rules",
+		ruleSet(fromFilesystem.Rules), ruleSet(toFilesystem.Rules))...)
+	changes = append(changes, diffSets("network.rules",
+		ruleSet(fromNetwork.Rules), ruleSet(toNetwork.Rules))...)
+	changes = append(changes, diffSets("signal.rules",
+		ruleSet(ptrOrZero(from.Signal).Rules), ruleSet(ptrOrZero(to.Signal).Rules))...)
+	changes = append(changes, diffSets("ptrace.rules",
+		ruleSet(ptrOrZero(from.Ptrace).Rules), ruleSet(ptrOrZero(to.Ptrace).Rules))...)
+	changes = append(changes, diffSets("dbus.rules",
+		ruleSet(ptrOrZero(from.Dbus).Rules), ruleSet(ptrOrZero(to.Dbus).Rules))...)
+	changes = append(changes, diffSets("mount.rules",
+		ruleSet(ptrOrZero(from.Mount).Rules), ruleSet(ptrOrZero(to.Mount).Rules))...)
+
+	return changes
+}
+
+// ruleSet returns the string representation of all rules as set.
+func ruleSet[T any](rules []T) sets.Set[string] {
+	res := sets.New[string]()
+	for i := range rules {
+		res.Insert(fmt.Sprintf("%+v", rules[i]))
+	}
+
+	return res
+}
+
+func diffSelinux(from, to selinuxprofileapi.Allow) []Change {
+	changes := []Change{}
+
+	labels := sets.New[selinuxprofileapi.LabelKey]()
+	for label := range from {
+		labels.Insert(label)
+	}
+
+	for label := range to {
+		labels.Insert(label)
+	}
+
+	for _, label := range sets.List(labels) {
+		classes := sets.New[selinuxprofileapi.ObjectClassKey]()
+		for class := range from[label] {
+			classes.Insert(class)
+		}
+
+		for class := range to[label] {
+			classes.Insert(class)
+		}
+
+		for _, class := range sets.List(classes) {
+			changes = append(changes, diffSets(
+				fmt.Sprintf("allow[%s][%s]", label, class),
+				sets.New(from[label][class]...),
+				sets.New(to[label][class]...),
+			)...)
+		}
+	}
+
+	return changes
+}
+
+// diffSets returns the removed and added entries of a set in sorted order.
+func diffSets(path string, from, to sets.Set[string]) []Change {
+	changes := []Change{}
+
+	for _, value := range sets.List(from.Difference(to)) {
+		changes = append(changes, Change{Type: ChangeTypeRemoved, Path: path, Value: value})
+	}
+
+	for _, value := range sets.List(to.Difference(from)) {
+		changes = append(changes, Change{Type: ChangeTypeAdded, Path: path, Value: value})
+	}
+
+	return changes
+}
+
+// diffBool compares two optional booleans, where unset means false.
+func diffBool(path string, from, to *bool) []Change {
+	fromValue := ptrOrZero(from)
+	toValue := ptrOrZero(to)
+
+	if fromValue == toValue {
+		return nil
+	}
+
+	return []Change{{
+		Type: ChangeTypeChanged,
+		Path: path,
+		Old:  strconv.FormatBool(fromValue),
+		New:  strconv.FormatBool(toValue),
+	}}
+}
+
+func keys[V any](m map[string]V) sets.Set[string] {
+	res := sets.New[string]()
+	for k := range m {
+		res.Insert(k)
+	}
+
+	return res
+}
+
+func ptrOrZero[T any](ptr *T) T {
+	var zero T
+	if ptr == nil {
+		return zero
+	}
+
+	return *ptr
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+)
+
+// ErrProfilesDiffer is returned by Run if the compared profiles differ.
+var ErrProfilesDiffer = errors.New("profiles differ")
+
+// Result is the outcome of a profile comparison.
+type Result struct {
+	// From is the path to the original profile.
+	From string `json:"from"`
+
+	// To is the path to the modified profile.
+	To string `json:"to"`
+
+	// Kind is the kind of both profiles.
+	Kind string `json:"kind"`
+
+	// Changes are the semantic differences between both profiles.
+	Changes []Change `json:"changes"`
+}
+
+// Differ is the main structure of this package.
+type Differ struct {
+	impl
+	options *Options
+}
+
+// New returns a new Differ instance.
+func New(options *Options) *Differ {
+	return &Differ{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Differ.
+func (d *Differ) Run() error {
+	from, err := d.readProfile(d.options.fromFile)
+	if err != nil {
+		return err
+	}
+
+	to, err := d.readProfile(d.options.toFile)
+	if err != nil {
+		return err
+	}
+
+	changes, err := Diff(from, to)
+	if err != nil {
+		return fmt.Errorf("diff profiles: %w", err)
+	}
+
+	result := &Result{
+		From:    d.options.fromFile,
+		To:      d.options.toFile,
+		Kind:    from.GetObjectKind().GroupVersionKind().Kind,
+		Changes: changes,
+	}
+
+	switch d.options.outputFormat {
+	case OutputFormatJSON:
+		err = printJSON(d.Stdout(), result)
+	default:
+		err = printText(d.Stdout(), result)
+	}
+
+	if err != nil {
+		return fmt.Errorf("print diff: %w", err)
+	}
+
+	if len(changes) > 0 {
+		return ErrProfilesDiffer
+	}
+
+	log.Printf("Profiles %s and %s are equal", d.options.fromFile, d.options.toFile)
+
+	return nil
+}
+
+func (d *Differ) readProfile(filepath string) (client.Object, error) {
+	log.Printf("Reading file %s", filepath)
+
+	content, err := d.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("open profile: %w", err)
+	}
+
+	profile, err := artifact.ReadProfile(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath, err)
+	}
+
+	return profile, nil
+}
+
+func printJSON(w io.Writer, result *Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(result); err != nil {
+		return fmt.Errorf("encode JSON: %w", err)
+	}
+
+	return nil
+}
+
+func printText(w io.Writer, result *Result) error {
+	if len(result.Changes) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w, "--- %s\n+++ %s\n", result.From, result.To); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	for _, change := range result.Changes {
+		var line string
+
+		switch change.Type {
+		case ChangeTypeAdded:
+			line = fmt.Sprintf("+ %s: %s", change.Path, change.Value)
+		case ChangeTypeRemoved:
+			line = fmt.Sprintf("- %s: %s", change.Path, change.Value)
+		case ChangeTypeChanged:
+			line = fmt.Sprintf("~ %s: %s -> %s", change.Path, change.Old, change.New)
+			if change.Value != "" {
+				line = fmt.Sprintf("~ %s: %s: %s -> %s", change.Path, change.Value, change.Old, change.New)
+			}
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return fmt.Errorf("write change: %w", err)
+		}
+	}
+
+	return nil
+}
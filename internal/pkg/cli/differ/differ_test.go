@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/differ/differfakes"
+)
+
+const SeccompA = `
+apiVersion: security-profiles-operator.x-k8s.io/v1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  syscalls:
+    - action: SCMP_ACT_ALLOW
+      names:
+        - read
+        - write
+    - action: SCMP_ACT_LOG
+      names:
+        - mount
+`
+
+const SeccompB = `
+apiVersion: security-profiles-operator.x-k8s.io/v1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_LOG
+  syscalls:
+    - action: SCMP_ACT_ALLOW
+      names:
+        - read
+        - open
+    - action: SCMP_ACT_ALLOW
+      names:
+        - write
+      args:
+        - index: 0
+          value: 1
+          op: SCMP_CMP_EQ
+`
+
+const AppArmorA = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    executable:
+      allowedExecutables:
+        - /usr/bin/nginx
+    filesystem:
+      readOnlyPaths:
+        - /etc/nginx/**
+      readWritePaths:
+        - /tmp/**
+    capability:
+      allowedCapabilities:
+        - net_bind_service
+    network:
+      allowedProtocols:
+        allowTcp: true
+`
+
+const AppArmorB = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    executable:
+      allowedExecutables:
+        - /usr/bin/nginx
+    filesystem:
+      readOnlyPaths:
+        - /etc/nginx/**
+        - /usr/share/nginx/**
+    capability:
+      allowedCapabilities:
+        - net_bind_service
+        - setuid
+    network:
+      allowRaw: true
+      allowedProtocols:
+        allowTcp: true
+      rules:
+        - family: unix
+          type: stream
+`
+
+const SelinuxA = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha2
+kind: SelinuxProfile
+spec:
+  inherit:
+    - name: container
+  allow:
+    var_log_t:
+      dir:
+        - open
+        - read
+`
+
+const SelinuxB = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha2
+kind: SelinuxProfile
+spec:
+  inherit:
+    - name: container
+  allow:
+    var_log_t:
+      dir:
+        - open
+      file:
+        - getattr
+`
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		from, to string
+		expected []Change
+	}{
+		{
+			name: "equal profiles",
+			from: SeccompA,
+			to:   SeccompA,
+		},
+		{
+			name: "seccomp",
+			from: SeccompA,
+			to:   SeccompB,
+			expected: []Change{
+				{Type: ChangeTypeChanged, Path: "defaultAction", Old: "SCMP_ACT_ERRNO", New: "SCMP_ACT_LOG"},
+				{Type: ChangeTypeAdded, Path: "syscalls[SCMP_ACT_ALLOW]", Value: "open"},
+				{
+					Type:  ChangeTypeChanged,
+					Path:  "syscalls[SCMP_ACT_ALLOW].args",
+					Value: "write",
+					Old:   "unconditional",
+					New:   "arg0 SCMP_CMP_EQ 1",
+				},
+				{Type: ChangeTypeRemoved, Path: "syscalls[SCMP_ACT_LOG]", Value: "mount"},
+			},
+		},
+		{
+			name: "apparmor",
+			from: AppArmorA,
+			to:   AppArmorB,
+			expected: []Change{
+				{Type: ChangeTypeAdded, Path: "filesystem.readOnlyPaths", Value: "/usr/share/nginx/**"},
+				{Type: ChangeTypeRemoved, Path: "filesystem.readWritePaths", Value: "/tmp/**"},
+				{Type: ChangeTypeAdded, Path: "capability.allowedCapabilities", Value: "setuid"},
+				{Type: ChangeTypeChanged, Path: "network.allowRaw", Old: "false", New: "true"},
+				{Type: ChangeTypeAdded, Path: "network.rules", Value: "{Family:unix Type:stream Deny:false}"},
+			},
+		},
+		{
+			name: "selinux",
+			from: SelinuxA,
+			to:   SelinuxB,
+			expected: []Change{
+				{Type: ChangeTypeRemoved, Path: "allow[var_log_t][dir]", Value: "read"},
+				{Type: ChangeTypeAdded, Path: "allow[var_log_t][file]", Value: "getattr"},
+			},
+		},
+	} {
+		from, to, expected := tc.from, tc.to, tc.expected
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fromProfile, err := artifact.ReadProfile([]byte(from))
+			require.NoError(t, err)
+
+			toProfile, err := artifact.ReadProfile([]byte(to))
+			require.NoError(t, err)
+
+			changes, err := Diff(fromProfile, toProfile)
+			require.NoError(t, err)
+
+			if expected == nil {
+				require.Empty(t, changes)
+			} else {
+				require.Equal(t, expected, changes)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	defaultOptions := func() *Options {
+		options := Default()
+		options.fromFile = "foo.yaml"
+		options.toFile = "bar.yaml"
+
+		return options
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*differfakes.FakeImpl) *Options
+		assert  func(string, error)
+	}{
+		{
+			name: "equal profiles",
+			prepare: func(mock *differfakes.FakeImpl) *Options {
+				mock.ReadFileReturns([]byte(SeccompA), nil)
+
+				return defaultOptions()
+			},
+			assert: func(output string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, output)
+			},
+		},
+		{
+			name: "text diff",
+			prepare: func(mock *differfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, []byte(SelinuxA), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(SelinuxB), nil)
+
+				return defaultOptions()
+			},
+			assert: func(output string, err error) {
+				require.ErrorIs(t, err, ErrProfilesDiffer)
+				require.Equal(t, "--- foo.yaml\n+++ bar.yaml\n"+
+					"- allow[var_log_t][dir]: read\n"+
+					"+ allow[var_log_t][file]: getattr\n", output)
+			},
+		},
+		{
+			name: "JSON diff",
+			prepare: func(mock *differfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, []byte(SeccompA), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(SeccompB), nil)
+
+				options := defaultOptions()
+				options.outputFormat = OutputFormatJSON
+
+				return options
+			},
+			assert: func(output string, err error) {
+				require.ErrorIs(t, err, ErrProfilesDiffer)
+
+				result := &Result{}
+				require.NoError(t, json.Unmarshal([]byte(output), result))
+				require.Equal(t, "foo.yaml", result.From)
+				require.Equal(t, "bar.yaml", result.To)
+				require.Equal(t, "SeccompProfile", result.Kind)
+				require.Len(t, result.Changes, 4)
+			},
+		},
+		{
+			name: "cannot diff different kinds",
+			prepare: func(mock *differfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, []byte(SeccompA), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(SelinuxA), nil)
+
+				return defaultOptions()
+			},
+			assert: func(output string, err error) {
+				require.ErrorContains(t, err, "cannot compare *v1.SeccompProfile with *v1.SelinuxProfile")
+				require.Empty(t, output)
+			},
+		},
+		{
+			name: "input file not found",
+			prepare: func(mock *differfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, nil, errors.New("file not found"))
+
+				return defaultOptions()
+			},
+			assert: func(_ string, err error) {
+				require.ErrorContains(t, err, "open profile: file not found")
+			},
+		},
+		{
+			name: "input file is not yaml",
+			prepare: func(mock *differfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, []byte("% this is not yaml"), nil)
+
+				return defaultOptions()
+			},
+			assert: func(_ string, err error) {
+				require.ErrorContains(t, err, "cannot parse yaml")
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &differfakes.FakeImpl{}
+			output := &bytes.Buffer{}
+			mock.StdoutReturns(output)
+			options := prepare(mock)
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(output.String(), err)
+		})
+	}
+}
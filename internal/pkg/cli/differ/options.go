@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"errors"
+	"fmt"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+// Options define all possible options for the differ.
+type Options struct {
+	fromFile     string
+	toFile       string
+	outputFormat string
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		outputFormat: OutputFormatText,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	args := ctx.Args().Slice()
+	if len(args) != 2 {
+		return nil, errors.New("exactly two profiles have to be provided")
+	}
+
+	options.fromFile = args[0]
+	options.toFile = args[1]
+
+	if ctx.IsSet(FlagOutputFormat) {
+		options.outputFormat = ctx.String(FlagOutputFormat)
+	}
+
+	if options.outputFormat != OutputFormatText && options.outputFormat != OutputFormatJSON {
+		return nil, fmt.Errorf(
+			"unsupported output format %q, must be %s or %s",
+			options.outputFormat, OutputFormatText, OutputFormatJSON,
+		)
+	}
+
+	return options, nil
+}
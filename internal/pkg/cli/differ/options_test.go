@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{ // Success
+			prepare: func(set *flag.FlagSet) {
+				require.NoError(t, set.Parse([]string{"foo.yaml", "bar.yaml"}))
+			},
+			assert: func(options *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "foo.yaml", options.fromFile)
+				require.Equal(t, "bar.yaml", options.toFile)
+				require.Equal(t, OutputFormatText, options.outputFormat)
+			},
+		},
+		{ // Success with JSON output
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagOutputFormat, "", "")
+				require.NoError(t, set.Set(FlagOutputFormat, OutputFormatJSON))
+				require.NoError(t, set.Parse([]string{"foo.yaml", "bar.yaml"}))
+			},
+			assert: func(options *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, OutputFormatJSON, options.outputFormat)
+			},
+		},
+		{ // failure: no profiles provided
+			prepare: func(set *flag.FlagSet) {},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{ // failure: too many profiles provided
+			prepare: func(set *flag.FlagSet) {
+				require.NoError(t, set.Parse([]string{"foo.yaml", "bar.yaml", "baz.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{ // failure: unsupported output format
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagOutputFormat, "", "")
+				require.NoError(t, set.Set(FlagOutputFormat, "yaml"))
+				require.NoError(t, set.Parse([]string{"foo.yaml", "bar.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.ErrorContains(t, err, "unsupported output format")
+			},
+		},
+	} {
+		set := flag.NewFlagSet("", flag.ExitOnError)
+		tc.prepare(set)
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, set, nil)
+
+		options, err := FromContext(ctx)
+		tc.assert(options, err)
+	}
+}
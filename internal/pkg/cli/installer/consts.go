@@ -16,7 +16,34 @@ limitations under the License.
 
 package installer
 
-import "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+import (
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
 
-// DefaultProfileFile defines the default output location for the installer.
-var DefaultProfileFile = cli.DefaultFile
+var (
+	// DefaultProfileFile defines the default output location for the installer.
+	DefaultProfileFile = cli.DefaultFile
+
+	// DefaultSeccompProfileDir defines the default directory for installing
+	// seccomp profiles, which is the localhost profile root of the kubelet.
+	DefaultSeccompProfileDir = config.KubeletSeccompRootPath()
+)
+
+const (
+	// FlagSeccompProfileDir is the flag for defining the directory where
+	// seccomp profiles get installed.
+	FlagSeccompProfileDir string = "seccomp-profile-dir"
+
+	// FlagDisableSignatureVerification is the flag for disabling the signature
+	// verification of base profiles pulled from OCI registries.
+	FlagDisableSignatureVerification string = "disable-signature-verification"
+
+	// FlagPlainHTTP is the flag for connecting to the registry via HTTP
+	// instead of HTTPS.
+	FlagPlainHTTP string = cli.FlagPlainHTTP
+
+	// FlagInsecure is the flag for skipping the verification of the registry
+	// TLS certificate.
+	FlagInsecure string = cli.FlagInsecure
+)
@@ -17,9 +17,17 @@ limitations under the License.
 package installer
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/exec"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 
 	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 )
 
@@ -31,6 +39,12 @@ type impl interface {
 	ReadFile(string) ([]byte, error)
 	AppArmorEnabled(manager apparmorprofile.ProfileManager) bool
 	AppArmorInstallProfile(manager apparmorprofile.ProfileManager, p profilebaseapi.StatusBaseUser) (bool, error)
+	Pull(string, *v1.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)
+	MkdirAll(string, os.FileMode) error
+	MkdirTemp(string, string) (string, error)
+	WriteFile(string, []byte, os.FileMode) error
+	RemoveAll(string) error
+	Semodule(args ...string) error
 }
 
 func (*defaultImpl) ReadFile(name string) ([]byte, error) {
@@ -47,3 +61,39 @@ func (*defaultImpl) AppArmorInstallProfile(
 ) (bool, error) {
 	return manager.InstallProfile(p)
 }
+
+func (*defaultImpl) Pull(
+	from string,
+	platform *v1.Platform,
+	signOpts *artifact.PullSignatureOptions,
+	regOpts *artifact.RegistryOptions,
+) (*artifact.PullResult, error) {
+	return artifact.New(logr.New(&cli.LogSink{})).Pull(
+		context.Background(), from, "", "", platform, signOpts, regOpts,
+	)
+}
+
+func (*defaultImpl) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+func (*defaultImpl) MkdirTemp(dir, pattern string) (string, error) {
+	return os.MkdirTemp(dir, pattern)
+}
+
+func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
+func (*defaultImpl) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+func (*defaultImpl) Semodule(args ...string) error {
+	out, err := exec.Command("semodule", args...).CombinedOutput() //nolint:gosec // arguments are trusted
+	if err != nil {
+		return fmt.Errorf("run semodule: %w: %s", err, out)
+	}
+
+	return nil
+}
@@ -17,15 +17,34 @@ limitations under the License.
 package installer
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/hairyhenderson/go-which"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/utils/ptr"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+)
+
+const (
+	filePermissionMode os.FileMode = 0o644
+	dirPermissionMode  os.FileMode = 0o744
 )
 
 // Installer is the main structure of this package.
@@ -74,6 +93,14 @@ func (p *Installer) Run() error {
 		if _, err := p.AppArmorInstallProfile(manager, obj); err != nil {
 			return fmt.Errorf("install apparmor profile: %w", err)
 		}
+	case *seccompprofileapi.SeccompProfile:
+		if err := p.installSeccompProfile(obj); err != nil {
+			return fmt.Errorf("install seccomp profile: %w", err)
+		}
+	case *selinuxprofileapi.SelinuxProfile:
+		if err := p.installSelinuxProfile(obj); err != nil {
+			return fmt.Errorf("install selinux profile: %w", err)
+		}
 	default:
 		return fmt.Errorf("cannot install %T profile", obj)
 	}
@@ -81,6 +108,153 @@ func (p *Installer) Run() error {
 	return nil
 }
 
+func (p *Installer) installSeccompProfile(profile *seccompprofileapi.SeccompProfile) error {
+	syscalls, err := seccompprofile.ResolveSyscalls(
+		profile, profile.Spec.Syscalls, p.getBaseProfile, 0,
+	)
+	if err != nil {
+		return fmt.Errorf("resolve syscalls: %w", err)
+	}
+
+	profile.Spec.Syscalls = syscalls
+
+	// There is no SPOD restricting the allowed syscalls on the host.
+	if err := seccompprofile.ValidateProfile(profile, &spodapi.SPODSecurityConfig{}); err != nil {
+		return fmt.Errorf("validate profile: %w", err)
+	}
+
+	content, err := json.Marshal(profile.Spec)
+	if err != nil {
+		return fmt.Errorf("marshal JSON profile: %w", err)
+	}
+
+	if err := p.MkdirAll(p.options.SeccompProfileDir, dirPermissionMode); err != nil {
+		return fmt.Errorf("create seccomp profile directory: %w", err)
+	}
+
+	profilePath := SeccompProfilePath(profile, p.options)
+	p.logger.Info("Installing seccomp profile", "profilePath", profilePath)
+
+	if err := p.WriteFile(profilePath, content, filePermissionMode); err != nil {
+		return fmt.Errorf("write seccomp profile: %w", err)
+	}
+
+	return nil
+}
+
+// getBaseProfile retrieves the base profile of sp either from an OCI artifact
+// registry or from a YAML file next to the installed profile.
+func (p *Installer) getBaseProfile(
+	sp *seccompprofileapi.SeccompProfile,
+) (*seccompprofileapi.SeccompProfile, error) {
+	baseProfileName := sp.Spec.BaseProfileName
+
+	if from, ok := artifact.OCIReference(baseProfileName); ok {
+		p.logger.Info("Pulling base profile", "from", from)
+
+		signOpts, regOpts, err := common.PullOptions(context.Background(), nil, p.spod(from), from)
+		if err != nil {
+			return nil, fmt.Errorf("get pull options for base profile %s: %w", from, err)
+		}
+
+		res, err := p.Pull(from, &v1.Platform{
+			Architecture: runtime.GOARCH,
+			OS:           runtime.GOOS,
+		}, signOpts, regOpts)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve base profile %s from OCI artifact: %w", from, err)
+		}
+
+		if res.Type() != artifact.PullResultTypeSeccompProfile {
+			return nil, fmt.Errorf("pull result type %s is not a seccomp profile", res.Type())
+		}
+
+		return res.SeccompProfile(), nil
+	}
+
+	baseProfilePath := filepath.Join(filepath.Dir(p.options.ProfilePath), baseProfileName+".yaml")
+	p.logger.Info("Reading base profile", "filename", baseProfilePath)
+
+	content, err := p.ReadFile(baseProfilePath)
+	if err != nil {
+		return nil, fmt.Errorf("open base profile: %w", err)
+	}
+
+	profile, err := artifact.ReadProfile(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", baseProfilePath, err)
+	}
+
+	baseProfile, ok := profile.(*seccompprofileapi.SeccompProfile)
+	if !ok {
+		return nil, fmt.Errorf("base profile %s is no seccomp profile", baseProfilePath)
+	}
+
+	return baseProfile, nil
+}
+
+// spod returns the SPOD configuration equivalent to the options for pulling
+// the base profile ref. It does not reference any ConfigMaps, which is why
+// the pull options can be retrieved without a cluster.
+func (p *Installer) spod(ref string) *spodapi.SecurityProfilesOperatorDaemon {
+	host, _, _ := strings.Cut(ref, "/")
+
+	spod := &spodapi.SecurityProfilesOperatorDaemon{}
+	spod.Spec.Security = spodapi.SPODSecurityConfig{
+		DisableOCIArtifactSignatureVerification: ptr.To(p.options.DisableSignatureVerification),
+		OCIRegistries: []spodapi.OCIRegistryConfig{{
+			Host:                  host,
+			PlainHTTP:             ptr.To(p.options.PlainHTTP),
+			InsecureSkipTLSVerify: ptr.To(p.options.Insecure),
+		}},
+	}
+
+	return spod
+}
+
+func (p *Installer) installSelinuxProfile(profile *selinuxprofileapi.SelinuxProfile) error {
+	systemInherits, err := translator.SystemInherits(profile)
+	if err != nil {
+		return fmt.Errorf("get inherited policies: %w", err)
+	}
+
+	policy, err := translator.Object2CIL(systemInherits, nil, profile, nil)
+	if err != nil {
+		return fmt.Errorf("translate profile to CIL: %w", err)
+	}
+
+	tempDir, err := p.MkdirTemp("", "spoc-install-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+
+	defer func() {
+		if err := p.RemoveAll(tempDir); err != nil {
+			p.logger.Error(err, "Unable to remove temp dir")
+		}
+	}()
+
+	// The module name is derived from the file name by semodule.
+	policyFile := filepath.Join(tempDir, profile.GetPolicyName()+".cil")
+	if err := p.WriteFile(policyFile, []byte(policy), filePermissionMode); err != nil {
+		return fmt.Errorf("write CIL policy: %w", err)
+	}
+
+	p.logger.Info("Installing SELinux profile",
+		"module", profile.GetPolicyName(), "usage", profile.GetPolicyUsage())
+
+	if err := p.Semodule("-i", policyFile); err != nil {
+		return fmt.Errorf("install selinux module: %w", err)
+	}
+
+	return nil
+}
+
+// SeccompProfilePath returns the path of the installed seccomp profile.
+func SeccompProfilePath(profile *seccompprofileapi.SeccompProfile, options *Options) string {
+	return filepath.Join(options.SeccompProfileDir, profile.GetName()+".json")
+}
+
 func PatchProfileName(profile *apparmorprofileapi.AppArmorProfile, options *Options) error {
 	if options.ExecutablePath != "" {
 		profile.Name = options.ExecutablePath
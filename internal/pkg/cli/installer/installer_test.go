@@ -26,6 +26,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/installer/installerfakes"
 )
@@ -45,6 +46,69 @@ func TestRun(t *testing.T) {
 	seccompProfile, err := os.ReadFile("../../../../examples/seccompprofile.yaml")
 	require.NoError(t, err)
 
+	selinuxProfile, err := os.ReadFile("../../../../examples/selinuxprofile.yaml")
+	require.NoError(t, err)
+
+	seccompProfileWithBase := []byte(`
+apiVersion: security-profiles-operator.x-k8s.io/v1
+kind: SeccompProfile
+metadata:
+  name: profile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  baseProfileName: base
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - write
+`)
+
+	seccompBaseProfile := []byte(`
+apiVersion: security-profiles-operator.x-k8s.io/v1
+kind: SeccompProfile
+metadata:
+  name: base
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - read
+`)
+
+	seccompProfileWithOCIBase := []byte(`
+apiVersion: security-profiles-operator.x-k8s.io/v1
+kind: SeccompProfile
+metadata:
+  name: profile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  baseProfileName: oci://registry.local/base:v1
+`)
+
+	seccompProfileInvalidNotify := []byte(`
+apiVersion: security-profiles-operator.x-k8s.io/v1
+kind: SeccompProfile
+metadata:
+  name: profile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  syscalls:
+  - action: SCMP_ACT_NOTIFY
+    names:
+    - mount
+  notify:
+    defaultAction: Deny
+    rules:
+    - names:
+      - mount
+      action: Allow
+      args:
+      - index: 1
+        patterns:
+        - /data/*
+`)
+
 	for _, tc := range []struct {
 		name    string
 		prepare func(*installerfakes.FakeImpl) *Options
@@ -92,14 +156,125 @@ func TestRun(t *testing.T) {
 			},
 		},
 		{
-			name: "unsupported profile type",
+			name: "successful seccomp install",
 			prepare: func(mock *installerfakes.FakeImpl) *Options {
 				mock.ReadFileReturns(seccompProfile, nil)
 
+				return &Options{
+					ProfilePath:       "/foo",
+					SeccompProfileDir: "/bar",
+				}
+			},
+			assert: func(mock *installerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				dir, _ := mock.MkdirAllArgsForCall(0)
+				require.Equal(t, "/bar", dir)
+				path, content, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, "/bar/profile-block-all.json", path)
+				require.JSONEq(t, `{"defaultAction":"SCMP_ACT_ERRNO"}`, string(content))
+			},
+		},
+		{
+			name: "successful seccomp install with base profile",
+			prepare: func(mock *installerfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, seccompProfileWithBase, nil)
+				mock.ReadFileReturnsOnCall(1, seccompBaseProfile, nil)
+
+				return &Options{
+					ProfilePath:       "/foo/profile.yaml",
+					SeccompProfileDir: "/bar",
+				}
+			},
+			assert: func(mock *installerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "/foo/base.yaml", mock.ReadFileArgsForCall(1))
+				path, content, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, "/bar/profile.json", path)
+				require.JSONEq(t,
+					`{"defaultAction":"SCMP_ACT_ERRNO","baseProfileName":"base",`+
+						`"syscalls":[{"names":["read"],"action":"SCMP_ACT_ALLOW"},`+
+						`{"names":["write"],"action":"SCMP_ACT_ALLOW"}]}`,
+					string(content),
+				)
+			},
+		},
+		{
+			name: "seccomp base profile not found",
+			prepare: func(mock *installerfakes.FakeImpl) *Options {
+				mock.ReadFileReturnsOnCall(0, seccompProfileWithBase, nil)
+				mock.ReadFileReturnsOnCall(1, nil, os.ErrNotExist)
+
+				return defaultOptions()
+			},
+			assert: func(mock *installerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, os.ErrNotExist)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "seccomp base profile pull options",
+			prepare: func(mock *installerfakes.FakeImpl) *Options {
+				mock.ReadFileReturns(seccompProfileWithOCIBase, nil)
+				mock.PullReturns(&artifact.PullResult{}, nil)
+
+				return &Options{
+					ProfilePath:                  "/foo",
+					SeccompProfileDir:            "/bar",
+					DisableSignatureVerification: true,
+					PlainHTTP:                    true,
+				}
+			},
+			assert: func(mock *installerfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "is not a seccomp profile")
+				require.Zero(t, mock.WriteFileCallCount())
+
+				from, _, signOpts, regOpts := mock.PullArgsForCall(0)
+				require.Equal(t, "registry.local/base:v1", from)
+				require.True(t, signOpts.DisableSignatureVerification)
+				require.True(t, regOpts.PlainHTTP)
+				require.False(t, regOpts.InsecureSkipTLSVerify)
+			},
+		},
+		{
+			name: "invalid seccomp profile",
+			prepare: func(mock *installerfakes.FakeImpl) *Options {
+				mock.ReadFileReturns(seccompProfileInvalidNotify, nil)
+
+				return defaultOptions()
+			},
+			assert: func(mock *installerfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "patterns are only supported")
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "successful selinux install",
+			prepare: func(mock *installerfakes.FakeImpl) *Options {
+				mock.ReadFileReturns(selinuxProfile, nil)
+				mock.MkdirTempReturns("/tmp/test", nil)
+
+				return defaultOptions()
+			},
+			assert: func(mock *installerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				path, content, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, "/tmp/test/errorlogger.cil", path)
+				require.Contains(t, string(content), "(block errorlogger")
+				require.Equal(t, []string{"-i", "/tmp/test/errorlogger.cil"}, mock.SemoduleArgsForCall(0))
+				require.Equal(t, "/tmp/test", mock.RemoveAllArgsForCall(0))
+			},
+		},
+		{
+			name: "selinux install failed",
+			prepare: func(mock *installerfakes.FakeImpl) *Options {
+				mock.ReadFileReturns(selinuxProfile, nil)
+				mock.SemoduleReturns(errors.New("policy syntax error"))
+
 				return defaultOptions()
 			},
 			assert: func(mock *installerfakes.FakeImpl, err error) {
-				require.ErrorContains(t, err, "cannot install")
+				require.ErrorContains(t, err, "policy syntax error")
+				require.Equal(t, 1, mock.RemoveAllCallCount())
 			},
 		},
 		{
@@ -18,9 +18,12 @@ limitations under the License.
 package installerfakes
 
 import (
+	"os"
 	"sync"
 
+	v1a "github.com/opencontainers/image-spec/specs-go/v1"
 	v1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 )
 
@@ -50,6 +53,48 @@ type FakeImpl struct {
 		result1 bool
 		result2 error
 	}
+	MkdirAllStub        func(string, os.FileMode) error
+	mkdirAllMutex       sync.RWMutex
+	mkdirAllArgsForCall []struct {
+		arg1 string
+		arg2 os.FileMode
+	}
+	mkdirAllReturns struct {
+		result1 error
+	}
+	mkdirAllReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MkdirTempStub        func(string, string) (string, error)
+	mkdirTempMutex       sync.RWMutex
+	mkdirTempArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	mkdirTempReturns struct {
+		result1 string
+		result2 error
+	}
+	mkdirTempReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	PullStub        func(string, *v1a.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)
+	pullMutex       sync.RWMutex
+	pullArgsForCall []struct {
+		arg1 string
+		arg2 *v1a.Platform
+		arg3 *artifact.PullSignatureOptions
+		arg4 *artifact.RegistryOptions
+	}
+	pullReturns struct {
+		result1 *artifact.PullResult
+		result2 error
+	}
+	pullReturnsOnCall map[int]struct {
+		result1 *artifact.PullResult
+		result2 error
+	}
 	ReadFileStub        func(string) ([]byte, error)
 	readFileMutex       sync.RWMutex
 	readFileArgsForCall []struct {
@@ -63,6 +108,41 @@ type FakeImpl struct {
 		result1 []byte
 		result2 error
 	}
+	RemoveAllStub        func(string) error
+	removeAllMutex       sync.RWMutex
+	removeAllArgsForCall []struct {
+		arg1 string
+	}
+	removeAllReturns struct {
+		result1 error
+	}
+	removeAllReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SemoduleStub        func(...string) error
+	semoduleMutex       sync.RWMutex
+	semoduleArgsForCall []struct {
+		arg1 []string
+	}
+	semoduleReturns struct {
+		result1 error
+	}
+	semoduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteFileStub        func(string, []byte, os.FileMode) error
+	writeFileMutex       sync.RWMutex
+	writeFileArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 os.FileMode
+	}
+	writeFileReturns struct {
+		result1 error
+	}
+	writeFileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -193,6 +273,200 @@ func (fake *FakeImpl) AppArmorInstallProfileReturnsOnCall(i int, result1 bool, r
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) MkdirAll(arg1 string, arg2 os.FileMode) error {
+	fake.mkdirAllMutex.Lock()
+	ret, specificReturn := fake.mkdirAllReturnsOnCall[len(fake.mkdirAllArgsForCall)]
+	fake.mkdirAllArgsForCall = append(fake.mkdirAllArgsForCall, struct {
+		arg1 string
+		arg2 os.FileMode
+	}{arg1, arg2})
+	stub := fake.MkdirAllStub
+	fakeReturns := fake.mkdirAllReturns
+	fake.recordInvocation("MkdirAll", []interface{}{arg1, arg2})
+	fake.mkdirAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) MkdirAllCallCount() int {
+	fake.mkdirAllMutex.RLock()
+	defer fake.mkdirAllMutex.RUnlock()
+	return len(fake.mkdirAllArgsForCall)
+}
+
+func (fake *FakeImpl) MkdirAllCalls(stub func(string, os.FileMode) error) {
+	fake.mkdirAllMutex.Lock()
+	defer fake.mkdirAllMutex.Unlock()
+	fake.MkdirAllStub = stub
+}
+
+func (fake *FakeImpl) MkdirAllArgsForCall(i int) (string, os.FileMode) {
+	fake.mkdirAllMutex.RLock()
+	defer fake.mkdirAllMutex.RUnlock()
+	argsForCall := fake.mkdirAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) MkdirAllReturns(result1 error) {
+	fake.mkdirAllMutex.Lock()
+	defer fake.mkdirAllMutex.Unlock()
+	fake.MkdirAllStub = nil
+	fake.mkdirAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) MkdirAllReturnsOnCall(i int, result1 error) {
+	fake.mkdirAllMutex.Lock()
+	defer fake.mkdirAllMutex.Unlock()
+	fake.MkdirAllStub = nil
+	if fake.mkdirAllReturnsOnCall == nil {
+		fake.mkdirAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.mkdirAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) MkdirTemp(arg1 string, arg2 string) (string, error) {
+	fake.mkdirTempMutex.Lock()
+	ret, specificReturn := fake.mkdirTempReturnsOnCall[len(fake.mkdirTempArgsForCall)]
+	fake.mkdirTempArgsForCall = append(fake.mkdirTempArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.MkdirTempStub
+	fakeReturns := fake.mkdirTempReturns
+	fake.recordInvocation("MkdirTemp", []interface{}{arg1, arg2})
+	fake.mkdirTempMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) MkdirTempCallCount() int {
+	fake.mkdirTempMutex.RLock()
+	defer fake.mkdirTempMutex.RUnlock()
+	return len(fake.mkdirTempArgsForCall)
+}
+
+func (fake *FakeImpl) MkdirTempCalls(stub func(string, string) (string, error)) {
+	fake.mkdirTempMutex.Lock()
+	defer fake.mkdirTempMutex.Unlock()
+	fake.MkdirTempStub = stub
+}
+
+func (fake *FakeImpl) MkdirTempArgsForCall(i int) (string, string) {
+	fake.mkdirTempMutex.RLock()
+	defer fake.mkdirTempMutex.RUnlock()
+	argsForCall := fake.mkdirTempArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) MkdirTempReturns(result1 string, result2 error) {
+	fake.mkdirTempMutex.Lock()
+	defer fake.mkdirTempMutex.Unlock()
+	fake.MkdirTempStub = nil
+	fake.mkdirTempReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) MkdirTempReturnsOnCall(i int, result1 string, result2 error) {
+	fake.mkdirTempMutex.Lock()
+	defer fake.mkdirTempMutex.Unlock()
+	fake.MkdirTempStub = nil
+	if fake.mkdirTempReturnsOnCall == nil {
+		fake.mkdirTempReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.mkdirTempReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) Pull(arg1 string, arg2 *v1a.Platform, arg3 *artifact.PullSignatureOptions, arg4 *artifact.RegistryOptions) (*artifact.PullResult, error) {
+	fake.pullMutex.Lock()
+	ret, specificReturn := fake.pullReturnsOnCall[len(fake.pullArgsForCall)]
+	fake.pullArgsForCall = append(fake.pullArgsForCall, struct {
+		arg1 string
+		arg2 *v1a.Platform
+		arg3 *artifact.PullSignatureOptions
+		arg4 *artifact.RegistryOptions
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PullStub
+	fakeReturns := fake.pullReturns
+	fake.recordInvocation("Pull", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pullMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) PullCallCount() int {
+	fake.pullMutex.RLock()
+	defer fake.pullMutex.RUnlock()
+	return len(fake.pullArgsForCall)
+}
+
+func (fake *FakeImpl) PullCalls(stub func(string, *v1a.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)) {
+	fake.pullMutex.Lock()
+	defer fake.pullMutex.Unlock()
+	fake.PullStub = stub
+}
+
+func (fake *FakeImpl) PullArgsForCall(i int) (string, *v1a.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) {
+	fake.pullMutex.RLock()
+	defer fake.pullMutex.RUnlock()
+	argsForCall := fake.pullArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImpl) PullReturns(result1 *artifact.PullResult, result2 error) {
+	fake.pullMutex.Lock()
+	defer fake.pullMutex.Unlock()
+	fake.PullStub = nil
+	fake.pullReturns = struct {
+		result1 *artifact.PullResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) PullReturnsOnCall(i int, result1 *artifact.PullResult, result2 error) {
+	fake.pullMutex.Lock()
+	defer fake.pullMutex.Unlock()
+	fake.PullStub = nil
+	if fake.pullReturnsOnCall == nil {
+		fake.pullReturnsOnCall = make(map[int]struct {
+			result1 *artifact.PullResult
+			result2 error
+		})
+	}
+	fake.pullReturnsOnCall[i] = struct {
+		result1 *artifact.PullResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ReadFile(arg1 string) ([]byte, error) {
 	fake.readFileMutex.Lock()
 	ret, specificReturn := fake.readFileReturnsOnCall[len(fake.readFileArgsForCall)]
@@ -257,6 +531,196 @@ func (fake *FakeImpl) ReadFileReturnsOnCall(i int, result1 []byte, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) RemoveAll(arg1 string) error {
+	fake.removeAllMutex.Lock()
+	ret, specificReturn := fake.removeAllReturnsOnCall[len(fake.removeAllArgsForCall)]
+	fake.removeAllArgsForCall = append(fake.removeAllArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveAllStub
+	fakeReturns := fake.removeAllReturns
+	fake.recordInvocation("RemoveAll", []interface{}{arg1})
+	fake.removeAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) RemoveAllCallCount() int {
+	fake.removeAllMutex.RLock()
+	defer fake.removeAllMutex.RUnlock()
+	return len(fake.removeAllArgsForCall)
+}
+
+func (fake *FakeImpl) RemoveAllCalls(stub func(string) error) {
+	fake.removeAllMutex.Lock()
+	defer fake.removeAllMutex.Unlock()
+	fake.RemoveAllStub = stub
+}
+
+func (fake *FakeImpl) RemoveAllArgsForCall(i int) string {
+	fake.removeAllMutex.RLock()
+	defer fake.removeAllMutex.RUnlock()
+	argsForCall := fake.removeAllArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) RemoveAllReturns(result1 error) {
+	fake.removeAllMutex.Lock()
+	defer fake.removeAllMutex.Unlock()
+	fake.RemoveAllStub = nil
+	fake.removeAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) RemoveAllReturnsOnCall(i int, result1 error) {
+	fake.removeAllMutex.Lock()
+	defer fake.removeAllMutex.Unlock()
+	fake.RemoveAllStub = nil
+	if fake.removeAllReturnsOnCall == nil {
+		fake.removeAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) Semodule(arg1 ...string) error {
+	fake.semoduleMutex.Lock()
+	ret, specificReturn := fake.semoduleReturnsOnCall[len(fake.semoduleArgsForCall)]
+	fake.semoduleArgsForCall = append(fake.semoduleArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.SemoduleStub
+	fakeReturns := fake.semoduleReturns
+	fake.recordInvocation("Semodule", []interface{}{arg1})
+	fake.semoduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SemoduleCallCount() int {
+	fake.semoduleMutex.RLock()
+	defer fake.semoduleMutex.RUnlock()
+	return len(fake.semoduleArgsForCall)
+}
+
+func (fake *FakeImpl) SemoduleCalls(stub func(...string) error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = stub
+}
+
+func (fake *FakeImpl) SemoduleArgsForCall(i int) []string {
+	fake.semoduleMutex.RLock()
+	defer fake.semoduleMutex.RUnlock()
+	argsForCall := fake.semoduleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) SemoduleReturns(result1 error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = nil
+	fake.semoduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SemoduleReturnsOnCall(i int, result1 error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = nil
+	if fake.semoduleReturnsOnCall == nil {
+		fake.semoduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.semoduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 os.FileMode) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeFileMutex.Lock()
+	ret, specificReturn := fake.writeFileReturnsOnCall[len(fake.writeFileArgsForCall)]
+	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 os.FileMode
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteFileStub
+	fakeReturns := fake.writeFileReturns
+	fake.recordInvocation("WriteFile", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) WriteFileCallCount() int {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	return len(fake.writeFileArgsForCall)
+}
+
+func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, os.FileMode) error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = stub
+}
+
+func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, os.FileMode) {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	argsForCall := fake.writeFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) WriteFileReturns(result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	fake.writeFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WriteFileReturnsOnCall(i int, result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	if fake.writeFileReturnsOnCall == nil {
+		fake.writeFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
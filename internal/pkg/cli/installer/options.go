@@ -24,14 +24,19 @@ import (
 
 // Options define all possible options for the puller.
 type Options struct {
-	ProfilePath    string
-	ExecutablePath string
+	ProfilePath                  string
+	ExecutablePath               string
+	SeccompProfileDir            string
+	DisableSignatureVerification bool
+	PlainHTTP                    bool
+	Insecure                     bool
 }
 
 // Default returns a default options instance.
 func Default() *Options {
 	return &Options{
-		ProfilePath: DefaultProfileFile,
+		ProfilePath:       DefaultProfileFile,
+		SeccompProfileDir: DefaultSeccompProfileDir,
 	}
 }
 
@@ -53,5 +58,25 @@ func FromContext(ctx *ucli.Context) (*Options, error) {
 		return nil, errors.New("too many arguments")
 	}
 
+	if ctx.IsSet(FlagSeccompProfileDir) {
+		options.SeccompProfileDir = ctx.String(FlagSeccompProfileDir)
+	}
+
+	if options.SeccompProfileDir == "" {
+		return nil, errors.New("no seccomp profile directory provided")
+	}
+
+	if ctx.IsSet(FlagDisableSignatureVerification) {
+		options.DisableSignatureVerification = ctx.Bool(FlagDisableSignatureVerification)
+	}
+
+	if ctx.IsSet(FlagPlainHTTP) {
+		options.PlainHTTP = ctx.Bool(FlagPlainHTTP)
+	}
+
+	if ctx.IsSet(FlagInsecure) {
+		options.Insecure = ctx.Bool(FlagInsecure)
+	}
+
 	return options, nil
 }
@@ -59,6 +59,45 @@ func TestFromContext(t *testing.T) {
 				require.NoError(t, err)
 				require.Empty(t, options.ExecutablePath)
 				require.Equal(t, DefaultProfileFile, options.ProfilePath)
+				require.Equal(t, DefaultSeccompProfileDir, options.SeccompProfileDir)
+			},
+		},
+		{ // Success: seccomp profile dir and signature verification specified
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagSeccompProfileDir, "", "")
+				set.Bool(FlagDisableSignatureVerification, false, "")
+				require.NoError(t, set.Set(FlagSeccompProfileDir, "/etc/seccomp"))
+				require.NoError(t, set.Set(FlagDisableSignatureVerification, "true"))
+				require.NoError(t, set.Parse([]string{"profile.yml"}))
+			},
+			assert: func(options *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "/etc/seccomp", options.SeccompProfileDir)
+				require.True(t, options.DisableSignatureVerification)
+			},
+		},
+		{ // Success: registry options specified
+			prepare: func(set *flag.FlagSet) {
+				set.Bool(FlagPlainHTTP, false, "")
+				set.Bool(FlagInsecure, false, "")
+				require.NoError(t, set.Set(FlagPlainHTTP, "true"))
+				require.NoError(t, set.Set(FlagInsecure, "true"))
+				require.NoError(t, set.Parse([]string{"profile.yml"}))
+			},
+			assert: func(options *Options, err error) {
+				require.NoError(t, err)
+				require.True(t, options.PlainHTTP)
+				require.True(t, options.Insecure)
+			},
+		},
+		{ // failure: empty seccomp profile dir
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagSeccompProfileDir, "", "")
+				require.NoError(t, set.Set(FlagSeccompProfileDir, ""))
+				require.NoError(t, set.Parse([]string{"profile.yml"}))
+			},
+			assert: func(options *Options, err error) {
+				require.Error(t, err)
 			},
 		},
 		{ // failure: too many args
@@ -29,6 +29,18 @@ const (
 	// authentication.
 	FlagUsername string = cli.FlagUsername
 
+	// FlagPlainHTTP is the flag for connecting to the registry via HTTP
+	// instead of HTTPS.
+	FlagPlainHTTP string = cli.FlagPlainHTTP
+
+	// FlagInsecure is the flag for skipping the verification of the registry
+	// TLS certificate.
+	FlagInsecure string = cli.FlagInsecure
+
+	// FlagCAFile is the flag for defining the file containing the PEM encoded
+	// CA certificates to verify the registry TLS certificate.
+	FlagCAFile string = cli.FlagCAFile
+
 	// FlagPlatform is the flag for defining the platform.
 	FlagPlatform string = "platform"
 
@@ -43,4 +55,12 @@ const (
 	// FlagAllowedOidcIssuerRegexp is the flag for defining the allowed Oidc issuers
 	// regexp when verifying the image signature.
 	FlagAllowedOidcIssuerRegexp string = "allowed-oidc-issuer-regexp"
+
+	// FlagPublicKey is the flag for defining the file containing the PEM
+	// encoded public keys to verify the image signature.
+	FlagPublicKey string = "public-key"
+
+	// FlagIgnoreTlog is the flag for skipping the transparency log
+	// verification of the image signature.
+	FlagIgnoreTlog string = "ignore-tlog"
 )
@@ -32,18 +32,28 @@ type defaultImpl struct{}
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
 //counterfeiter:generate . impl
 type impl interface {
-	Pull(string, string, string, *v1.Platform, *artifact.PullSignatureOptions) (*artifact.PullResult, error)
+	Pull(
+		string, string, string, *v1.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions,
+	) (*artifact.PullResult, error)
 	WriteFile(string, []byte, os.FileMode) error
+	ReadFile(string) ([]byte, error)
 }
 
 func (*defaultImpl) Pull(
-	from, username, password string, platform *v1.Platform, signOpts *artifact.PullSignatureOptions,
+	from, username, password string,
+	platform *v1.Platform,
+	signOpts *artifact.PullSignatureOptions,
+	regOpts *artifact.RegistryOptions,
 ) (*artifact.PullResult, error) {
 	return artifact.New(logr.New(&cli.LogSink{})).Pull(
-		context.Background(), from, username, password, platform, signOpts,
+		context.Background(), from, username, password, platform, signOpts, regOpts,
 	)
 }
 
 func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
 	return os.WriteFile(name, data, perm)
 }
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
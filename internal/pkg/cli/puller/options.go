@@ -37,6 +37,11 @@ type Options struct {
 	disableSignatureVerification bool
 	allowedIdentityRegexp        string
 	allowedOidcIssuerRegexp      string
+	publicKeyFile                string
+	ignoreTlog                   bool
+	plainHTTP                    bool
+	insecure                     bool
+	caFile                       string
 }
 
 // Default returns a default options instance.
@@ -83,6 +88,26 @@ func FromContext(ctx *ucli.Context) (*Options, error) {
 		options.allowedOidcIssuerRegexp = ctx.String(FlagAllowedOidcIssuerRegexp)
 	}
 
+	if ctx.IsSet(FlagPublicKey) {
+		options.publicKeyFile = ctx.String(FlagPublicKey)
+	}
+
+	if ctx.IsSet(FlagIgnoreTlog) {
+		options.ignoreTlog = ctx.Bool(FlagIgnoreTlog)
+	}
+
+	if ctx.IsSet(FlagPlainHTTP) {
+		options.plainHTTP = ctx.Bool(FlagPlainHTTP)
+	}
+
+	if ctx.IsSet(FlagInsecure) {
+		options.insecure = ctx.Bool(FlagInsecure)
+	}
+
+	if ctx.IsSet(FlagCAFile) {
+		options.caFile = ctx.String(FlagCAFile)
+	}
+
 	options.password = os.Getenv(cli.EnvKeyPassword)
 
 	platform, err := cli.ParsePlatform(ctx.String(FlagPlatform))
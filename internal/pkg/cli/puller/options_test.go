@@ -71,6 +71,39 @@ func TestFromContext(t *testing.T) {
 				require.Equal(t, "testOidc*", opts.allowedOidcIssuerRegexp)
 			},
 		},
+		{
+			name: "success with public key and ignore tlog",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagPublicKey, "", "")
+				set.Bool(FlagIgnoreTlog, false, "")
+				require.NoError(t, set.Set(FlagPublicKey, "cosign.pub"))
+				require.NoError(t, set.Set(FlagIgnoreTlog, "true"))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "cosign.pub", opts.publicKeyFile)
+				require.True(t, opts.ignoreTlog)
+			},
+		},
+		{
+			name: "success with registry options",
+			prepare: func(set *flag.FlagSet) {
+				set.Bool(FlagPlainHTTP, false, "")
+				set.Bool(FlagInsecure, false, "")
+				set.String(FlagCAFile, "", "")
+				require.NoError(t, set.Set(FlagPlainHTTP, "true"))
+				require.NoError(t, set.Set(FlagInsecure, "true"))
+				require.NoError(t, set.Set(FlagCAFile, "ca.pem"))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.True(t, opts.plainHTTP)
+				require.True(t, opts.insecure)
+				require.Equal(t, "ca.pem", opts.caFile)
+			},
+		},
 		{
 			name: "failure no image provided",
 			prepare: func(set *flag.FlagSet) {
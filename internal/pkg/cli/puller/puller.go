@@ -40,6 +40,40 @@ func New(options *Options) *Puller {
 
 // Run the Puller.
 func (p *Puller) Run() error {
+	signOpts := &artifact.PullSignatureOptions{
+		DisableSignatureVerification: p.options.disableSignatureVerification,
+		AllowedIdentityRegexp:        p.options.allowedIdentityRegexp,
+		AllowedOidcIssuerRegexp:      p.options.allowedOidcIssuerRegexp,
+		IgnoreTlog:                   p.options.ignoreTlog,
+	}
+
+	if p.options.publicKeyFile != "" {
+		log.Printf("Using public keys from: %s", p.options.publicKeyFile)
+
+		publicKeys, err := p.ReadFile(p.options.publicKeyFile)
+		if err != nil {
+			return fmt.Errorf("read public keys: %w", err)
+		}
+
+		signOpts.PublicKeys = publicKeys
+	}
+
+	regOpts := &artifact.RegistryOptions{
+		PlainHTTP:             p.options.plainHTTP,
+		InsecureSkipTLSVerify: p.options.insecure,
+	}
+
+	if p.options.caFile != "" {
+		log.Printf("Using CA certificates from: %s", p.options.caFile)
+
+		caBundle, err := p.ReadFile(p.options.caFile)
+		if err != nil {
+			return fmt.Errorf("read CA certificates: %w", err)
+		}
+
+		regOpts.CABundle = caBundle
+	}
+
 	log.Printf("Pulling profile from: %s", p.options.pullFrom)
 
 	result, err := p.Pull(
@@ -47,11 +81,8 @@ func (p *Puller) Run() error {
 		p.options.username,
 		p.options.password,
 		p.options.platform,
-		&artifact.PullSignatureOptions{
-			DisableSignatureVerification: p.options.disableSignatureVerification,
-			AllowedIdentityRegexp:        p.options.allowedIdentityRegexp,
-			AllowedOidcIssuerRegexp:      p.options.allowedOidcIssuerRegexp,
-		},
+		signOpts,
+		regOpts,
 	)
 	if err != nil {
 		return fmt.Errorf("pull profile: %w", err)
@@ -82,3 +82,57 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestRunPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	options := Default()
+	options.publicKeyFile = "cosign.pub"
+	options.ignoreTlog = true
+
+	mock := &pullerfakes.FakeImpl{}
+	mock.ReadFileReturns([]byte("key"), nil)
+	mock.PullReturns(&artifact.PullResult{}, nil)
+
+	sut := New(options)
+	sut.impl = mock
+
+	require.NoError(t, sut.Run())
+	require.Equal(t, "cosign.pub", mock.ReadFileArgsForCall(0))
+
+	_, _, _, _, signOpts, _ := mock.PullArgsForCall(0)
+	require.Equal(t, []byte("key"), signOpts.PublicKeys)
+	require.True(t, signOpts.IgnoreTlog)
+
+	mock.ReadFileReturns(nil, errTest)
+	require.ErrorIs(t, sut.Run(), errTest)
+	require.Equal(t, 1, mock.PullCallCount())
+}
+
+func TestRunRegistryOptions(t *testing.T) {
+	t.Parallel()
+
+	options := Default()
+	options.plainHTTP = true
+	options.insecure = true
+	options.caFile = "ca.pem"
+
+	mock := &pullerfakes.FakeImpl{}
+	mock.ReadFileReturns([]byte("ca"), nil)
+	mock.PullReturns(&artifact.PullResult{}, nil)
+
+	sut := New(options)
+	sut.impl = mock
+
+	require.NoError(t, sut.Run())
+	require.Equal(t, "ca.pem", mock.ReadFileArgsForCall(0))
+
+	_, _, _, _, _, regOpts := mock.PullArgsForCall(0)
+	require.True(t, regOpts.PlainHTTP)
+	require.True(t, regOpts.InsecureSkipTLSVerify)
+	require.Equal(t, []byte("ca"), regOpts.CABundle)
+
+	mock.ReadFileReturns(nil, errTest)
+	require.ErrorIs(t, sut.Run(), errTest)
+	require.Equal(t, 1, mock.PullCallCount())
+}
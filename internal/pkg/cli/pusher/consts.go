@@ -43,6 +43,18 @@ const (
 	// authentication.
 	FlagUsername string = cli.FlagUsername
 
+	// FlagPlainHTTP is the flag for connecting to the registry via HTTP
+	// instead of HTTPS.
+	FlagPlainHTTP string = cli.FlagPlainHTTP
+
+	// FlagInsecure is the flag for skipping the verification of the registry
+	// TLS certificate.
+	FlagInsecure string = cli.FlagInsecure
+
+	// FlagCAFile is the flag for defining the file containing the PEM encoded
+	// CA certificates to verify the registry TLS certificate.
+	FlagCAFile string = cli.FlagCAFile
+
 	// FlagAnnotations is the flag for setting custom annotations to the pushed
 	// artifact.
 	FlagAnnotations string = "annotations"
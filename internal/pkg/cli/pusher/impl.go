@@ -17,6 +17,8 @@ limitations under the License.
 package pusher
 
 import (
+	"os"
+
 	"github.com/go-logr/logr"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 
@@ -29,13 +31,19 @@ type defaultImpl struct{}
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
 //counterfeiter:generate . impl
 type impl interface {
-	Push(map[*v1.Platform]string, string, string, string, map[string]string) error
+	Push(map[*v1.Platform]string, string, string, string, map[string]string, *artifact.RegistryOptions) error
+	ReadFile(string) ([]byte, error)
 }
 
 func (*defaultImpl) Push(
 	files map[*v1.Platform]string,
 	to, username, password string,
 	annotations map[string]string,
+	regOpts *artifact.RegistryOptions,
 ) error {
-	return artifact.New(logr.New(&cli.LogSink{})).Push(files, to, username, password, annotations)
+	return artifact.New(logr.New(&cli.LogSink{})).Push(files, to, username, password, annotations, regOpts)
+}
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
 }
@@ -36,6 +36,9 @@ type Options struct {
 	username    string
 	password    string
 	annotations map[string]string
+	plainHTTP   bool
+	insecure    bool
+	caFile      string
 }
 
 // Default returns a default options instance.
@@ -99,6 +102,18 @@ func FromContext(ctx *ucli.Context) (*Options, error) {
 		options.username = ctx.String(FlagUsername)
 	}
 
+	if ctx.IsSet(FlagPlainHTTP) {
+		options.plainHTTP = ctx.Bool(FlagPlainHTTP)
+	}
+
+	if ctx.IsSet(FlagInsecure) {
+		options.insecure = ctx.Bool(FlagInsecure)
+	}
+
+	if ctx.IsSet(FlagCAFile) {
+		options.caFile = ctx.String(FlagCAFile)
+	}
+
 	options.password = os.Getenv(cli.EnvKeyPassword)
 	options.annotations = map[string]string{}
 
@@ -44,6 +44,24 @@ func TestFromContext(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "success with registry options",
+			prepare: func(set *flag.FlagSet) {
+				set.Bool(FlagPlainHTTP, false, "")
+				set.Bool(FlagInsecure, false, "")
+				set.String(FlagCAFile, "", "")
+				require.NoError(t, set.Set(FlagPlainHTTP, "true"))
+				require.NoError(t, set.Set(FlagInsecure, "true"))
+				require.NoError(t, set.Set(FlagCAFile, "ca.pem"))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.True(t, opts.plainHTTP)
+				require.True(t, opts.insecure)
+				require.Equal(t, "ca.pem", opts.caFile)
+			},
+		},
 		{
 			name: "success with annotations",
 			prepare: func(set *flag.FlagSet) {
@@ -19,6 +19,8 @@ package pusher
 import (
 	"fmt"
 	"log"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 )
 
 // Pusher is the main structure of this package.
@@ -37,6 +39,22 @@ func New(options *Options) *Pusher {
 
 // Run the Pusher.
 func (p *Pusher) Run() error {
+	regOpts := &artifact.RegistryOptions{
+		PlainHTTP:             p.options.plainHTTP,
+		InsecureSkipTLSVerify: p.options.insecure,
+	}
+
+	if p.options.caFile != "" {
+		log.Printf("Using CA certificates from: %s", p.options.caFile)
+
+		caBundle, err := p.ReadFile(p.options.caFile)
+		if err != nil {
+			return fmt.Errorf("read CA certificates: %w", err)
+		}
+
+		regOpts.CABundle = caBundle
+	}
+
 	log.Printf("Pushing profiles to: %s", p.options.pushTo)
 
 	if err := p.Push(
@@ -45,6 +63,7 @@ func (p *Pusher) Run() error {
 		p.options.username,
 		p.options.password,
 		p.options.annotations,
+		regOpts,
 	); err != nil {
 		return fmt.Errorf("push profile: %w", err)
 	}
@@ -69,3 +69,30 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestRunRegistryOptions(t *testing.T) {
+	t.Parallel()
+
+	options := Default()
+	options.plainHTTP = true
+	options.insecure = true
+	options.caFile = "ca.pem"
+
+	mock := &pusherfakes.FakeImpl{}
+	mock.ReadFileReturns([]byte("ca"), nil)
+
+	sut := New(options)
+	sut.impl = mock
+
+	require.NoError(t, sut.Run())
+	require.Equal(t, "ca.pem", mock.ReadFileArgsForCall(0))
+
+	_, _, _, _, _, regOpts := mock.PushArgsForCall(0)
+	require.True(t, regOpts.PlainHTTP)
+	require.True(t, regOpts.InsecureSkipTLSVerify)
+	require.Equal(t, []byte("ca"), regOpts.CABundle)
+
+	mock.ReadFileReturns(nil, errTest)
+	require.ErrorIs(t, sut.Run(), errTest)
+	require.Equal(t, 1, mock.PushCallCount())
+}
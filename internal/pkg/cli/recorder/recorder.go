@@ -89,6 +89,7 @@ func (r *Recorder) Run() error {
 		logr.New(&cli.LogSink{}),
 		recordSeccomp,
 		recordAppArmor,
+		false,
 	)
 
 	if err := r.LoadBpfRecorder(r.bpfRecorder); err != nil {
@@ -288,7 +289,8 @@ func (r *Recorder) generateAppArmorProfile(mntns uint32) apparmorprofileapi.AppA
 		abstract.Filesystem = &files
 	}
 
-	if processed.Socket.UseRaw || processed.Socket.UseTCP || processed.Socket.UseUDP {
+	if processed.Socket.UseRaw || processed.Socket.UseTCP || processed.Socket.UseUDP ||
+		len(processed.Socket.Rules) != 0 {
 		net := apparmorprofileapi.AppArmorNetworkRules{}
 		proto := apparmorprofileapi.AppArmorAllowedProtocols{}
 
@@ -306,6 +308,13 @@ func (r *Recorder) generateAppArmorProfile(mntns uint32) apparmorprofileapi.AppA
 			net.Protocols = &proto
 		}
 
+		for _, rule := range processed.Socket.Rules {
+			net.Rules = append(net.Rules, apparmorprofileapi.AppArmorNetworkRule{
+				Family: rule.Family,
+				Type:   rule.Type,
+			})
+		}
+
 		abstract.Network = &net
 	}
 
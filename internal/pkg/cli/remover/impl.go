@@ -17,7 +17,9 @@ limitations under the License.
 package remover
 
 import (
+	"fmt"
 	"os"
+	"os/exec"
 
 	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
@@ -31,6 +33,8 @@ type impl interface {
 	ReadFile(string) ([]byte, error)
 	AppArmorEnabled(manager apparmorprofile.ProfileManager) bool
 	AppArmorRemoveProfile(manager apparmorprofile.ProfileManager, p profilebaseapi.StatusBaseUser) error
+	Remove(string) error
+	Semodule(args ...string) error
 }
 
 func (*defaultImpl) ReadFile(name string) ([]byte, error) {
@@ -47,3 +51,16 @@ func (*defaultImpl) AppArmorRemoveProfile(
 ) error {
 	return manager.RemoveProfile(p)
 }
+
+func (*defaultImpl) Remove(name string) error {
+	return os.Remove(name)
+}
+
+func (*defaultImpl) Semodule(args ...string) error {
+	out, err := exec.Command("semodule", args...).CombinedOutput() //nolint:gosec // arguments are trusted
+	if err != nil {
+		return fmt.Errorf("run semodule: %w: %s", err, out)
+	}
+
+	return nil
+}
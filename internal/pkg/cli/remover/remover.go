@@ -23,6 +23,8 @@ import (
 	"github.com/go-logr/logr"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/installer"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
@@ -74,6 +76,19 @@ func (p *Remover) Run() error {
 		if err := p.AppArmorRemoveProfile(manager, obj); err != nil {
 			return fmt.Errorf("remove apparmor profile: %w", err)
 		}
+	case *seccompprofileapi.SeccompProfile:
+		profilePath := installer.SeccompProfilePath(obj, p.options)
+		p.logger.Info("Removing seccomp profile", "profilePath", profilePath)
+
+		if err := p.Remove(profilePath); err != nil {
+			return fmt.Errorf("remove seccomp profile: %w", err)
+		}
+	case *selinuxprofileapi.SelinuxProfile:
+		p.logger.Info("Removing SELinux profile", "module", obj.GetPolicyName())
+
+		if err := p.Semodule("-r", obj.GetPolicyName()); err != nil {
+			return fmt.Errorf("remove selinux profile: %w", err)
+		}
 	default:
 		return fmt.Errorf("cannot remove %T profile", obj)
 	}
@@ -46,6 +46,9 @@ func TestRun(t *testing.T) {
 	seccompProfile, err := os.ReadFile("../../../../examples/seccompprofile.yaml")
 	require.NoError(t, err)
 
+	selinuxProfile, err := os.ReadFile("../../../../examples/selinuxprofile.yaml")
+	require.NoError(t, err)
+
 	for _, tc := range []struct {
 		name    string
 		prepare func(*removerfakes.FakeImpl) *installer.Options
@@ -93,14 +96,54 @@ func TestRun(t *testing.T) {
 			},
 		},
 		{
-			name: "unsupported profile type",
+			name: "successful seccomp removal",
 			prepare: func(mock *removerfakes.FakeImpl) *installer.Options {
 				mock.ReadFileReturns(seccompProfile, nil)
 
+				return &installer.Options{
+					ProfilePath:       "/foo",
+					SeccompProfileDir: "/bar",
+				}
+			},
+			assert: func(mock *removerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "/bar/profile-block-all.json", mock.RemoveArgsForCall(0))
+			},
+		},
+		{
+			name: "seccomp remove failed",
+			prepare: func(mock *removerfakes.FakeImpl) *installer.Options {
+				mock.ReadFileReturns(seccompProfile, nil)
+				mock.RemoveReturns(os.ErrNotExist)
+
+				return defaultOptions()
+			},
+			assert: func(mock *removerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, os.ErrNotExist)
+			},
+		},
+		{
+			name: "successful selinux removal",
+			prepare: func(mock *removerfakes.FakeImpl) *installer.Options {
+				mock.ReadFileReturns(selinuxProfile, nil)
+
+				return defaultOptions()
+			},
+			assert: func(mock *removerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"-r", "errorlogger"}, mock.SemoduleArgsForCall(0))
+			},
+		},
+		{
+			name: "selinux remove failed",
+			prepare: func(mock *removerfakes.FakeImpl) *installer.Options {
+				mock.ReadFileReturns(selinuxProfile, nil)
+				mock.SemoduleReturns(errors.New("module not found"))
+
 				return defaultOptions()
 			},
 			assert: func(mock *removerfakes.FakeImpl, err error) {
-				require.ErrorContains(t, err, "cannot remove")
+				require.ErrorContains(t, err, "module not found")
 			},
 		},
 		{
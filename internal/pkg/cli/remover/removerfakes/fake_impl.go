@@ -61,6 +61,28 @@ type FakeImpl struct {
 		result1 []byte
 		result2 error
 	}
+	RemoveStub        func(string) error
+	removeMutex       sync.RWMutex
+	removeArgsForCall []struct {
+		arg1 string
+	}
+	removeReturns struct {
+		result1 error
+	}
+	removeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SemoduleStub        func(...string) error
+	semoduleMutex       sync.RWMutex
+	semoduleArgsForCall []struct {
+		arg1 []string
+	}
+	semoduleReturns struct {
+		result1 error
+	}
+	semoduleReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -252,6 +274,128 @@ func (fake *FakeImpl) ReadFileReturnsOnCall(i int, result1 []byte, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) Remove(arg1 string) error {
+	fake.removeMutex.Lock()
+	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
+	fake.removeArgsForCall = append(fake.removeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveStub
+	fakeReturns := fake.removeReturns
+	fake.recordInvocation("Remove", []interface{}{arg1})
+	fake.removeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) RemoveCallCount() int {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	return len(fake.removeArgsForCall)
+}
+
+func (fake *FakeImpl) RemoveCalls(stub func(string) error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = stub
+}
+
+func (fake *FakeImpl) RemoveArgsForCall(i int) string {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	argsForCall := fake.removeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) RemoveReturns(result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	fake.removeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) RemoveReturnsOnCall(i int, result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	if fake.removeReturnsOnCall == nil {
+		fake.removeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) Semodule(arg1 ...string) error {
+	fake.semoduleMutex.Lock()
+	ret, specificReturn := fake.semoduleReturnsOnCall[len(fake.semoduleArgsForCall)]
+	fake.semoduleArgsForCall = append(fake.semoduleArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.SemoduleStub
+	fakeReturns := fake.semoduleReturns
+	fake.recordInvocation("Semodule", []interface{}{arg1})
+	fake.semoduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SemoduleCallCount() int {
+	fake.semoduleMutex.RLock()
+	defer fake.semoduleMutex.RUnlock()
+	return len(fake.semoduleArgsForCall)
+}
+
+func (fake *FakeImpl) SemoduleCalls(stub func(...string) error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = stub
+}
+
+func (fake *FakeImpl) SemoduleArgsForCall(i int) []string {
+	fake.semoduleMutex.RLock()
+	defer fake.semoduleMutex.RUnlock()
+	argsForCall := fake.semoduleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) SemoduleReturns(result1 error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = nil
+	fake.semoduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SemoduleReturnsOnCall(i int, result1 error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = nil
+	if fake.semoduleReturnsOnCall == nil {
+		fake.semoduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.semoduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -36,4 +36,12 @@ const (
 	// TypeSeccomp is the type indicating that we should run using a seccomp
 	// profile.
 	TypeSeccomp Type = "seccomp"
+
+	// TypeApparmor is the type indicating that we should run using an
+	// AppArmor profile.
+	TypeApparmor Type = "apparmor"
+
+	// TypeSelinux is the type indicating that we should run using a SELinux
+	// profile.
+	TypeSelinux Type = "selinux"
 )
@@ -20,8 +20,12 @@ package runner
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/nxadm/tail"
@@ -32,7 +36,9 @@ import (
 	libseccomp "github.com/seccomp/libseccomp-golang"
 	"sigs.k8s.io/yaml"
 
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/auditsource"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
@@ -57,6 +63,15 @@ type impl interface {
 	GetName(libseccomp.ScmpSyscall) (string, error)
 	PidLoad() uint32
 	Printf(format string, v ...any)
+	AppArmorEnabled(manager apparmorprofile.ProfileManager) bool
+	AppArmorInstallProfile(manager apparmorprofile.ProfileManager, p profilebaseapi.StatusBaseUser) (bool, error)
+	AppArmorRemoveProfile(manager apparmorprofile.ProfileManager, p profilebaseapi.StatusBaseUser) error
+	SelinuxEnabled() bool
+	MkdirTemp(string, string) (string, error)
+	WriteFile(string, []byte, os.FileMode) error
+	RemoveAll(string) error
+	Semodule(args ...string) error
+	SetExecLabel(lsm, label string) error
 }
 
 func (*defaultImpl) ReadFile(name string) ([]byte, error) {
@@ -118,3 +133,70 @@ func (*defaultImpl) PidLoad() uint32 {
 func (*defaultImpl) Printf(format string, v ...any) {
 	log.Printf(format, v...)
 }
+
+func (*defaultImpl) AppArmorEnabled(manager apparmorprofile.ProfileManager) bool {
+	return manager.Enabled()
+}
+
+func (*defaultImpl) AppArmorInstallProfile(
+	manager apparmorprofile.ProfileManager,
+	p profilebaseapi.StatusBaseUser,
+) (bool, error) {
+	return manager.InstallProfile(p)
+}
+
+func (*defaultImpl) AppArmorRemoveProfile(
+	manager apparmorprofile.ProfileManager,
+	p profilebaseapi.StatusBaseUser,
+) error {
+	return manager.RemoveProfile(p)
+}
+
+func (*defaultImpl) SelinuxEnabled() bool {
+	_, err := os.Stat("/sys/fs/selinux/enforce")
+
+	return err == nil
+}
+
+func (*defaultImpl) MkdirTemp(dir, pattern string) (string, error) {
+	return os.MkdirTemp(dir, pattern)
+}
+
+func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
+func (*defaultImpl) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+func (*defaultImpl) Semodule(args ...string) error {
+	out, err := exec.Command("semodule", args...).CombinedOutput() //nolint:gosec // arguments are trusted
+	if err != nil {
+		return fmt.Errorf("run semodule: %w: %s", err, out)
+	}
+
+	return nil
+}
+
+// SetExecLabel sets the label the kernel applies to the next program executed
+// by the current thread. The LSM specific interface is preferred over the
+// shared one, which is only available for the major LSM.
+func (*defaultImpl) SetExecLabel(lsm, label string) error {
+	path := filepath.Join("/proc/thread-self/attr", lsm, "exec")
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		path = "/proc/thread-self/attr/exec"
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(label); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+
+	return nil
+}
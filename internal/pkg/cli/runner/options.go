@@ -57,7 +57,9 @@ func FromContext(ctx *cli.Context) (*Options, error) {
 		options.typ = Type(ctx.String(FlagType))
 	}
 
-	if options.typ != TypeSeccomp {
+	if options.typ != TypeSeccomp &&
+		options.typ != TypeApparmor &&
+		options.typ != TypeSelinux {
 		return nil, fmt.Errorf("unsupported %s: %s", FlagType, options.typ)
 	}
 
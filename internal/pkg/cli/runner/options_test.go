@@ -41,6 +41,28 @@ func TestFromContext(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "success apparmor type",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagType, "", "")
+				require.NoError(t, set.Set(FlagType, string(TypeApparmor)))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "success selinux type",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagType, "", "")
+				require.NoError(t, set.Set(FlagType, string(TypeSelinux)))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name:    "failure no command provided",
 			prepare: func(set *flag.FlagSet) {},
@@ -19,21 +19,38 @@ limitations under the License.
 package runner
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"path/filepath"
+	"runtime"
 	"sync/atomic"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/nxadm/tail"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	libseccomp "github.com/seccomp/libseccomp-golang"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+)
+
+const (
+	lsmApparmor = "apparmor"
+	lsmSelinux  = "selinux"
+
+	// selinuxContextFormat is the context of the confined command, which
+	// matches the one used for containers.
+	selinuxContextFormat = "system_u:system_r:%s:s0"
 )
 
 // Runner is the main structure of this package.
@@ -62,6 +79,19 @@ func (r *Runner) Run() error {
 		return fmt.Errorf("open profile: %w", err)
 	}
 
+	switch r.options.typ {
+	case TypeSeccomp:
+		return r.runSeccomp(content)
+	case TypeApparmor:
+		return r.runAppArmor(content)
+	case TypeSelinux:
+		return r.runSelinux(content)
+	}
+
+	return fmt.Errorf("unsupported %s: %s", FlagType, r.options.typ)
+}
+
+func (r *Runner) runSeccomp(content []byte) error {
 	if filepath.Ext(r.options.profile) != seccompprofileapi.ExtJSON {
 		log.Print("Assuming YAML profile")
 
@@ -70,6 +100,8 @@ func (r *Runner) Run() error {
 			return fmt.Errorf("unmarshal YAML profile: %w", err)
 		}
 
+		var err error
+
 		content, err = r.JSONMarshal(seccompProfile.Spec)
 		if err != nil {
 			return fmt.Errorf("remarshal JSON profile: %w", err)
@@ -96,6 +128,125 @@ func (r *Runner) Run() error {
 		return fmt.Errorf("init profile: %w", err)
 	}
 
+	return r.runCommand()
+}
+
+func (r *Runner) runAppArmor(content []byte) error {
+	profile := &apparmorprofileapi.AppArmorProfile{}
+	if err := r.YamlUnmarshal(content, profile); err != nil {
+		return fmt.Errorf("unmarshal YAML profile: %w", err)
+	}
+
+	manager := apparmorprofile.NewAppArmorProfileManager(logr.New(&cli.LogSink{}))
+	if !r.AppArmorEnabled(manager) {
+		return errors.New("insufficient permissions or AppArmor is unavailable")
+	}
+
+	// Treat the profile as new to refuse replacing (and later removing) an
+	// already loaded profile of the same name.
+	profile.Generation = 1
+
+	log.Printf("Load AppArmor profile %s", profile.GetProfileName())
+
+	if _, err := r.AppArmorInstallProfile(manager, profile); err != nil {
+		return fmt.Errorf("install apparmor profile: %w", err)
+	}
+
+	defer func() {
+		log.Printf("Unload AppArmor profile %s", profile.GetProfileName())
+
+		if err := r.AppArmorRemoveProfile(manager, profile); err != nil {
+			log.Printf("Unable to unload AppArmor profile: %v", err)
+		}
+	}()
+
+	go r.startEnricher()
+
+	return r.runCommandWithExecLabel(lsmApparmor, "exec "+profile.GetProfileName())
+}
+
+func (r *Runner) runSelinux(content []byte) error {
+	profile := &selinuxprofileapi.SelinuxProfile{}
+	if err := r.YamlUnmarshal(content, profile); err != nil {
+		return fmt.Errorf("unmarshal YAML profile: %w", err)
+	}
+
+	if !r.SelinuxEnabled() {
+		return errors.New("SELinux is not enabled")
+	}
+
+	systemInherits, err := translator.SystemInherits(profile)
+	if err != nil {
+		return fmt.Errorf("get inherited policies: %w", err)
+	}
+
+	policy, err := translator.Object2CIL(systemInherits, nil, profile, nil)
+	if err != nil {
+		return fmt.Errorf("translate profile to CIL: %w", err)
+	}
+
+	tempDir, err := r.MkdirTemp("", "spoc-run-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+
+	defer func() {
+		if err := r.RemoveAll(tempDir); err != nil {
+			log.Printf("Unable to remove temp dir: %v", err)
+		}
+	}()
+
+	// The module name is derived from the file name by semodule.
+	policyFile := filepath.Join(tempDir, profile.GetPolicyName()+".cil")
+	if err := r.WriteFile(policyFile, []byte(policy), 0o600); err != nil {
+		return fmt.Errorf("write CIL policy: %w", err)
+	}
+
+	log.Printf("Load SELinux module %s", profile.GetPolicyName())
+
+	if err := r.Semodule("-i", policyFile); err != nil {
+		return fmt.Errorf("install selinux module: %w", err)
+	}
+
+	defer func() {
+		log.Printf("Unload SELinux module %s", profile.GetPolicyName())
+
+		if err := r.Semodule("-r", profile.GetPolicyName()); err != nil {
+			log.Printf("Unable to unload SELinux module: %v", err)
+		}
+	}()
+
+	go r.startEnricher()
+
+	return r.runCommandWithExecLabel(
+		lsmSelinux, fmt.Sprintf(selinuxContextFormat, profile.GetPolicyUsage()),
+	)
+}
+
+// runCommandWithExecLabel runs the command confined by the provided LSM
+// label.
+func (r *Runner) runCommandWithExecLabel(lsm, label string) error {
+	errChan := make(chan error)
+
+	go func() {
+		// The exec label is a per thread attribute. We therefore lock the
+		// goroutine to its thread and never unlock it, which makes the
+		// runtime terminate the thread together with the goroutine.
+		runtime.LockOSThread()
+
+		if err := r.SetExecLabel(lsm, label); err != nil {
+			errChan <- fmt.Errorf("set exec label: %w", err)
+
+			return
+		}
+
+		errChan <- r.runCommand()
+	}()
+
+	return <-errChan
+}
+
+func (r *Runner) runCommand() error {
 	cmd := command.New(r.options.commandOptions)
 
 	newPid, err := r.CommandRun(cmd)
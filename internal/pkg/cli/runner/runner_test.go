@@ -20,11 +20,13 @@ package runner
 
 import (
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/nxadm/tail"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
 
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/runner/runnerfakes"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
@@ -138,6 +140,158 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestRunLSM(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testProfileName = "nginx"
+		testTempDir     = "/tmp/spoc-run-test"
+	)
+
+	for _, tc := range []struct {
+		name    string
+		typ     Type
+		prepare func(*runnerfakes.FakeImpl)
+		assert  func(*runnerfakes.FakeImpl, error)
+	}{
+		{
+			name: "success apparmor",
+			typ:  TypeApparmor,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.AppArmorEnabledReturns(true)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.AppArmorInstallProfileCallCount())
+				_, profile := mock.AppArmorInstallProfileArgsForCall(0)
+				require.Equal(t, testProfileName, profile.GetName())
+				require.Equal(t, 1, mock.AppArmorRemoveProfileCallCount())
+				lsm, label := mock.SetExecLabelArgsForCall(0)
+				require.Equal(t, "apparmor", lsm)
+				require.Equal(t, "exec "+testProfileName, label)
+			},
+		},
+		{
+			name: "failure apparmor not enabled",
+			typ:  TypeApparmor,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.AppArmorEnabledReturns(false)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.AppArmorInstallProfileCallCount())
+			},
+		},
+		{
+			name: "failure on AppArmorInstallProfile",
+			typ:  TypeApparmor,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.AppArmorEnabledReturns(true)
+				mock.AppArmorInstallProfileReturns(false, errTest)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.AppArmorRemoveProfileCallCount())
+			},
+		},
+		{
+			name: "failure on SetExecLabel for apparmor",
+			typ:  TypeApparmor,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.AppArmorEnabledReturns(true)
+				mock.SetExecLabelReturns(errTest)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.CommandRunCallCount())
+				require.Equal(t, 1, mock.AppArmorRemoveProfileCallCount())
+			},
+		},
+		{
+			name: "success selinux",
+			typ:  TypeSelinux,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.SelinuxEnabledReturns(true)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+				require.Equal(t, 2, mock.SemoduleCallCount())
+				policyFile, _, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, filepath.Join(testTempDir, testProfileName+".cil"), policyFile)
+				require.Equal(t, []string{"-i", policyFile}, mock.SemoduleArgsForCall(0))
+				require.Equal(t, []string{"-r", testProfileName}, mock.SemoduleArgsForCall(1))
+				lsm, label := mock.SetExecLabelArgsForCall(0)
+				require.Equal(t, "selinux", lsm)
+				require.Equal(t, "system_u:system_r:"+testProfileName+".process:s0", label)
+				require.Equal(t, 1, mock.RemoveAllCallCount())
+				require.Equal(t, testTempDir, mock.RemoveAllArgsForCall(0))
+			},
+		},
+		{
+			name: "failure selinux not enabled",
+			typ:  TypeSelinux,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.SelinuxEnabledReturns(false)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.SemoduleCallCount())
+			},
+		},
+		{
+			name: "failure on Semodule",
+			typ:  TypeSelinux,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.SelinuxEnabledReturns(true)
+				mock.SemoduleReturns(errTest)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 1, mock.SemoduleCallCount())
+				require.Zero(t, mock.CommandRunCallCount())
+			},
+		},
+		{
+			name: "failure on CommandRun for selinux",
+			typ:  TypeSelinux,
+			prepare: func(mock *runnerfakes.FakeImpl) {
+				mock.SelinuxEnabledReturns(true)
+				mock.CommandRunReturns(0, errTest)
+			},
+			assert: func(mock *runnerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 2, mock.SemoduleCallCount())
+			},
+		},
+	} {
+		typ := tc.typ
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &runnerfakes.FakeImpl{}
+			mock.ReadFileReturns([]byte("metadata:\n  name: "+testProfileName+"\n"), nil)
+			mock.YamlUnmarshalCalls(func(content []byte, obj any) error {
+				return yaml.Unmarshal(content, obj)
+			})
+			mock.MkdirTempReturns(testTempDir, nil)
+			prepare(mock)
+
+			options := Default()
+			options.typ = typ
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
+
 func waitForFunctionCall(t *testing.T, fn func() int) {
 	t.Helper()
 
@@ -21,17 +21,57 @@ limitations under the License.
 package runnerfakes
 
 import (
+	"os"
 	"sync"
 
 	"github.com/nxadm/tail"
 	"github.com/opencontainers/runc/libcontainer/configs"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	seccomp "github.com/seccomp/libseccomp-golang"
+	v1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
 type FakeImpl struct {
+	AppArmorEnabledStub        func(apparmorprofile.ProfileManager) bool
+	appArmorEnabledMutex       sync.RWMutex
+	appArmorEnabledArgsForCall []struct {
+		arg1 apparmorprofile.ProfileManager
+	}
+	appArmorEnabledReturns struct {
+		result1 bool
+	}
+	appArmorEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	AppArmorInstallProfileStub        func(apparmorprofile.ProfileManager, v1.StatusBaseUser) (bool, error)
+	appArmorInstallProfileMutex       sync.RWMutex
+	appArmorInstallProfileArgsForCall []struct {
+		arg1 apparmorprofile.ProfileManager
+		arg2 v1.StatusBaseUser
+	}
+	appArmorInstallProfileReturns struct {
+		result1 bool
+		result2 error
+	}
+	appArmorInstallProfileReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	AppArmorRemoveProfileStub        func(apparmorprofile.ProfileManager, v1.StatusBaseUser) error
+	appArmorRemoveProfileMutex       sync.RWMutex
+	appArmorRemoveProfileArgsForCall []struct {
+		arg1 apparmorprofile.ProfileManager
+		arg2 v1.StatusBaseUser
+	}
+	appArmorRemoveProfileReturns struct {
+		result1 error
+	}
+	appArmorRemoveProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CommandRunStub        func(*command.Command) (uint32, error)
 	commandRunMutex       sync.RWMutex
 	commandRunArgsForCall []struct {
@@ -142,6 +182,20 @@ type FakeImpl struct {
 	linesReturnsOnCall map[int]struct {
 		result1 chan *tail.Line
 	}
+	MkdirTempStub        func(string, string) (string, error)
+	mkdirTempMutex       sync.RWMutex
+	mkdirTempArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	mkdirTempReturns struct {
+		result1 string
+		result2 error
+	}
+	mkdirTempReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	PidLoadStub        func() uint32
 	pidLoadMutex       sync.RWMutex
 	pidLoadArgsForCall []struct {
@@ -171,6 +225,50 @@ type FakeImpl struct {
 		result1 []byte
 		result2 error
 	}
+	RemoveAllStub        func(string) error
+	removeAllMutex       sync.RWMutex
+	removeAllArgsForCall []struct {
+		arg1 string
+	}
+	removeAllReturns struct {
+		result1 error
+	}
+	removeAllReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SelinuxEnabledStub        func() bool
+	selinuxEnabledMutex       sync.RWMutex
+	selinuxEnabledArgsForCall []struct {
+	}
+	selinuxEnabledReturns struct {
+		result1 bool
+	}
+	selinuxEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	SemoduleStub        func(...string) error
+	semoduleMutex       sync.RWMutex
+	semoduleArgsForCall []struct {
+		arg1 []string
+	}
+	semoduleReturns struct {
+		result1 error
+	}
+	semoduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetExecLabelStub        func(string, string) error
+	setExecLabelMutex       sync.RWMutex
+	setExecLabelArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setExecLabelReturns struct {
+		result1 error
+	}
+	setExecLabelReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetupSeccompStub        func(*specs.LinuxSeccomp) (*configs.Seccomp, error)
 	setupSeccompMutex       sync.RWMutex
 	setupSeccompArgsForCall []struct {
@@ -198,6 +296,19 @@ type FakeImpl struct {
 		result1 *tail.Tail
 		result2 error
 	}
+	WriteFileStub        func(string, []byte, os.FileMode) error
+	writeFileMutex       sync.RWMutex
+	writeFileArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 os.FileMode
+	}
+	writeFileReturns struct {
+		result1 error
+	}
+	writeFileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	YamlUnmarshalStub        func([]byte, any) error
 	yamlUnmarshalMutex       sync.RWMutex
 	yamlUnmarshalArgsForCall []struct {
@@ -214,6 +325,194 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) AppArmorEnabled(arg1 apparmorprofile.ProfileManager) bool {
+	fake.appArmorEnabledMutex.Lock()
+	ret, specificReturn := fake.appArmorEnabledReturnsOnCall[len(fake.appArmorEnabledArgsForCall)]
+	fake.appArmorEnabledArgsForCall = append(fake.appArmorEnabledArgsForCall, struct {
+		arg1 apparmorprofile.ProfileManager
+	}{arg1})
+	stub := fake.AppArmorEnabledStub
+	fakeReturns := fake.appArmorEnabledReturns
+	fake.recordInvocation("AppArmorEnabled", []interface{}{arg1})
+	fake.appArmorEnabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) AppArmorEnabledCallCount() int {
+	fake.appArmorEnabledMutex.RLock()
+	defer fake.appArmorEnabledMutex.RUnlock()
+	return len(fake.appArmorEnabledArgsForCall)
+}
+
+func (fake *FakeImpl) AppArmorEnabledCalls(stub func(apparmorprofile.ProfileManager) bool) {
+	fake.appArmorEnabledMutex.Lock()
+	defer fake.appArmorEnabledMutex.Unlock()
+	fake.AppArmorEnabledStub = stub
+}
+
+func (fake *FakeImpl) AppArmorEnabledArgsForCall(i int) apparmorprofile.ProfileManager {
+	fake.appArmorEnabledMutex.RLock()
+	defer fake.appArmorEnabledMutex.RUnlock()
+	argsForCall := fake.appArmorEnabledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) AppArmorEnabledReturns(result1 bool) {
+	fake.appArmorEnabledMutex.Lock()
+	defer fake.appArmorEnabledMutex.Unlock()
+	fake.AppArmorEnabledStub = nil
+	fake.appArmorEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeImpl) AppArmorEnabledReturnsOnCall(i int, result1 bool) {
+	fake.appArmorEnabledMutex.Lock()
+	defer fake.appArmorEnabledMutex.Unlock()
+	fake.AppArmorEnabledStub = nil
+	if fake.appArmorEnabledReturnsOnCall == nil {
+		fake.appArmorEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.appArmorEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeImpl) AppArmorInstallProfile(arg1 apparmorprofile.ProfileManager, arg2 v1.StatusBaseUser) (bool, error) {
+	fake.appArmorInstallProfileMutex.Lock()
+	ret, specificReturn := fake.appArmorInstallProfileReturnsOnCall[len(fake.appArmorInstallProfileArgsForCall)]
+	fake.appArmorInstallProfileArgsForCall = append(fake.appArmorInstallProfileArgsForCall, struct {
+		arg1 apparmorprofile.ProfileManager
+		arg2 v1.StatusBaseUser
+	}{arg1, arg2})
+	stub := fake.AppArmorInstallProfileStub
+	fakeReturns := fake.appArmorInstallProfileReturns
+	fake.recordInvocation("AppArmorInstallProfile", []interface{}{arg1, arg2})
+	fake.appArmorInstallProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) AppArmorInstallProfileCallCount() int {
+	fake.appArmorInstallProfileMutex.RLock()
+	defer fake.appArmorInstallProfileMutex.RUnlock()
+	return len(fake.appArmorInstallProfileArgsForCall)
+}
+
+func (fake *FakeImpl) AppArmorInstallProfileCalls(stub func(apparmorprofile.ProfileManager, v1.StatusBaseUser) (bool, error)) {
+	fake.appArmorInstallProfileMutex.Lock()
+	defer fake.appArmorInstallProfileMutex.Unlock()
+	fake.AppArmorInstallProfileStub = stub
+}
+
+func (fake *FakeImpl) AppArmorInstallProfileArgsForCall(i int) (apparmorprofile.ProfileManager, v1.StatusBaseUser) {
+	fake.appArmorInstallProfileMutex.RLock()
+	defer fake.appArmorInstallProfileMutex.RUnlock()
+	argsForCall := fake.appArmorInstallProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) AppArmorInstallProfileReturns(result1 bool, result2 error) {
+	fake.appArmorInstallProfileMutex.Lock()
+	defer fake.appArmorInstallProfileMutex.Unlock()
+	fake.AppArmorInstallProfileStub = nil
+	fake.appArmorInstallProfileReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AppArmorInstallProfileReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.appArmorInstallProfileMutex.Lock()
+	defer fake.appArmorInstallProfileMutex.Unlock()
+	fake.AppArmorInstallProfileStub = nil
+	if fake.appArmorInstallProfileReturnsOnCall == nil {
+		fake.appArmorInstallProfileReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.appArmorInstallProfileReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AppArmorRemoveProfile(arg1 apparmorprofile.ProfileManager, arg2 v1.StatusBaseUser) error {
+	fake.appArmorRemoveProfileMutex.Lock()
+	ret, specificReturn := fake.appArmorRemoveProfileReturnsOnCall[len(fake.appArmorRemoveProfileArgsForCall)]
+	fake.appArmorRemoveProfileArgsForCall = append(fake.appArmorRemoveProfileArgsForCall, struct {
+		arg1 apparmorprofile.ProfileManager
+		arg2 v1.StatusBaseUser
+	}{arg1, arg2})
+	stub := fake.AppArmorRemoveProfileStub
+	fakeReturns := fake.appArmorRemoveProfileReturns
+	fake.recordInvocation("AppArmorRemoveProfile", []interface{}{arg1, arg2})
+	fake.appArmorRemoveProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) AppArmorRemoveProfileCallCount() int {
+	fake.appArmorRemoveProfileMutex.RLock()
+	defer fake.appArmorRemoveProfileMutex.RUnlock()
+	return len(fake.appArmorRemoveProfileArgsForCall)
+}
+
+func (fake *FakeImpl) AppArmorRemoveProfileCalls(stub func(apparmorprofile.ProfileManager, v1.StatusBaseUser) error) {
+	fake.appArmorRemoveProfileMutex.Lock()
+	defer fake.appArmorRemoveProfileMutex.Unlock()
+	fake.AppArmorRemoveProfileStub = stub
+}
+
+func (fake *FakeImpl) AppArmorRemoveProfileArgsForCall(i int) (apparmorprofile.ProfileManager, v1.StatusBaseUser) {
+	fake.appArmorRemoveProfileMutex.RLock()
+	defer fake.appArmorRemoveProfileMutex.RUnlock()
+	argsForCall := fake.appArmorRemoveProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) AppArmorRemoveProfileReturns(result1 error) {
+	fake.appArmorRemoveProfileMutex.Lock()
+	defer fake.appArmorRemoveProfileMutex.Unlock()
+	fake.AppArmorRemoveProfileStub = nil
+	fake.appArmorRemoveProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) AppArmorRemoveProfileReturnsOnCall(i int, result1 error) {
+	fake.appArmorRemoveProfileMutex.Lock()
+	defer fake.appArmorRemoveProfileMutex.Unlock()
+	fake.AppArmorRemoveProfileStub = nil
+	if fake.appArmorRemoveProfileReturnsOnCall == nil {
+		fake.appArmorRemoveProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appArmorRemoveProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) CommandRun(arg1 *command.Command) (uint32, error) {
 	fake.commandRunMutex.Lock()
 	ret, specificReturn := fake.commandRunReturnsOnCall[len(fake.commandRunArgsForCall)]
@@ -784,6 +1083,71 @@ func (fake *FakeImpl) LinesReturnsOnCall(i int, result1 chan *tail.Line) {
 	}{result1}
 }
 
+func (fake *FakeImpl) MkdirTemp(arg1 string, arg2 string) (string, error) {
+	fake.mkdirTempMutex.Lock()
+	ret, specificReturn := fake.mkdirTempReturnsOnCall[len(fake.mkdirTempArgsForCall)]
+	fake.mkdirTempArgsForCall = append(fake.mkdirTempArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.MkdirTempStub
+	fakeReturns := fake.mkdirTempReturns
+	fake.recordInvocation("MkdirTemp", []interface{}{arg1, arg2})
+	fake.mkdirTempMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) MkdirTempCallCount() int {
+	fake.mkdirTempMutex.RLock()
+	defer fake.mkdirTempMutex.RUnlock()
+	return len(fake.mkdirTempArgsForCall)
+}
+
+func (fake *FakeImpl) MkdirTempCalls(stub func(string, string) (string, error)) {
+	fake.mkdirTempMutex.Lock()
+	defer fake.mkdirTempMutex.Unlock()
+	fake.MkdirTempStub = stub
+}
+
+func (fake *FakeImpl) MkdirTempArgsForCall(i int) (string, string) {
+	fake.mkdirTempMutex.RLock()
+	defer fake.mkdirTempMutex.RUnlock()
+	argsForCall := fake.mkdirTempArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) MkdirTempReturns(result1 string, result2 error) {
+	fake.mkdirTempMutex.Lock()
+	defer fake.mkdirTempMutex.Unlock()
+	fake.MkdirTempStub = nil
+	fake.mkdirTempReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) MkdirTempReturnsOnCall(i int, result1 string, result2 error) {
+	fake.mkdirTempMutex.Lock()
+	defer fake.mkdirTempMutex.Unlock()
+	fake.MkdirTempStub = nil
+	if fake.mkdirTempReturnsOnCall == nil {
+		fake.mkdirTempReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.mkdirTempReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) PidLoad() uint32 {
 	fake.pidLoadMutex.Lock()
 	ret, specificReturn := fake.pidLoadReturnsOnCall[len(fake.pidLoadArgsForCall)]
@@ -934,6 +1298,243 @@ func (fake *FakeImpl) ReadFileReturnsOnCall(i int, result1 []byte, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) RemoveAll(arg1 string) error {
+	fake.removeAllMutex.Lock()
+	ret, specificReturn := fake.removeAllReturnsOnCall[len(fake.removeAllArgsForCall)]
+	fake.removeAllArgsForCall = append(fake.removeAllArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveAllStub
+	fakeReturns := fake.removeAllReturns
+	fake.recordInvocation("RemoveAll", []interface{}{arg1})
+	fake.removeAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) RemoveAllCallCount() int {
+	fake.removeAllMutex.RLock()
+	defer fake.removeAllMutex.RUnlock()
+	return len(fake.removeAllArgsForCall)
+}
+
+func (fake *FakeImpl) RemoveAllCalls(stub func(string) error) {
+	fake.removeAllMutex.Lock()
+	defer fake.removeAllMutex.Unlock()
+	fake.RemoveAllStub = stub
+}
+
+func (fake *FakeImpl) RemoveAllArgsForCall(i int) string {
+	fake.removeAllMutex.RLock()
+	defer fake.removeAllMutex.RUnlock()
+	argsForCall := fake.removeAllArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) RemoveAllReturns(result1 error) {
+	fake.removeAllMutex.Lock()
+	defer fake.removeAllMutex.Unlock()
+	fake.RemoveAllStub = nil
+	fake.removeAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) RemoveAllReturnsOnCall(i int, result1 error) {
+	fake.removeAllMutex.Lock()
+	defer fake.removeAllMutex.Unlock()
+	fake.RemoveAllStub = nil
+	if fake.removeAllReturnsOnCall == nil {
+		fake.removeAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SelinuxEnabled() bool {
+	fake.selinuxEnabledMutex.Lock()
+	ret, specificReturn := fake.selinuxEnabledReturnsOnCall[len(fake.selinuxEnabledArgsForCall)]
+	fake.selinuxEnabledArgsForCall = append(fake.selinuxEnabledArgsForCall, struct {
+	}{})
+	stub := fake.SelinuxEnabledStub
+	fakeReturns := fake.selinuxEnabledReturns
+	fake.recordInvocation("SelinuxEnabled", []interface{}{})
+	fake.selinuxEnabledMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SelinuxEnabledCallCount() int {
+	fake.selinuxEnabledMutex.RLock()
+	defer fake.selinuxEnabledMutex.RUnlock()
+	return len(fake.selinuxEnabledArgsForCall)
+}
+
+func (fake *FakeImpl) SelinuxEnabledCalls(stub func() bool) {
+	fake.selinuxEnabledMutex.Lock()
+	defer fake.selinuxEnabledMutex.Unlock()
+	fake.SelinuxEnabledStub = stub
+}
+
+func (fake *FakeImpl) SelinuxEnabledReturns(result1 bool) {
+	fake.selinuxEnabledMutex.Lock()
+	defer fake.selinuxEnabledMutex.Unlock()
+	fake.SelinuxEnabledStub = nil
+	fake.selinuxEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeImpl) SelinuxEnabledReturnsOnCall(i int, result1 bool) {
+	fake.selinuxEnabledMutex.Lock()
+	defer fake.selinuxEnabledMutex.Unlock()
+	fake.SelinuxEnabledStub = nil
+	if fake.selinuxEnabledReturnsOnCall == nil {
+		fake.selinuxEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.selinuxEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeImpl) Semodule(arg1 ...string) error {
+	fake.semoduleMutex.Lock()
+	ret, specificReturn := fake.semoduleReturnsOnCall[len(fake.semoduleArgsForCall)]
+	fake.semoduleArgsForCall = append(fake.semoduleArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.SemoduleStub
+	fakeReturns := fake.semoduleReturns
+	fake.recordInvocation("Semodule", []interface{}{arg1})
+	fake.semoduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SemoduleCallCount() int {
+	fake.semoduleMutex.RLock()
+	defer fake.semoduleMutex.RUnlock()
+	return len(fake.semoduleArgsForCall)
+}
+
+func (fake *FakeImpl) SemoduleCalls(stub func(...string) error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = stub
+}
+
+func (fake *FakeImpl) SemoduleArgsForCall(i int) []string {
+	fake.semoduleMutex.RLock()
+	defer fake.semoduleMutex.RUnlock()
+	argsForCall := fake.semoduleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) SemoduleReturns(result1 error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = nil
+	fake.semoduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SemoduleReturnsOnCall(i int, result1 error) {
+	fake.semoduleMutex.Lock()
+	defer fake.semoduleMutex.Unlock()
+	fake.SemoduleStub = nil
+	if fake.semoduleReturnsOnCall == nil {
+		fake.semoduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.semoduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SetExecLabel(arg1 string, arg2 string) error {
+	fake.setExecLabelMutex.Lock()
+	ret, specificReturn := fake.setExecLabelReturnsOnCall[len(fake.setExecLabelArgsForCall)]
+	fake.setExecLabelArgsForCall = append(fake.setExecLabelArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetExecLabelStub
+	fakeReturns := fake.setExecLabelReturns
+	fake.recordInvocation("SetExecLabel", []interface{}{arg1, arg2})
+	fake.setExecLabelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SetExecLabelCallCount() int {
+	fake.setExecLabelMutex.RLock()
+	defer fake.setExecLabelMutex.RUnlock()
+	return len(fake.setExecLabelArgsForCall)
+}
+
+func (fake *FakeImpl) SetExecLabelCalls(stub func(string, string) error) {
+	fake.setExecLabelMutex.Lock()
+	defer fake.setExecLabelMutex.Unlock()
+	fake.SetExecLabelStub = stub
+}
+
+func (fake *FakeImpl) SetExecLabelArgsForCall(i int) (string, string) {
+	fake.setExecLabelMutex.RLock()
+	defer fake.setExecLabelMutex.RUnlock()
+	argsForCall := fake.setExecLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) SetExecLabelReturns(result1 error) {
+	fake.setExecLabelMutex.Lock()
+	defer fake.setExecLabelMutex.Unlock()
+	fake.SetExecLabelStub = nil
+	fake.setExecLabelReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SetExecLabelReturnsOnCall(i int, result1 error) {
+	fake.setExecLabelMutex.Lock()
+	defer fake.setExecLabelMutex.Unlock()
+	fake.SetExecLabelStub = nil
+	if fake.setExecLabelReturnsOnCall == nil {
+		fake.setExecLabelReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setExecLabelReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) SetupSeccomp(arg1 *specs.LinuxSeccomp) (*configs.Seccomp, error) {
 	fake.setupSeccompMutex.Lock()
 	ret, specificReturn := fake.setupSeccompReturnsOnCall[len(fake.setupSeccompArgsForCall)]
@@ -1063,6 +1664,74 @@ func (fake *FakeImpl) TailFileReturnsOnCall(i int, result1 *tail.Tail, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 os.FileMode) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeFileMutex.Lock()
+	ret, specificReturn := fake.writeFileReturnsOnCall[len(fake.writeFileArgsForCall)]
+	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 os.FileMode
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteFileStub
+	fakeReturns := fake.writeFileReturns
+	fake.recordInvocation("WriteFile", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) WriteFileCallCount() int {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	return len(fake.writeFileArgsForCall)
+}
+
+func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, os.FileMode) error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = stub
+}
+
+func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, os.FileMode) {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	argsForCall := fake.writeFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) WriteFileReturns(result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	fake.writeFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WriteFileReturnsOnCall(i int, result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	if fake.writeFileReturnsOnCall == nil {
+		fake.writeFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) YamlUnmarshal(arg1 []byte, arg2 any) error {
 	var arg1Copy []byte
 	if arg1 != nil {
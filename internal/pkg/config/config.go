@@ -135,6 +135,11 @@ const (
 	// creates a selinux profile.
 	SelinuxProfileRecordLogsAnnotationKey = "io.containers.trace-avcs/"
 
+	// SelinuxProfileRecordBpfAnnotationKey is the annotation on a Pod that
+	// triggers the internal bpf module to trace the AVC denials of a Pod and
+	// creates a selinux profile.
+	SelinuxProfileRecordBpfAnnotationKey = "io.containers.trace-bpf-avcs/"
+
 	// KubeletDirNodeLabelKey is the label on a Node that specifies
 	// a custom kubelet root directory configured for this node. The directory
 	// path is provided in the following format folder-subfolder-subfolder
@@ -154,6 +159,18 @@ const (
 	// the log enricher.
 	LogEnricherProfile = "log-enricher-trace"
 
+	// SeccompNotifyRecorderProfile is the seccomp profile name for tracing
+	// syscalls from the log enricher via seccomp user notifications.
+	SeccompNotifyRecorderProfile = "seccomp-notify-trace"
+
+	// SeccompNotifyRecorderSocket is the socket path on which the log enricher
+	// receives the seccomp user notifications of recorded containers.
+	SeccompNotifyRecorderSocket = "/var/run/security-profiles-operator/recorder.sock"
+
+	// SeccompAgentSocket is the socket path on which the seccomp agent
+	// receives the seccomp user notifications of profiles using notify rules.
+	SeccompAgentSocket = "/var/run/security-profiles-operator/agent.sock"
+
 	// SelinuxPermissiveProfile is the selinux profile name for tracing AVC from
 	// the log enricher.
 	SelinuxPermissiveProfile = "selinuxrecording.process"
@@ -175,6 +192,10 @@ const (
 	// from OCI artifacts.
 	OCIProfilePrefix = "oci://"
 
+	// OCILayoutProfilePrefix is the prefix used for specifying security
+	// profiles from a local OCI image layout directory.
+	OCILayoutProfilePrefix = "oci-layout://"
+
 	// SpoApparmorProfile is the default file name for apparmor profile used by
 	// the security-profiles-operator container part of spod daemonset.
 	SpoApparmorProfile = "spo-apparmor.yaml"
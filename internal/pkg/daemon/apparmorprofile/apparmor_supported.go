@@ -19,6 +19,7 @@ limitations under the License.
 package apparmorprofile
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -74,6 +75,10 @@ func (a *aaProfileManager) RemoveProfile(bp profilebaseapi.StatusBaseUser) error
 		return errors.New(errInvalidCustomResourceType)
 	}
 
+	if err := a.removeProfile(a.logger, profile.GetAuditProfileName()); err != nil {
+		return fmt.Errorf("removing audit profile: %w", err)
+	}
+
 	return a.removeProfile(a.logger, profile.GetProfileName())
 }
 
@@ -91,12 +96,57 @@ func (a *aaProfileManager) InstallProfile(bp profilebaseapi.StatusBaseUser) (boo
 		return false, errors.New(errProfileExists)
 	}
 
-	policy, err := crd2armor.GenerateProfile(profile.GetProfileName(), profile.Spec.Mode, &profile.Spec.Abstract)
+	policy, auditPolicy, err := generatePolicies(profile)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := a.loadProfile(a.logger, profile.GetProfileName(), policy)
 	if err != nil {
-		return false, fmt.Errorf("generating raw apparmor profile: %w", err)
+		return updated, err
 	}
 
-	return a.loadProfile(a.logger, profile.GetProfileName(), policy)
+	// The complain mode variant is used by profile bindings in Audit mode
+	auditUpdated, err := a.loadProfile(a.logger, profile.GetAuditProfileName(), auditPolicy)
+
+	return updated || auditUpdated, err
+}
+
+// generatePolicies returns the policy of the profile and its complain mode
+// variant. Raw profiles are used verbatim.
+func generatePolicies(profile *apparmorprofileapi.AppArmorProfile) (policy, auditPolicy string, err error) {
+	if profile.IsRaw() {
+		if err := profile.ValidateRaw(); err != nil {
+			return "", "", fmt.Errorf("validating raw apparmor profile: %w", err)
+		}
+
+		if err := crd2armor.ValidateRawProfile(profile.GetProfileName(), profile.Spec.Raw); err != nil {
+			return "", "", fmt.Errorf("validating raw apparmor profile: %w", err)
+		}
+
+		auditPolicy, err := crd2armor.GenerateRawAuditProfile(
+			profile.GetProfileName(), profile.GetAuditProfileName(), profile.Spec.Raw,
+		)
+		if err != nil {
+			return "", "", fmt.Errorf("generating raw apparmor audit profile: %w", err)
+		}
+
+		return profile.Spec.Raw, auditPolicy, nil
+	}
+
+	policy, err = crd2armor.GenerateProfile(profile.GetProfileName(), profile.Spec.Mode, &profile.Spec.Abstract)
+	if err != nil {
+		return "", "", fmt.Errorf("generating raw apparmor profile: %w", err)
+	}
+
+	auditPolicy, err = crd2armor.GenerateProfile(
+		profile.GetAuditProfileName(), apparmorprofileapi.AppArmorModeComplain, &profile.Spec.Abstract,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("generating raw apparmor audit profile: %w", err)
+	}
+
+	return policy, auditPolicy, nil
 }
 
 func (a *aaProfileManager) CustomResourceTypeName() string {
@@ -134,6 +184,22 @@ func loadProfile(logger logr.Logger, name, content string) (bool, error) {
 	a := aa.NewAppArmor(aa.WithLogger(logger))
 
 	err := mount.Do(func() error {
+		// Make sure that the policy does not define profiles which belong to
+		// other profiles before writing it to disk.
+		parser, found := crd2armor.FindParser()
+		if !found {
+			return errors.New("cannot find apparmor_parser")
+		}
+
+		names, err := parser.ProfileNames(context.Background(), content)
+		if err != nil {
+			return fmt.Errorf("parsing policy: %w", err)
+		}
+
+		if err := crd2armor.ValidateProfileNames(name, names); err != nil {
+			return fmt.Errorf("validating policy: %w", err)
+		}
+
 		// AppArmor convention: A profile for /bin/foo is typically named `bin.foo`.
 		path := filepath.Join(
 			targetProfileDir,
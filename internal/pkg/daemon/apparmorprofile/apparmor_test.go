@@ -20,6 +20,7 @@ package apparmorprofile
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -72,6 +73,69 @@ func TestInstallProfile(t *testing.T) {
 			},
 			profile: &apparmorprofileapi.AppArmorProfile{},
 		},
+		{
+			name: "load audit profile",
+			sut: aaProfileManager{
+				loadProfile: func(_ logr.Logger, name, content string) (bool, error) {
+					if name != "profile_audit" {
+						return false, nil
+					}
+
+					if !strings.Contains(content, "complain") {
+						return false, errors.New("audit profile is not in complain mode")
+					}
+
+					return true, nil
+				},
+				checkProfileExist: func(_ logr.Logger, _ string) bool { return false },
+			},
+			profile: &apparmorprofileapi.AppArmorProfile{ObjectMeta: metav1.ObjectMeta{
+				Name: "profile",
+			}},
+			wantResult: true,
+		},
+		{
+			name: "load raw profile",
+			sut: aaProfileManager{
+				loadProfile: func(_ logr.Logger, name, content string) (bool, error) {
+					switch name {
+					case "profile":
+						if content != "profile profile {\n  /etc/hosts r,\n}\n" {
+							return false, errors.New("raw profile is not loaded verbatim")
+						}
+					case "profile_audit":
+						if !strings.HasPrefix(content, "profile profile_audit flags=(complain) {") {
+							return false, errors.New("audit profile is not in complain mode")
+						}
+					}
+
+					return true, nil
+				},
+				checkProfileExist: func(_ logr.Logger, _ string) bool { return false },
+			},
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Raw: "profile profile {\n  /etc/hosts r,\n}\n",
+				},
+			},
+			wantResult: true,
+		},
+		{
+			name: "raw profile with wrong name",
+			sut: aaProfileManager{
+				loadProfile:       func(_ logr.Logger, _, _ string) (bool, error) { return true, nil },
+				checkProfileExist: func(_ logr.Logger, _ string) bool { return false },
+			},
+			profile: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Raw: "profile docker-default {\n}\n",
+				},
+			},
+			wantErr: errors.New(`validating raw apparmor profile: raw profile is named "docker-default", ` +
+				`but must be named "profile"`),
+		},
 	}
 
 	for _, tc := range cases {
@@ -110,6 +174,22 @@ func TestRemoveProfile(t *testing.T) {
 			},
 			profile: &apparmorprofileapi.AppArmorProfile{},
 		},
+		{
+			name: "remove audit profile fails",
+			sut: aaProfileManager{
+				removeProfile: func(_ logr.Logger, name string) error {
+					if name == "profile_audit" {
+						return errors.New("oops")
+					}
+
+					return nil
+				},
+			},
+			profile: &apparmorprofileapi.AppArmorProfile{ObjectMeta: metav1.ObjectMeta{
+				Name: "profile",
+			}},
+			wantErr: errors.New("removing audit profile: oops"),
+		},
 	}
 
 	for _, tc := range cases {
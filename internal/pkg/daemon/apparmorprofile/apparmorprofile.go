@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
 	aa "github.com/pjbgf/go-apparmor/pkg/apparmor"
 	"github.com/pjbgf/go-apparmor/pkg/hostop"
 	"k8s.io/client-go/tools/record"
@@ -57,16 +58,25 @@ const (
 
 // NewController returns a new empty controller instance.
 func NewController() controller.Controller {
-	return &Reconciler{}
+	return &Reconciler{
+		impl: &defaultImpl{},
+		baseProfiles: ttlcache.New(
+			ttlcache.WithTTL[string, *apparmorprofileapi.AppArmorProfile](defaultCacheTimeout),
+			ttlcache.WithCapacity[string, *apparmorprofileapi.AppArmorProfile](maxCacheItems),
+		),
+	}
 }
 
 // A Reconciler reconciles AppArmor profiles.
 type Reconciler struct {
-	client  client.Client
-	log     logr.Logger
-	record  record.EventRecorder
-	metrics *metrics.Metrics
-	manager ProfileManager
+	client       client.Client
+	reader       client.Reader
+	log          logr.Logger
+	record       record.EventRecorder
+	metrics      *metrics.Metrics
+	manager      ProfileManager
+	baseProfiles *ttlcache.Cache[string, *apparmorprofileapi.AppArmorProfile]
+	impl
 }
 
 // Name returns the name of the controller.
@@ -157,13 +167,22 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		return r.reconcileDeletion(ctx, sp, nodeStatus)
 	}
 
+	effective, err := r.effectiveProfile(ctx, sp, l)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// TODO: backoff policy
-	updated, err := r.manager.InstallProfile(sp)
+	updated, err := r.manager.InstallProfile(effective)
 	if err != nil {
 		l.Error(err, "cannot load profile into node")
 		r.metrics.IncAppArmorProfileError(sp.GetName(), reasonCannotLoadProfile)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotLoadProfile, err.Error())
 
+		if statusErr := r.setErrorNodeStatus(ctx, nodeStatus); statusErr != nil {
+			l.Error(statusErr, "cannot update node status")
+		}
+
 		return reconcile.Result{}, fmt.Errorf("cannot load profile into node: %w", err)
 	}
 
@@ -214,6 +233,32 @@ func (r *Reconciler) reconcileAppArmorProfile(
 	return reconcile.Result{}, nil
 }
 
+// setErrorNodeStatus reports the profile as failed on this node, the node
+// status is created if it does not exist yet.
+func (r *Reconciler) setErrorNodeStatus(ctx context.Context, nodeStatus *nodestatus.StatusClient) error {
+	exists, err := nodeStatus.Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("checking if node status exists: %w", err)
+	}
+
+	if !exists {
+		if err := nodeStatus.Create(ctx); err != nil {
+			return fmt.Errorf("cannot ensure node status: %w", err)
+		}
+	} else {
+		isError, err := nodeStatus.Matches(ctx, secprofnodestatusapi.ProfileStateError)
+		if err != nil {
+			return fmt.Errorf("getting node status: %w", err)
+		}
+
+		if isError {
+			return nil
+		}
+	}
+
+	return nodeStatus.SetNodeStatus(ctx, secprofnodestatusapi.ProfileStateError)
+}
+
 func (r *Reconciler) reconcileDeletion(
 	ctx context.Context,
 	sp *apparmorprofileapi.AppArmorProfile,
@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// maxBaseProfileLevel is the maximum depth of stacked base profiles.
+	maxBaseProfileLevel = 15
+
+	defaultCacheTimeout time.Duration = 24 * time.Hour
+	maxCacheItems       uint64        = 1000
+
+	errBaseProfileDigestRequired = "base profile has to be referenced by digest"
+
+	reasonInvalidAppArmorProfile string = "InvalidAppArmorProfile"
+	reasonCannotPullProfile      string = "CannotPullAppArmorProfile"
+	reasonProfileNotAllowed      string = "ProfileNotAllowed"
+)
+
+// baseProfileKind stores the digests of remote base profiles in the
+// AppArmorProfile status.
+var baseProfileKind = &common.BaseProfileKind[*apparmorprofileapi.AppArmorProfile]{
+	Annotation: apparmorprofileapi.ResolveBaseProfilesAnnotation,
+	Status: func(sp *apparmorprofileapi.AppArmorProfile) ([]common.ResolvedBaseProfile, string) {
+		resolved := make([]common.ResolvedBaseProfile, 0, len(sp.Status.ResolvedBaseProfiles))
+		for _, r := range sp.Status.ResolvedBaseProfiles {
+			resolved = append(resolved, common.ResolvedBaseProfile(r))
+		}
+
+		return resolved, sp.Status.ObservedResolveBaseProfiles
+	},
+	SetStatus: func(sp *apparmorprofileapi.AppArmorProfile, resolved []common.ResolvedBaseProfile, observed string) {
+		sp.Status.ResolvedBaseProfiles = nil
+		for _, r := range resolved {
+			sp.Status.ResolvedBaseProfiles = append(sp.Status.ResolvedBaseProfiles, apparmorprofileapi.ResolvedBaseProfile(r))
+		}
+
+		sp.Status.ObservedResolveBaseProfiles = observed
+	},
+}
+
+// BaseProfileGetter retrieves the base profile referenced by the provided
+// AppArmor profile.
+type BaseProfileGetter func(*apparmorprofileapi.AppArmorProfile) (*apparmorprofileapi.AppArmorProfile, error)
+
+// ResolveAbstract recursively resolves the base profiles of sp up to a depth
+// level of 15 by using getBaseProfile and returns the union of their
+// abstracts with the abstract of sp.
+func ResolveAbstract(
+	sp *apparmorprofileapi.AppArmorProfile,
+	getBaseProfile BaseProfileGetter,
+) (*apparmorprofileapi.AppArmorAbstract, error) {
+	abstracts := []*apparmorprofileapi.AppArmorAbstract{&sp.Spec.Abstract}
+
+	for profile := sp; profile.Spec.BaseProfileName != ""; {
+		if len(abstracts) > maxBaseProfileLevel {
+			return nil, fmt.Errorf(
+				"max recursion level of %d is reached for resolving base profiles",
+				maxBaseProfileLevel,
+			)
+		}
+
+		baseProfile, err := getBaseProfile(profile)
+		if err != nil {
+			return nil, err
+		}
+
+		abstracts = append(abstracts, &baseProfile.Spec.Abstract)
+		profile = baseProfile
+	}
+
+	// Start with the outermost base profile, which is most likely the one
+	// using glob patterns.
+	effective := &apparmorprofileapi.AppArmorAbstract{}
+	for i := len(abstracts) - 1; i >= 0; i-- {
+		util.UnionAppArmorAbstract(effective, abstracts[i].DeepCopy())
+	}
+
+	return effective, nil
+}
+
+// effectiveProfile returns a copy of sp which contains the union of the
+// abstracts of sp and all its base profiles.
+func (r *Reconciler) effectiveProfile(
+	ctx context.Context, sp *apparmorprofileapi.AppArmorProfile, l logr.Logger,
+) (*apparmorprofileapi.AppArmorProfile, error) {
+	// Raw profiles are loaded verbatim and cannot have a base profile.
+	if sp.Spec.BaseProfileName == "" || sp.IsRaw() {
+		return sp, nil
+	}
+
+	digests := baseProfileKind.NewDigests(sp)
+
+	abstract, err := ResolveAbstract(sp, func(
+		profile *apparmorprofileapi.AppArmorProfile,
+	) (*apparmorprofileapi.AppArmorProfile, error) {
+		return r.getBaseProfile(ctx, sp, profile.Spec.BaseProfileName, digests, l)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("resolve base profiles: %w", err)
+	}
+
+	if err := baseProfileKind.UpdateStatus(ctx, r.client, r.record, sp, digests, l); err != nil {
+		return nil, err
+	}
+
+	effective := sp.DeepCopy()
+	effective.Spec.BaseProfileName = ""
+	effective.Spec.Abstract = *abstract
+
+	return effective, nil
+}
+
+// getBaseProfile retrieves the base profile baseProfileName either from the
+// cluster or from an OCI artifact registry. Failures are reported on sp.
+func (r *Reconciler) getBaseProfile(
+	ctx context.Context,
+	sp *apparmorprofileapi.AppArmorProfile,
+	baseProfileName string,
+	digests *common.BaseProfileDigests,
+	l logr.Logger,
+) (*apparmorprofileapi.AppArmorProfile, error) {
+	l.Info("Resolving base profile", "baseProfile", baseProfileName)
+
+	if from, ok := artifact.OCIReference(baseProfileName); ok {
+		return r.getRemoteBaseProfile(ctx, sp, from, digests, l)
+	}
+
+	baseProfile := &apparmorprofileapi.AppArmorProfile{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: baseProfileName}, baseProfile); err != nil {
+		l.Error(err, "cannot retrieve base profile "+baseProfileName)
+		r.metrics.IncAppArmorProfileError(sp.GetName(), reasonInvalidAppArmorProfile)
+		r.record.Event(sp, util.EventTypeWarning, reasonInvalidAppArmorProfile, err.Error())
+
+		return nil, fmt.Errorf("get base profile %s: %w", baseProfileName, err)
+	}
+
+	return baseProfile, nil
+}
+
+// getRemoteBaseProfile retrieves the base profile from an OCI artifact
+// registry or layout. References pinned to a digest are served from the cache
+// if possible, while all others are pulled to resolve their digest.
+func (r *Reconciler) getRemoteBaseProfile(
+	ctx context.Context,
+	sp *apparmorprofileapi.AppArmorProfile,
+	from string,
+	digests *common.BaseProfileDigests,
+	l logr.Logger,
+) (*apparmorprofileapi.AppArmorProfile, error) {
+	spod, err := common.GetSPOD(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the SPOD configuration: %w", err)
+	}
+
+	if ptr.Deref(spod.Spec.Security.RequireBaseProfileDigest, false) && !artifact.IsDigestReference(from) {
+		err := fmt.Errorf("%s: %s", errBaseProfileDigestRequired, from)
+		l.Error(err, "base profile not allowed")
+		r.metrics.IncAppArmorProfileError(sp.GetName(), reasonProfileNotAllowed)
+		r.record.Event(sp, util.EventTypeWarning, reasonProfileNotAllowed, err.Error())
+
+		return nil, err
+	}
+
+	pullFrom := from
+
+	if pinned, ok := digests.Pinned(from); ok {
+		pullFrom, err = artifact.DigestReference(from, digest.Digest(pinned))
+		if err != nil {
+			return nil, fmt.Errorf("pin base profile %s to digest: %w", from, err)
+		}
+
+		if item := r.baseProfiles.Get(pullFrom); item != nil {
+			l.Info("Using cached base profile", "baseProfile", pullFrom)
+			digests.Add(from, pinned)
+
+			return item.Value(), nil
+		}
+	}
+
+	signOpts, regOpts, err := common.PullOptions(ctx, r.reader, spod, from)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Info("Pulling base profile: " + pullFrom)
+
+	res, err := r.Pull(ctx, l, pullFrom, "", "", &v1.Platform{
+		Architecture: runtime.GOARCH,
+		OS:           runtime.GOOS,
+	}, signOpts, regOpts)
+	if err != nil {
+		l.Error(err, "cannot pull base profile "+pullFrom)
+		r.metrics.IncAppArmorProfileError(sp.GetName(), reasonCannotPullProfile)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotPullProfile, err.Error())
+
+		return nil, fmt.Errorf("retrieve base profile %s from OCI registry: %w", pullFrom, err)
+	}
+
+	if resType := r.PullResultType(res); resType != artifact.PullResultTypeAppArmorProfile {
+		return nil, fmt.Errorf("pull result type %s is not an AppArmor profile", resType)
+	}
+
+	baseProfile := r.PullResultApparmorProfile(res)
+	resDigest := r.PullResultDigest(res)
+
+	cacheKey, err := artifact.DigestReference(from, resDigest)
+	if err != nil {
+		return nil, fmt.Errorf("pin base profile %s to digest: %w", from, err)
+	}
+
+	r.baseProfiles.Set(cacheKey, baseProfile, ttlcache.DefaultTTL)
+	digests.Add(from, resDigest.String())
+
+	return baseProfile, nil
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/apparmorprofilefakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+var errTest = errors.New("test")
+
+const testDigest digest.Digest = "sha256:2d5e1ef4b52b0a5fb1b1e17ae3a5ca83c3b9b8d16c0a6f2b1f2a2c4d3e5f6a7b"
+
+func TestResolveAbstract(t *testing.T) {
+	t.Parallel()
+
+	runtimeProfile := &apparmorprofileapi.AppArmorProfile{
+		Spec: apparmorprofileapi.AppArmorProfileSpec{
+			Abstract: apparmorprofileapi.AppArmorAbstract{
+				Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+					AllowedLibraries: []string{"/lib/**"},
+				},
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					ReadOnlyPaths: []string{"/etc/**"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		sp             *apparmorprofileapi.AppArmorProfile
+		getBaseProfile BaseProfileGetter
+		assert         func(*apparmorprofileapi.AppArmorAbstract, error)
+	}{
+		{
+			name: "success no base profile",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					Abstract: apparmorprofileapi.AppArmorAbstract{
+						Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+							AllowedCapabilities: []string{"net_admin"},
+						},
+					},
+				},
+			},
+			assert: func(abstract *apparmorprofileapi.AppArmorAbstract, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"net_admin"}, abstract.Capability.AllowedCapabilities)
+				require.Nil(t, abstract.Filesystem)
+			},
+		},
+		{
+			name: "success stacked base profiles",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					BaseProfileName: "shell",
+					Abstract: apparmorprofileapi.AppArmorAbstract{
+						Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+							AllowedExecutables: []string{"/usr/bin/app"},
+							AllowedLibraries:   []string{"/lib/libc.so.6", "/opt/lib/libapp.so"},
+						},
+						Filesystem: &apparmorprofileapi.AppArmorFsRules{
+							ReadWritePaths: []string{"/var/lib/app"},
+						},
+					},
+				},
+			},
+			getBaseProfile: func(sp *apparmorprofileapi.AppArmorProfile) (*apparmorprofileapi.AppArmorProfile, error) {
+				switch sp.Spec.BaseProfileName {
+				case "shell":
+					return &apparmorprofileapi.AppArmorProfile{
+						Spec: apparmorprofileapi.AppArmorProfileSpec{
+							BaseProfileName: "runtime",
+							Abstract: apparmorprofileapi.AppArmorAbstract{
+								Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+									AllowedExecutables: []string{"/bin/sh"},
+								},
+							},
+						},
+					}, nil
+				case "runtime":
+					return runtimeProfile, nil
+				}
+
+				return nil, errTest
+			},
+			assert: func(abstract *apparmorprofileapi.AppArmorAbstract, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"/bin/sh", "/usr/bin/app"}, abstract.Executable.AllowedExecutables)
+				require.Equal(t, []string{"/lib/**", "/opt/lib/libapp.so"}, abstract.Executable.AllowedLibraries)
+				require.Equal(t, []string{"/etc/**"}, abstract.Filesystem.ReadOnlyPaths)
+				require.Equal(t, []string{"/var/lib/app"}, abstract.Filesystem.ReadWritePaths)
+
+				// The base profile must not be modified
+				require.Equal(t, []string{"/lib/**"}, runtimeProfile.Spec.Abstract.Executable.AllowedLibraries)
+			},
+		},
+		{
+			name: "failure on getBaseProfile",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				Spec: apparmorprofileapi.AppArmorProfileSpec{BaseProfileName: "missing"},
+			},
+			getBaseProfile: func(*apparmorprofileapi.AppArmorProfile) (*apparmorprofileapi.AppArmorProfile, error) {
+				return nil, errTest
+			},
+			assert: func(_ *apparmorprofileapi.AppArmorAbstract, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure max recursion",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				Spec: apparmorprofileapi.AppArmorProfileSpec{BaseProfileName: "self"},
+			},
+			getBaseProfile: func(sp *apparmorprofileapi.AppArmorProfile) (*apparmorprofileapi.AppArmorProfile, error) {
+				return sp, nil
+			},
+			assert: func(_ *apparmorprofileapi.AppArmorAbstract, err error) {
+				require.ErrorContains(t, err, "max recursion level")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.assert(ResolveAbstract(tc.sp, tc.getBaseProfile))
+		})
+	}
+}
+
+//nolint:paralleltest // subtests modify environment variables and cannot run in parallel
+func TestEffectiveProfile(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		sp     *apparmorprofileapi.AppArmorProfile
+		getFn  util.MockGetFn
+		assert func(*apparmorprofileapi.AppArmorProfile, error)
+	}{
+		{
+			name: "success local base profile",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "app"},
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					BaseProfileName: "runtime",
+					Abstract: apparmorprofileapi.AppArmorAbstract{
+						Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+							AllowedCapabilities: []string{"net_admin"},
+						},
+					},
+				},
+			},
+			getFn: func(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				require.Equal(t, "runtime", key.Name)
+				require.Empty(t, key.Namespace)
+
+				profile, ok := obj.(*apparmorprofileapi.AppArmorProfile)
+				require.True(t, ok)
+
+				profile.Spec.Abstract.Capability = &apparmorprofileapi.AppArmorCapabilityRules{
+					AllowedCapabilities: []string{"chown"},
+				}
+
+				return nil
+			},
+			assert: func(effective *apparmorprofileapi.AppArmorProfile, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "app", effective.GetName())
+				require.Empty(t, effective.Spec.BaseProfileName)
+				require.Equal(t, []string{"chown", "net_admin"}, effective.Spec.Abstract.Capability.AllowedCapabilities)
+			},
+		},
+		{
+			name: "failure on get local base profile",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				Spec: apparmorprofileapi.AppArmorProfileSpec{BaseProfileName: "runtime"},
+			},
+			getFn: util.NewMockGetFn(errTest),
+			assert: func(_ *apparmorprofileapi.AppArmorProfile, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on base profile digest required",
+			sp: &apparmorprofileapi.AppArmorProfile{
+				Spec: apparmorprofileapi.AppArmorProfileSpec{
+					BaseProfileName: config.OCIProfilePrefix + "ghcr.io/security-profiles/runtime:latest",
+				},
+			},
+			getFn: util.NewMockGetFn(nil, func(obj client.Object) error {
+				spod, ok := obj.(*spodapi.SecurityProfilesOperatorDaemon)
+				require.True(t, ok)
+
+				spod.Spec.Security.RequireBaseProfileDigest = ptr.To(true)
+
+				return nil
+			}),
+			assert: func(_ *apparmorprofileapi.AppArmorProfile, err error) {
+				require.ErrorContains(t, err, errBaseProfileDigestRequired)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+
+			sut, ok := NewController().(*Reconciler)
+			require.True(t, ok)
+
+			sut.client = &util.MockClient{MockGet: tc.getFn}
+			sut.record = record.NewFakeRecorder(10)
+			sut.metrics = metrics.New()
+
+			tc.assert(sut.effectiveProfile(t.Context(), tc.sp, logr.Discard()))
+		})
+	}
+}
+
+//nolint:paralleltest // modifies environment variables and cannot run in parallel
+func TestGetRemoteBaseProfilePinned(t *testing.T) {
+	t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+
+	mock := &apparmorprofilefakes.FakeImpl{}
+	mock.PullResultTypeReturns(artifact.PullResultTypeAppArmorProfile)
+	mock.PullResultApparmorProfileReturns(&apparmorprofileapi.AppArmorProfile{})
+	mock.PullResultDigestReturns(testDigest)
+
+	sut, ok := NewController().(*Reconciler)
+	require.True(t, ok)
+
+	sut.impl = mock
+	sut.client = &util.MockClient{MockGet: util.NewMockGetFn(nil)}
+	sut.record = record.NewFakeRecorder(10)
+	sut.metrics = metrics.New()
+
+	from := config.OCIProfilePrefix + "registry.local/test:v1"
+	sp := &apparmorprofileapi.AppArmorProfile{
+		Spec: apparmorprofileapi.AppArmorProfileSpec{BaseProfileName: from},
+		Status: apparmorprofileapi.AppArmorProfileStatus{
+			ResolvedBaseProfiles: []apparmorprofileapi.ResolvedBaseProfile{
+				{Reference: "registry.local/test:v1", Digest: testDigest.String()},
+			},
+		},
+	}
+
+	// The first resolution pulls the pinned digest instead of the tag
+	digests := baseProfileKind.NewDigests(sp)
+	_, err := sut.getBaseProfile(t.Context(), sp, from, digests, logr.Discard())
+	require.NoError(t, err)
+	require.Equal(t, 1, mock.PullCallCount())
+
+	_, _, pulled, _, _, _, _, _ := mock.PullArgsForCall(0)
+	require.Equal(t, "registry.local/test@"+testDigest.String(), pulled)
+	require.Equal(t, []common.ResolvedBaseProfile{
+		{Reference: "registry.local/test:v1", Digest: testDigest.String()},
+	}, digests.Resolved())
+
+	// The second resolution uses the cache
+	digests = baseProfileKind.NewDigests(sp)
+	_, err = sut.getBaseProfile(t.Context(), sp, from, digests, logr.Discard())
+	require.NoError(t, err)
+	require.Equal(t, 1, mock.PullCallCount())
+	require.Equal(t, []common.ResolvedBaseProfile{
+		{Reference: "registry.local/test:v1", Digest: testDigest.String()},
+	}, digests.Resolved())
+}
+
+//nolint:paralleltest // modifies environment variables and cannot run in parallel
+func TestGetRemoteBaseProfileTag(t *testing.T) {
+	t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+
+	mock := &apparmorprofilefakes.FakeImpl{}
+	mock.PullResultTypeReturns(artifact.PullResultTypeAppArmorProfile)
+	mock.PullResultApparmorProfileReturns(&apparmorprofileapi.AppArmorProfile{})
+	mock.PullResultDigestReturns(testDigest)
+
+	sut, ok := NewController().(*Reconciler)
+	require.True(t, ok)
+
+	sut.impl = mock
+	sut.client = &util.MockClient{MockGet: util.NewMockGetFn(nil)}
+	sut.record = record.NewFakeRecorder(10)
+	sut.metrics = metrics.New()
+
+	from := config.OCIProfilePrefix + "registry.local/test:v1"
+	sp := &apparmorprofileapi.AppArmorProfile{
+		Spec: apparmorprofileapi.AppArmorProfileSpec{BaseProfileName: from},
+	}
+
+	// Unpinned tags are pulled to resolve their digest on every resolution
+	for i := 1; i <= 2; i++ {
+		_, err := sut.getBaseProfile(t.Context(), sp, from, baseProfileKind.NewDigests(sp), logr.Discard())
+		require.NoError(t, err)
+		require.Equal(t, i, mock.PullCallCount())
+
+		_, _, pulled, _, _, _, _, _ := mock.PullArgsForCall(i - 1)
+		require.Equal(t, "registry.local/test:v1", pulled)
+	}
+
+	// The result is cached by digest only
+	require.NotNil(t, sut.baseProfiles.Get("registry.local/test@"+testDigest.String()))
+	require.Nil(t, sut.baseProfiles.Get("registry.local/test:v1"))
+}
@@ -40,7 +40,9 @@ profile {{.Name}} flags=({{.ProfileMode}},attach_disconnected,mediate_deleted) {
 {{end}}{{end}}
 {{ if ne .Data.Executable.AllowedLibraries nil }}
 {{range $allowedlib := .Data.Executable.AllowedLibraries}}  {{$allowedlib}} mr,
-{{end}}{{end}}{{end}}
+{{end}}{{end}}
+{{range $transition := .Data.Executable.Transitions}}  {{execRule $transition}},
+{{end}}{{end}}
   {{ if .AllowMount }}
   /sbin/fsck ixr,
   /sbin/fsck.ext4 ixr,
@@ -59,6 +61,8 @@ profile {{.Name}} flags=({{.ProfileMode}},attach_disconnected,mediate_deleted) {
 {{end}}{{end}}{{end}}
 {{ if ne .Data.Filesystem.ReadWritePaths nil }}
 {{range $readwrite := .Data.Filesystem.ReadWritePaths}}  {{$readwrite}} rwlk,
+{{end}}{{end}}
+{{range $rule := .Data.Filesystem.Rules}}{{ if not (and $rule.Deny $.ComplainMode) }}  {{fileRule $rule}},
 {{end}}{{end}}{{end}}
 
   # Network rules
@@ -69,12 +73,26 @@ profile {{.Name}} flags=({{.ProfileMode}},attach_disconnected,mediate_deleted) {
 {{if .Data.Network.AllowTCP}}  network tcp,
 {{end}}{{end}}{{if ne .Data.Network.AllowUDP nil }}
 {{if .Data.Network.AllowUDP}}  network udp,
+{{end}}{{end}}
+{{range $rule := .Data.Network.Rules}}{{ if not (and $rule.Deny $.ComplainMode) }}  {{networkRule $rule}},
 {{end}}{{end}}{{end}}
 
   # Capabilities rules
 {{ if ne .Data.Capability nil}}{{range $cap := .Data.Capability.AllowedCapabilities}}  capability {{$cap}},
 {{end}}{{end}}
 
+  # Signal rules
+{{range $rule := .Data.Signal}}{{ if not (and $rule.Deny $.ComplainMode) }}  {{signalRule $rule}},
+{{end}}{{end}}
+  # Ptrace rules
+{{range $rule := .Data.Ptrace}}{{ if not (and $rule.Deny $.ComplainMode) }}  {{ptraceRule $rule}},
+{{end}}{{end}}
+  # D-Bus rules
+{{range $rule := .Data.Dbus}}{{ if not (and $rule.Deny $.ComplainMode) }}  {{dbusRule $rule}},
+{{end}}{{end}}
+  # Mount rules
+{{range $rule := .Data.Mount}}{{ if not (and $rule.Deny $.ComplainMode) }}  {{mountRule $rule}},
+{{end}}{{end}}
   {{ if .AllowMount }}
   mount,
   remount,
@@ -105,12 +123,17 @@ type ApparmorData struct {
 	Filesystem *FileSystem
 	Capability *Capability
 	Network    *Network
+	Signal     []apparmorprofileapi.AppArmorSignalRule
+	Ptrace     []apparmorprofileapi.AppArmorPtraceRule
+	Dbus       []apparmorprofileapi.AppArmorDbusRule
+	Mount      []apparmorprofileapi.AppArmorMountRule
 }
 
 // Executable validated allowed executables and libraries.
 type Executable struct {
 	AllowedExecutables []string
 	AllowedLibraries   []string
+	Transitions        []apparmorprofileapi.AppArmorExecTransition
 }
 
 // FileSystem validated allowed file paths.
@@ -118,6 +141,7 @@ type FileSystem struct {
 	ReadOnlyPaths  []string
 	WriteOnlyPaths []string
 	ReadWritePaths []string
+	Rules          []apparmorprofileapi.AppArmorFileRule
 }
 
 // Network network flags.
@@ -125,6 +149,7 @@ type Network struct {
 	AllowRaw bool
 	AllowTCP bool
 	AllowUDP bool
+	Rules    []apparmorprofileapi.AppArmorNetworkRule
 }
 
 // Capability validated allowed capabilities.
@@ -132,6 +157,119 @@ type Capability struct {
 	AllowedCapabilities []string
 }
 
+// templateFuncs renders the rules which cannot be expressed by plain path lists.
+var templateFuncs = template.FuncMap{
+	"execRule":    execRule,
+	"fileRule":    fileRule,
+	"networkRule": networkRule,
+	"signalRule":  signalRule,
+	"ptraceRule":  ptraceRule,
+	"dbusRule":    dbusRule,
+	"mountRule":   mountRule,
+}
+
+func execRule(t apparmorprofileapi.AppArmorExecTransition) string {
+	return joinNonEmpty(t.Path, string(t.Mode), value("-> ", t.Profile))
+}
+
+func fileRule(r apparmorprofileapi.AppArmorFileRule) string {
+	return qualifiers(r.Deny, r.Owner) + r.Path + " " + r.Mode
+}
+
+func networkRule(r apparmorprofileapi.AppArmorNetworkRule) string {
+	return qualifiers(r.Deny, false) + joinNonEmpty("network", r.Family, r.Type)
+}
+
+func signalRule(r apparmorprofileapi.AppArmorSignalRule) string {
+	return qualifiers(r.Deny, false) + joinNonEmpty("signal",
+		list("", r.Access), list("set=", r.Signals), value("peer=", r.Peer))
+}
+
+func ptraceRule(r apparmorprofileapi.AppArmorPtraceRule) string {
+	return qualifiers(r.Deny, false) + joinNonEmpty("ptrace",
+		list("", r.Access), value("peer=", r.Peer))
+}
+
+func dbusRule(r apparmorprofileapi.AppArmorDbusRule) string {
+	peer := list("peer=", slices.DeleteFunc(
+		[]string{value("name=", r.PeerName), value("label=", r.PeerLabel)},
+		func(s string) bool { return s == "" },
+	))
+
+	return qualifiers(r.Deny, false) + joinNonEmpty("dbus",
+		list("", r.Access), value("bus=", r.Bus), value("name=", r.Name),
+		value("path=", r.Path), value("interface=", r.Interface),
+		value("member=", r.Member), peer)
+}
+
+func mountRule(r apparmorprofileapi.AppArmorMountRule) string {
+	if r.Operation != apparmorprofileapi.AppArmorMountOperationMount {
+		return qualifiers(r.Deny, false) + joinNonEmpty(string(r.Operation),
+			list("options=", r.Options), r.MountPoint)
+	}
+
+	return qualifiers(r.Deny, false) + joinNonEmpty("mount",
+		list("fstype=", r.FsTypes), list("options=", r.Options),
+		r.Source, value("-> ", r.MountPoint))
+}
+
+// qualifiers returns the rule prefix for the deny and owner qualifiers.
+func qualifiers(deny, owner bool) string {
+	prefix := ""
+	if deny {
+		prefix += "deny "
+	}
+
+	if owner {
+		prefix += "owner "
+	}
+
+	return prefix
+}
+
+// value returns the key prefixed value or an empty string if the value is empty.
+func value(key, val string) string {
+	if val == "" {
+		return ""
+	}
+
+	return key + val
+}
+
+// list returns the key prefixed parenthesized list or an empty string if the
+// list is empty.
+func list(key string, vals []string) string {
+	if len(vals) == 0 {
+		return ""
+	}
+
+	return key + "(" + strings.Join(vals, ", ") + ")"
+}
+
+// joinNonEmpty joins all non-empty parts with a space.
+func joinNonEmpty(parts ...string) string {
+	return strings.Join(slices.DeleteFunc(parts, func(s string) bool { return s == "" }), " ")
+}
+
+// Known values for network, signal and socket type rules.
+var (
+	networkFamilies = []string{
+		"unix", "inet", "ax25", "ipx", "appletalk", "netrom", "bridge", "atmpvc",
+		"x25", "inet6", "rose", "netbeui", "security", "key", "netlink", "packet",
+		"ash", "econet", "atmsvc", "rds", "sna", "irda", "pppox", "wanpipe", "llc",
+		"ib", "mpls", "can", "tipc", "bluetooth", "iucv", "rxrpc", "isdn", "phonet",
+		"ieee802154", "caif", "alg", "nfc", "vsock", "kcm", "qipcrtr", "smc", "xdp",
+		"mctp",
+	}
+	socketTypes = []string{"stream", "dgram", "seqpacket", "rdm", "raw", "packet"}
+	signals     = []string{
+		"hup", "int", "quit", "ill", "trap", "abrt", "bus", "fpe", "kill", "usr1",
+		"segv", "usr2", "pipe", "alrm", "term", "stkflt", "chld", "cont", "stop",
+		"stp", "ttin", "ttou", "urg", "xcpu", "xfsz", "vtalrm", "prof", "winch",
+		"io", "pwr", "sys", "emt", "exists",
+	}
+)
+
 // Global chars constraints: these are strictly forbidden regardless of field type.
 var (
 	// Allowed characters in the profile name.
@@ -146,6 +284,18 @@ var (
 	// 6. An explicitly formatted ptrace rule injection hack
 	// Critically, it EXCLUDES commas, quotes, and newlines.
 	strictPathRegex = regexp.MustCompile(`^(?:/[a-zA-Z0-9_./*?+@{} -]*|ptrace\s*\([a-zA-Z]+\),(?:\s*#.*)?)$`)
+	// Absolute path without spaces, used by all rules which are not plain path lists.
+	rulePathRegex = regexp.MustCompile(`^/[a-zA-Z0-9_./*?+@{}-]*$`)
+	// Allowed characters in peer profile names, which may contain AppArmor globs.
+	peerChars = regexp.MustCompile(`^[a-zA-Z0-9_./*?@{}-]+$`)
+	// Allowed file access modes.
+	fileModeChars = regexp.MustCompile(`^[rwalkm]+$`)
+	// Allowed characters in D-Bus bus, interface, member and bus names.
+	dbusNameChars = regexp.MustCompile(`^[a-zA-Z0-9_.:-]+$`)
+	// Allowed characters in mount sources, file system types and options.
+	mountChars = regexp.MustCompile(`^[a-zA-Z0-9_./=@:-]+$`)
+	// Real-time signals, which are referenced by number.
+	rtSignal = regexp.MustCompile(`^rtmin\+[0-9]{1,2}$`)
 )
 
 func newApparmorData(name string, abstract *apparmorprofileapi.AppArmorAbstract) *ApparmorData {
@@ -160,6 +310,7 @@ func newApparmorData(name string, abstract *apparmorprofileapi.AppArmorAbstract)
 		data.Executable = &Executable{
 			AllowedExecutables: abstract.Executable.AllowedExecutables,
 			AllowedLibraries:   abstract.Executable.AllowedLibraries,
+			Transitions:        abstract.Executable.Transitions,
 		}
 	}
 
@@ -168,6 +319,7 @@ func newApparmorData(name string, abstract *apparmorprofileapi.AppArmorAbstract)
 			ReadOnlyPaths:  abstract.Filesystem.ReadOnlyPaths,
 			WriteOnlyPaths: abstract.Filesystem.WriteOnlyPaths,
 			ReadWritePaths: abstract.Filesystem.ReadWritePaths,
+			Rules:          abstract.Filesystem.Rules,
 		}
 	}
 
@@ -192,6 +344,24 @@ func newApparmorData(name string, abstract *apparmorprofileapi.AppArmorAbstract)
 			abstract.Network.Protocols.AllowUDP != nil {
 			data.Network.AllowUDP = *abstract.Network.Protocols.AllowUDP
 		}
+
+		data.Network.Rules = abstract.Network.Rules
+	}
+
+	if abstract.Signal != nil {
+		data.Signal = abstract.Signal.Rules
+	}
+
+	if abstract.Ptrace != nil {
+		data.Ptrace = abstract.Ptrace.Rules
+	}
+
+	if abstract.Dbus != nil {
+		data.Dbus = abstract.Dbus.Rules
+	}
+
+	if abstract.Mount != nil {
+		data.Mount = abstract.Mount.Rules
 	}
 
 	return data
@@ -240,6 +410,55 @@ func (d *ApparmorData) Validate() error {
 				return fmt.Errorf("validating execs and libs: %w", err)
 			}
 		}
+
+		for i := range d.Executable.Transitions {
+			if err := validateExecTransition(&d.Executable.Transitions[i]); err != nil {
+				return fmt.Errorf("validating exec transition: %w", err)
+			}
+		}
+	}
+
+	// 5. Validates all file rules.
+	if d.Filesystem != nil {
+		for i := range d.Filesystem.Rules {
+			if err := validateFileRule(&d.Filesystem.Rules[i]); err != nil {
+				return fmt.Errorf("validating file rule: %w", err)
+			}
+		}
+	}
+
+	// 6. Validates all network rules against the known families and socket types.
+	if d.Network != nil {
+		for i := range d.Network.Rules {
+			if err := validateNetworkRule(&d.Network.Rules[i]); err != nil {
+				return fmt.Errorf("validating network rule: %w", err)
+			}
+		}
+	}
+
+	// 7. Validates all signal, ptrace, dbus and mount rules.
+	for i := range d.Signal {
+		if err := validateSignalRule(&d.Signal[i]); err != nil {
+			return fmt.Errorf("validating signal rule: %w", err)
+		}
+	}
+
+	for i := range d.Ptrace {
+		if err := validatePtraceRule(&d.Ptrace[i]); err != nil {
+			return fmt.Errorf("validating ptrace rule: %w", err)
+		}
+	}
+
+	for i := range d.Dbus {
+		if err := validateDbusRule(&d.Dbus[i]); err != nil {
+			return fmt.Errorf("validating dbus rule: %w", err)
+		}
+	}
+
+	for i := range d.Mount {
+		if err := validateMountRule(&d.Mount[i]); err != nil {
+			return fmt.Errorf("validating mount rule: %w", err)
+		}
 	}
 
 	return nil
@@ -300,6 +519,154 @@ func validateExecutableOrLibrary(path string) error {
 	return nil
 }
 
+func validateExecTransition(t *apparmorprofileapi.AppArmorExecTransition) error {
+	if err := validateRulePath(t.Path); err != nil {
+		return err
+	}
+
+	if strings.Contains(t.Path, "/../") || strings.HasPrefix(t.Path, "/..") {
+		return fmt.Errorf("path cannot contain directory traversal: %q", t.Path)
+	}
+
+	switch t.Mode {
+	case apparmorprofileapi.AppArmorExecModeInherit:
+		if t.Profile != "" {
+			return fmt.Errorf("exec mode %q does not support a target profile", t.Mode)
+		}
+	case apparmorprofileapi.AppArmorExecModeProfile,
+		apparmorprofileapi.AppArmorExecModeProfileScrubbed,
+		apparmorprofileapi.AppArmorExecModeChild,
+		apparmorprofileapi.AppArmorExecModeChildScrubbed:
+		if t.Profile != "" && !profileNameChars.MatchString(t.Profile) {
+			return fmt.Errorf("invalid target profile name: %q", t.Profile)
+		}
+	default:
+		return fmt.Errorf("invalid exec mode: %q", t.Mode)
+	}
+
+	return nil
+}
+
+func validateFileRule(r *apparmorprofileapi.AppArmorFileRule) error {
+	if err := validateRulePath(r.Path); err != nil {
+		return err
+	}
+
+	if !fileModeChars.MatchString(r.Mode) {
+		return fmt.Errorf("invalid file mode: %q", r.Mode)
+	}
+
+	return nil
+}
+
+func validateNetworkRule(r *apparmorprofileapi.AppArmorNetworkRule) error {
+	if r.Family != "" && !slices.Contains(networkFamilies, r.Family) {
+		return fmt.Errorf("invalid network family: %q", r.Family)
+	}
+
+	if r.Type != "" && !slices.Contains(socketTypes, r.Type) {
+		return fmt.Errorf("invalid socket type: %q", r.Type)
+	}
+
+	return nil
+}
+
+func validateSignalRule(r *apparmorprofileapi.AppArmorSignalRule) error {
+	if err := validateAccess(r.Access, "send", "receive"); err != nil {
+		return err
+	}
+
+	for _, signal := range r.Signals {
+		if !slices.Contains(signals, signal) && !rtSignal.MatchString(signal) {
+			return fmt.Errorf("invalid signal: %q", signal)
+		}
+	}
+
+	return validatePeer(r.Peer)
+}
+
+func validatePtraceRule(r *apparmorprofileapi.AppArmorPtraceRule) error {
+	if err := validateAccess(r.Access, "read", "trace", "readby", "tracedby"); err != nil {
+		return err
+	}
+
+	return validatePeer(r.Peer)
+}
+
+func validateDbusRule(r *apparmorprofileapi.AppArmorDbusRule) error {
+	if err := validateAccess(r.Access, "send", "receive", "bind", "eavesdrop"); err != nil {
+		return err
+	}
+
+	for _, name := range []string{r.Bus, r.Name, r.Interface, r.Member, r.PeerName} {
+		if name != "" && !dbusNameChars.MatchString(name) {
+			return fmt.Errorf("invalid dbus name: %q", name)
+		}
+	}
+
+	if r.Path != "" {
+		if err := validateRulePath(r.Path); err != nil {
+			return err
+		}
+	}
+
+	return validatePeer(r.PeerLabel)
+}
+
+func validateMountRule(r *apparmorprofileapi.AppArmorMountRule) error {
+	switch r.Operation {
+	case apparmorprofileapi.AppArmorMountOperationMount:
+	case apparmorprofileapi.AppArmorMountOperationRemount:
+		if len(r.FsTypes) > 0 || r.Source != "" {
+			return fmt.Errorf("file system types and source are not supported for %q", r.Operation)
+		}
+	case apparmorprofileapi.AppArmorMountOperationUmount:
+		if len(r.FsTypes) > 0 || r.Source != "" || len(r.Options) > 0 {
+			return fmt.Errorf("file system types, options and source are not supported for %q", r.Operation)
+		}
+	default:
+		return fmt.Errorf("invalid mount operation: %q", r.Operation)
+	}
+
+	for _, val := range slices.Concat(r.FsTypes, r.Options, []string{r.Source}) {
+		if val != "" && !mountChars.MatchString(val) {
+			return fmt.Errorf("mount rule must contain only safe characters: %q", val)
+		}
+	}
+
+	if r.MountPoint != "" {
+		return validateRulePath(r.MountPoint)
+	}
+
+	return nil
+}
+
+func validateRulePath(path string) error {
+	if !rulePathRegex.MatchString(path) {
+		return fmt.Errorf("path must be absolute and contain only safe characters: %q", path)
+	}
+
+	return nil
+}
+
+func validatePeer(peer string) error {
+	if peer != "" && !peerChars.MatchString(peer) {
+		return fmt.Errorf("invalid peer: %q", peer)
+	}
+
+	return nil
+}
+
+func validateAccess(access []string, allowed ...string) error {
+	for _, a := range access {
+		if !slices.Contains(allowed, a) {
+			return fmt.Errorf("invalid access %q, must be one of %v", a, allowed)
+		}
+	}
+
+	return nil
+}
+
 type apparmorTemplateArgs struct {
 	Name         string
 	ProfileMode  string
@@ -340,10 +707,11 @@ func GenerateProfile(
 		Data:         data,
 		ProfileMode:  profileMode(mode),
 		ComplainMode: complain,
-		AllowMount:   allowMount,
+		// Explicit mount rules replace the generic ones.
+		AllowMount: allowMount && len(data.Mount) == 0,
 	}
 
-	tpl, err := template.New("apparmor").Parse(appArmorTemplate)
+	tpl, err := template.New("apparmor").Funcs(templateFuncs).Parse(appArmorTemplate)
 	if err != nil {
 		return "", err
 	}
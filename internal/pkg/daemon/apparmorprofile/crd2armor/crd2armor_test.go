@@ -74,6 +74,202 @@ func TestGenerateProfile(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:        "Generate profile with extended rules",
+			profileName: "ExtendedRules",
+			mode:        apparmorprofileapi.AppArmorModeEnforce,
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+					Transitions: []apparmorprofileapi.AppArmorExecTransition{
+						{Path: "/usr/bin/helper", Mode: apparmorprofileapi.AppArmorExecModeProfile, Profile: "helper"},
+						{Path: "/usr/bin/child", Mode: apparmorprofileapi.AppArmorExecModeChildScrubbed},
+					},
+				},
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					Rules: []apparmorprofileapi.AppArmorFileRule{
+						{Path: "/home/*/.cache/**", Mode: "rwk", Owner: true},
+						{Path: "/etc/shadow", Mode: "rw", Deny: true},
+					},
+				},
+				Network: &apparmorprofileapi.AppArmorNetworkRules{
+					Rules: []apparmorprofileapi.AppArmorNetworkRule{
+						{Family: "unix", Type: "stream"},
+						{Family: "netlink"},
+						{Family: "packet", Deny: true},
+					},
+				},
+				Signal: &apparmorprofileapi.AppArmorSignalRules{
+					Rules: []apparmorprofileapi.AppArmorSignalRule{
+						{Access: []string{"send"}, Signals: []string{"term", "kill"}, Peer: "worker"},
+					},
+				},
+				Ptrace: &apparmorprofileapi.AppArmorPtraceRules{
+					Rules: []apparmorprofileapi.AppArmorPtraceRule{
+						{Access: []string{"read"}, Peer: "worker"},
+					},
+				},
+				Dbus: &apparmorprofileapi.AppArmorDbusRules{
+					Rules: []apparmorprofileapi.AppArmorDbusRule{
+						{
+							Access:    []string{"send"},
+							Bus:       "system",
+							Path:      "/org/freedesktop/DBus",
+							Interface: "org.freedesktop.DBus",
+							Member:    "Hello",
+							PeerName:  "org.freedesktop.DBus",
+						},
+					},
+				},
+				Mount: &apparmorprofileapi.AppArmorMountRules{
+					Rules: []apparmorprofileapi.AppArmorMountRule{
+						{
+							Operation:  apparmorprofileapi.AppArmorMountOperationMount,
+							FsTypes:    []string{"tmpfs"},
+							Options:    []string{"rw", "nosuid"},
+							Source:     "tmpfs",
+							MountPoint: "/tmp/",
+						},
+						{Operation: apparmorprofileapi.AppArmorMountOperationUmount, MountPoint: "/tmp/"},
+					},
+				},
+				Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+					AllowedCapabilities: []string{"sys_rawio"},
+				},
+			},
+			mustContain: []string{
+				"/usr/bin/helper px -> helper,",
+				"/usr/bin/child Cx,",
+				"owner /home/*/.cache/** rwk,",
+				"deny /etc/shadow rw,",
+				"network unix stream,",
+				"network netlink,",
+				"deny network packet,",
+				"signal (send) set=(term, kill) peer=worker,",
+				"ptrace (read) peer=worker,",
+				"dbus (send) bus=system path=/org/freedesktop/DBus interface=org.freedesktop.DBus " +
+					"member=Hello peer=(name=org.freedesktop.DBus),",
+				"mount fstype=(tmpfs) options=(rw, nosuid) tmpfs -> /tmp/,",
+				"umount /tmp/,",
+			},
+			mustNotContain: []string{
+				"  mount,",
+				"/sbin/fsck ixr,",
+			},
+			wantErr: false,
+		},
+		{
+			name:        "Generate profile with extended rules in complain mode without deny",
+			profileName: "ExtendedRulesComplain",
+			mode:        apparmorprofileapi.AppArmorModeComplain,
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					Rules: []apparmorprofileapi.AppArmorFileRule{
+						{Path: "/etc/shadow", Mode: "rw", Deny: true},
+						{Path: "/etc/hosts", Mode: "r"},
+					},
+				},
+				Network: &apparmorprofileapi.AppArmorNetworkRules{
+					Rules: []apparmorprofileapi.AppArmorNetworkRule{
+						{Family: "packet", Deny: true},
+					},
+				},
+			},
+			mustContain: []string{
+				"/etc/hosts r,",
+			},
+			mustNotContain: []string{
+				"deny /etc/shadow rw,",
+				"deny network packet,",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Rule sanitization - bad - file mode injection",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					Rules: []apparmorprofileapi.AppArmorFileRule{{Path: "/etc/hosts", Mode: "r, /** rwx"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - file path with space",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					Rules: []apparmorprofileapi.AppArmorFileRule{{Path: "/etc/hosts /**", Mode: "r"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - unknown network family",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Network: &apparmorprofileapi.AppArmorNetworkRules{
+					Rules: []apparmorprofileapi.AppArmorNetworkRule{{Family: "unix,"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - inherit transition with profile",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+					Transitions: []apparmorprofileapi.AppArmorExecTransition{
+						{Path: "/usr/bin/helper", Mode: apparmorprofileapi.AppArmorExecModeInherit, Profile: "helper"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - unconfined transition",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+					Transitions: []apparmorprofileapi.AppArmorExecTransition{
+						{Path: "/usr/bin/helper", Mode: "ux"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - signal peer injection",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Signal: &apparmorprofileapi.AppArmorSignalRules{
+					Rules: []apparmorprofileapi.AppArmorSignalRule{{Peer: "foo,\n  /** rwx"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - ptrace access",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Ptrace: &apparmorprofileapi.AppArmorPtraceRules{
+					Rules: []apparmorprofileapi.AppArmorPtraceRule{{Access: []string{"write"}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - dbus member injection",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Dbus: &apparmorprofileapi.AppArmorDbusRules{
+					Rules: []apparmorprofileapi.AppArmorDbusRule{{Member: "Hello),"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Rule sanitization - bad - umount with options",
+			abstract: &apparmorprofileapi.AppArmorAbstract{
+				Mount: &apparmorprofileapi.AppArmorMountRules{
+					Rules: []apparmorprofileapi.AppArmorMountRule{
+						{Operation: apparmorprofileapi.AppArmorMountOperationUmount, Options: []string{"ro"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name:        "Name sanitization - good - alphanumeric and dashes",
 			profileName: "my-app_profile.v1",
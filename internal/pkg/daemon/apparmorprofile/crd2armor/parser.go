@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// SandboxCommand is the hidden command of the operator binary which
+	// restricts itself by using RunSandboxed before executing
+	// apparmor_parser.
+	SandboxCommand = "apparmor-parser-sandbox"
+
+	// parserTimeout limits the time apparmor_parser is allowed to run.
+	parserTimeout = 10 * time.Second
+	// maxParserOutput limits the parser output included in errors.
+	maxParserOutput = 1024
+	// selfExecutable is the binary providing SandboxCommand.
+	selfExecutable = "/proc/self/exe"
+)
+
+// parserLocations are the default locations of apparmor_parser.
+var parserLocations = []string{
+	"/usr/sbin/apparmor_parser",
+	"/sbin/apparmor_parser",
+}
+
+// Parser runs apparmor_parser on profiles without loading them into the
+// kernel.
+type Parser struct {
+	path string
+}
+
+// FindParser looks up apparmor_parser and returns false if it is not
+// available.
+func FindParser() (*Parser, bool) {
+	for _, location := range parserLocations {
+		if _, err := os.Stat(location); err == nil {
+			return &Parser{path: location}, true
+		}
+	}
+
+	if path, err := exec.LookPath("apparmor_parser"); err == nil {
+		return &Parser{path: path}, true
+	}
+
+	return nil, false
+}
+
+// DryRun compiles the profile without loading it into the kernel or
+// writing to the profile cache.
+func (p *Parser) DryRun(ctx context.Context, profile string) error {
+	if _, err := p.run(ctx, profile, "--skip-kernel-load", "--skip-cache", "--quiet"); err != nil {
+		return fmt.Errorf("compiling profile: %w", err)
+	}
+
+	return nil
+}
+
+// ProfileNames returns the names of all profiles defined by profile,
+// including child profiles and hats.
+func (p *Parser) ProfileNames(ctx context.Context, profile string) ([]string, error) {
+	out, err := p.run(ctx, profile, "--names")
+	if err != nil {
+		return nil, fmt.Errorf("listing profile names: %w", err)
+	}
+
+	return strings.Fields(out), nil
+}
+
+// run executes the parser on profile passed via stdin. The parser runs in a
+// sandbox with an empty environment and is killed after parserTimeout. The
+// sandbox uses new user, mount, network, PID, IPC, UTS and cgroup
+// namespaces if they can be created, and always applies the resource limits
+// and the seccomp filter of RunSandboxed. The returned error contains the
+// output of the parser, which is meant for logging only.
+func (p *Parser) run(ctx context.Context, profile string, args ...string) (string, error) {
+	out, err := p.runSandboxed(ctx, profile, true, args)
+	if isNamespaceError(err) {
+		out, err = p.runSandboxed(ctx, profile, false, args)
+	}
+
+	return out, err
+}
+
+func (p *Parser) runSandboxed(ctx context.Context, profile string, namespaces bool, args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, parserTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	//nolint:gosec // the parser is looked up by FindParser
+	cmd := exec.CommandContext(ctx, selfExecutable, append([]string{SandboxCommand, p.path}, args...)...)
+	cmd.Env = []string{}
+	cmd.Dir = "/"
+	cmd.Stdin = strings.NewReader(profile)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.SysProcAttr = sandboxProcAttr(namespaces)
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxParserOutput {
+			msg = msg[:maxParserOutput]
+		}
+
+		return "", fmt.Errorf("running %s: %w: %s", p.path, err, msg)
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+)
+
+// rawHeader is the header of a top level block in a raw profile, for
+// example `profile foo flags=(complain)`.
+type rawHeader struct {
+	// start is the offset of the header in the raw profile.
+	start int
+	// end is the offset of the opening brace of the block.
+	end int
+}
+
+var (
+	// Matches the profile flags, either as `flags=(...)` or as the legacy `(...)`.
+	rawFlagsRegex = regexp.MustCompile(`(?:flags\s*=\s*)?\(([^)]*)\)`)
+	// Flags which select the profile mode and are replaced in the audit variant.
+	rawModeFlags = []string{"enforce", "complain", "kill", "unconfined", "prompt"}
+	// Matches the target of include statements, either `<path>` or `"path"`.
+	rawIncludeRegex = regexp.MustCompile(`\binclude(?:\s+if\s+exists)?\s*([<"])([^>"]*)`)
+)
+
+// ValidateRawProfile checks that the raw AppArmor profile defines exactly one
+// top level profile which is named name and only includes files of the
+// AppArmor configuration directory. This is a static check which does not
+// replace running the profile through apparmor_parser, but it catches
+// profiles which would overwrite other profiles on the node early.
+func ValidateRawProfile(name, raw string) error {
+	if _, err := rawProfileHeader(name, raw); err != nil {
+		return err
+	}
+
+	return validateRawIncludes(raw)
+}
+
+// validateRawIncludes rejects includes which are not relative to the AppArmor
+// configuration directory, like `include "/etc/shadow"` or
+// `include <../../etc/shadow>`, which would let apparmor_parser read
+// arbitrary files.
+func validateRawIncludes(raw string) error {
+	for _, match := range rawIncludeRegex.FindAllStringSubmatch(raw, -1) {
+		target := match[2]
+		if match[1] == `"` {
+			return fmt.Errorf("include %q must use the <path> syntax", target)
+		}
+
+		if !filepath.IsLocal(target) {
+			return fmt.Errorf("include <%s> must be relative to the AppArmor configuration directory", target)
+		}
+	}
+
+	return nil
+}
+
+// GenerateRawAuditProfile renames the top level profile of raw from name to
+// auditName and switches it to complain mode.
+func GenerateRawAuditProfile(name, auditName, raw string) (string, error) {
+	if !profileNameChars.MatchString(auditName) {
+		return "", fmt.Errorf("invalid profile name: %q", auditName)
+	}
+
+	header, err := rawProfileHeader(name, raw)
+	if err != nil {
+		return "", err
+	}
+
+	complain := profileMode(apparmorprofileapi.AppArmorModeComplain)
+	decl := raw[header.start:header.end]
+	keyword := strings.Index(decl, "profile") + len("profile")
+	rest := strings.TrimLeft(decl[keyword:], " \t\r\n")
+	rest = rest[len(firstRawToken(rest)):]
+
+	if loc := rawFlagsRegex.FindStringSubmatchIndex(rest); loc != nil {
+		flags := []string{complain}
+
+		for _, flag := range strings.FieldsFunc(rest[loc[2]:loc[3]], func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t' || r == '\n'
+		}) {
+			if !slices.Contains(rawModeFlags, flag) {
+				flags = append(flags, flag)
+			}
+		}
+
+		rest = rest[:loc[0]] + "flags=(" + strings.Join(flags, ",") + ")" + rest[loc[1]:]
+	} else {
+		rest = strings.TrimRight(rest, " \t\r\n") + " flags=(" + complain + ") "
+	}
+
+	return raw[:header.start] + "profile " + auditName + rest + raw[header.end:], nil
+}
+
+// ValidateProfileNames checks that the profile names reported by
+// apparmor_parser belong to the profile name, which means that they are
+// either the profile itself or one of its children.
+func ValidateProfileNames(name string, names []string) error {
+	if len(names) == 0 {
+		return errors.New("raw profile does not define any profile")
+	}
+
+	for _, n := range names {
+		if n != name && !strings.HasPrefix(n, name+"//") {
+			return fmt.Errorf("raw profile defines profile %q which does not belong to %q", n, name)
+		}
+	}
+
+	return nil
+}
+
+func rawProfileHeader(name, raw string) (*rawHeader, error) {
+	if !profileNameChars.MatchString(name) {
+		return nil, fmt.Errorf("invalid profile name: %q", name)
+	}
+
+	headers, err := scanRawProfile(raw)
+	if err != nil {
+		return nil, fmt.Errorf("scanning raw profile: %w", err)
+	}
+
+	if len(headers) != 1 {
+		return nil, fmt.Errorf("raw profile must define exactly one top level profile, found %d", len(headers))
+	}
+
+	decl := raw[headers[0].start:headers[0].end]
+
+	fields := strings.Fields(decl)
+	if len(fields) < 2 || fields[0] != "profile" {
+		return nil, fmt.Errorf("top level block must be declared as `profile %s`: %q", name, decl)
+	}
+
+	declName := firstRawToken(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(decl), "profile")))
+	if unquoted, err := strconv.Unquote(declName); err == nil {
+		declName = unquoted
+	}
+
+	if declName != name {
+		return nil, fmt.Errorf("raw profile is named %q, but must be named %q", declName, name)
+	}
+
+	return &headers[0], nil
+}
+
+// firstRawToken returns the first whitespace separated token of s, quoted
+// tokens are returned including their quotes.
+func firstRawToken(s string) string {
+	if strings.HasPrefix(s, `"`) {
+		if end := closingQuote(s, 0); end > 0 {
+			return s[:end+1]
+		}
+	}
+
+	if i := strings.IndexAny(s, " \t\r\n{("); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
+
+// scanRawProfile returns the headers of all top level blocks in raw. The
+// scanner tracks comments, quoted strings, parentheses and the nesting of
+// blocks. Braces
+// which are part of a word, like in `/usr/{bin,sbin}/foo`, are alternations
+// and not treated as blocks. Preamble statements like includes and
+// variable definitions are terminated by the end of the line.
+func scanRawProfile(raw string) ([]rawHeader, error) {
+	var (
+		headers   []rawHeader
+		depth     int
+		altDepth  int
+		parens    int
+		stmtStart = -1
+		lineStmt  bool
+	)
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+
+		if c == '#' && atTokenStart(raw, i) && !isRawInclude(raw[i:]) {
+			if nl := strings.IndexByte(raw[i:], '\n'); nl >= 0 {
+				i += nl - 1
+			} else {
+				i = len(raw)
+			}
+
+			continue
+		}
+
+		if depth == 0 {
+			if stmtStart < 0 {
+				if isSpace(c) {
+					continue
+				}
+
+				stmtStart = i
+				lineStmt = c == '@' || c == '$' || isRawInclude(raw[i:])
+			}
+
+			if lineStmt {
+				if c == '\n' {
+					stmtStart = -1
+				}
+
+				continue
+			}
+		}
+
+		switch c {
+		case '"':
+			end := closingQuote(raw, i)
+			if end < 0 {
+				return nil, errors.New("unterminated quoted string")
+			}
+
+			i = end
+		case ' ', '\t', '\r', '\n':
+			altDepth = 0
+		case '(':
+			if depth == 0 {
+				parens++
+			}
+		case ')':
+			if depth == 0 {
+				parens = max(parens-1, 0)
+			}
+		case ',':
+			if depth == 0 && parens == 0 {
+				stmtStart = -1
+			}
+		case '{':
+			switch {
+			case depth == 0:
+				headers = append(headers, rawHeader{start: stmtStart, end: i})
+				stmtStart = -1
+				parens = 0
+				depth++
+			case atTokenStart(raw, i) || raw[i-1] == ')':
+				depth++
+			default:
+				altDepth++
+			}
+		case '}':
+			switch {
+			case altDepth > 0:
+				altDepth--
+			case depth == 0:
+				return nil, errors.New("unbalanced closing brace")
+			default:
+				depth--
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, errors.New("unbalanced opening brace")
+	}
+
+	return headers, nil
+}
+
+// closingQuote returns the offset of the quote which closes the quoted
+// string starting at start, or -1 if the string is not terminated.
+func closingQuote(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+func atTokenStart(s string, i int) bool {
+	return i == 0 || isSpace(s[i-1]) || s[i-1] == ','
+}
+
+func isRawInclude(s string) bool {
+	return strings.HasPrefix(s, "#include") || strings.HasPrefix(s, "include")
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
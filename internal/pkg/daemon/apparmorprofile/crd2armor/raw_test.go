@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRawProfile(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		raw         string
+		errContains string
+	}{
+		{
+			name: "valid profile",
+			raw: `#include <tunables/global>
+@{HOMES}={/home/*/,/root/}
+
+# The profile of the application
+profile test-profile flags=(attach_disconnected) {
+  #include <abstractions/base>
+  /usr/{bin,sbin}/* ixr,
+  @{HOMES}** r,
+  /tmp/"with space" rw,
+
+  profile child {
+    /bin/true ixr,
+  }
+
+  ^hat {
+    /etc/hosts r,
+  }
+}
+`,
+		},
+		{
+			name: "valid quoted profile name",
+			raw:  `profile "test-profile" {}`,
+		},
+		{
+			name:        "path attached profile",
+			raw:         "/usr/bin/foo {\n}\n",
+			errContains: "top level block must be declared as `profile test-profile`",
+		},
+		{
+			name:        "wrong profile name",
+			raw:         "profile other-profile {\n}\n",
+			errContains: `raw profile is named "other-profile", but must be named "test-profile"`,
+		},
+		{
+			name:        "multiple profiles",
+			raw:         "profile test-profile {\n}\nprofile other-profile {\n}\n",
+			errContains: "must define exactly one top level profile, found 2",
+		},
+		{
+			name:        "no profile",
+			raw:         "#include <tunables/global>\n",
+			errContains: "must define exactly one top level profile, found 0",
+		},
+		{
+			name: "profile in comment is ignored",
+			raw:  "# profile other-profile {\nprofile test-profile {\n}\n",
+		},
+		{
+			name:        "block escape",
+			raw:         "profile test-profile {\n  /etc/hosts r,}\n}\nprofile other-profile {\n}\n",
+			errContains: "unbalanced closing brace",
+		},
+		{
+			name:        "unbalanced profile",
+			raw:         "profile test-profile {\n  profile child {\n}\n",
+			errContains: "unbalanced opening brace",
+		},
+		{
+			name:        "unterminated quote",
+			raw:         "profile test-profile {\n  \"/etc/hosts r,\n}\n",
+			errContains: "unterminated quoted string",
+		},
+		{
+			name: "include if exists",
+			raw:  "profile test-profile {\n  include if exists <local/test-profile>\n  /usr/include/** r,\n}\n",
+		},
+		{
+			name:        "absolute include",
+			raw:         "include </etc/shadow>\nprofile test-profile {\n}\n",
+			errContains: "include </etc/shadow> must be relative",
+		},
+		{
+			name:        "out of tree include",
+			raw:         "profile test-profile {\n  #include <abstractions/../../../etc/shadow>\n}\n",
+			errContains: "include <abstractions/../../../etc/shadow> must be relative",
+		},
+		{
+			name:        "quoted include",
+			raw:         "profile test-profile {\n  include if exists \"/etc/shadow\"\n}\n",
+			errContains: `include "/etc/shadow" must use the <path> syntax`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRawProfile("test-profile", tc.raw)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateRawAuditProfile(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "without flags",
+			raw:  "#include <tunables/global>\nprofile test {\n  profile child {}\n}\n",
+			want: "#include <tunables/global>\nprofile test_audit flags=(complain) {\n  profile child {}\n}\n",
+		},
+		{
+			name: "with flags",
+			raw:  "profile test flags=(enforce, attach_disconnected) {\n}\n",
+			want: "profile test_audit flags=(complain,attach_disconnected) {\n}\n",
+		},
+		{
+			name: "with attachment",
+			raw:  "profile \"test\" /usr/bin/test (kill) {\n}\n",
+			want: "profile test_audit /usr/bin/test flags=(complain) {\n}\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GenerateRawAuditProfile("test", "test_audit", tc.raw)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+			require.NoError(t, ValidateRawProfile("test_audit", got))
+		})
+	}
+}
+
+func TestValidateProfileNames(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateProfileNames("test", []string{"test", "test//child", "test//hat"}))
+	require.Error(t, ValidateProfileNames("test", []string{"test", "testing"}))
+	require.Error(t, ValidateProfileNames("test", []string{"test", "other//test"}))
+	require.Error(t, ValidateProfileNames("test", nil))
+}
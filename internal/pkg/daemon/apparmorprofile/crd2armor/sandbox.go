@@ -0,0 +1,195 @@
+//go:build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+
+	seccomp "github.com/seccomp/libseccomp-golang"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// sandboxUser is the user and group apparmor_parser runs as inside of
+	// the user namespace.
+	sandboxUser = 65534
+
+	// sandboxNamespaces isolates apparmor_parser from the network, the
+	// processes and the mounts of the caller.
+	sandboxNamespaces = unix.CLONE_NEWUSER | unix.CLONE_NEWNS | unix.CLONE_NEWNET |
+		unix.CLONE_NEWPID | unix.CLONE_NEWIPC | unix.CLONE_NEWUTS | unix.CLONE_NEWCGROUP
+)
+
+// sandboxLimits are the resource limits of apparmor_parser.
+var sandboxLimits = map[int]uint64{
+	unix.RLIMIT_AS:     512 * 1024 * 1024,
+	unix.RLIMIT_CPU:    uint64(parserTimeout.Seconds()),
+	unix.RLIMIT_FSIZE:  0,
+	unix.RLIMIT_NOFILE: 64,
+	unix.RLIMIT_CORE:   0,
+}
+
+// sandboxDeniedSyscalls are not required for compiling profiles and fail
+// with EPERM.
+var sandboxDeniedSyscalls = []string{
+	// Network
+	"socket", "socketpair", "connect", "bind", "listen", "accept", "accept4",
+	"sendto", "sendmsg", "sendmmsg",
+	// Other processes and namespaces
+	"ptrace", "process_vm_readv", "process_vm_writev", "pidfd_open", "pidfd_getfd",
+	"unshare", "setns", "execveat",
+	// File system modifications
+	"creat", "mount", "umount2", "pivot_root", "chroot", "mknod", "mknodat",
+	"unlink", "unlinkat", "rename", "renameat", "renameat2", "mkdir", "mkdirat", "rmdir",
+	"link", "linkat", "symlink", "symlinkat", "truncate", "ftruncate",
+	"chmod", "fchmod", "fchmodat", "chown", "fchown", "fchownat", "lchown",
+	// Kernel
+	"bpf", "perf_event_open", "init_module", "finit_module", "delete_module",
+	"kexec_load", "kexec_file_load", "keyctl", "add_key", "request_key", "userfaultfd",
+}
+
+// sandboxOpenFlags are the flags which allow opening files for writing.
+var sandboxOpenFlags = []uint64{unix.O_WRONLY, unix.O_RDWR, unix.O_CREAT, unix.O_TRUNC}
+
+// sandboxOpenSyscalls maps the syscalls opening files to the index of their
+// flags argument.
+var sandboxOpenSyscalls = map[string]uint{"open": 1, "openat": 2}
+
+// sandboxProcAttr returns the attributes of the sandbox process, which runs
+// in new namespaces if namespaces is true.
+func sandboxProcAttr(namespaces bool) *syscall.SysProcAttr {
+	attr := &syscall.SysProcAttr{Pdeathsig: syscall.SIGKILL}
+	if !namespaces {
+		return attr
+	}
+
+	attr.Cloneflags = sandboxNamespaces
+	attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: sandboxUser, HostID: os.Getuid(), Size: 1}}
+	attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: sandboxUser, HostID: os.Getgid(), Size: 1}}
+	attr.GidMappingsEnableSetgroups = false
+
+	return attr
+}
+
+// isNamespaceError returns true if err indicates that the sandbox could not
+// be started because creating namespaces is not permitted, for example by
+// the seccomp profile of the container.
+func isNamespaceError(err error) bool {
+	var pathErr *os.PathError
+	if !errors.As(err, &pathErr) {
+		return false
+	}
+
+	return errors.Is(err, unix.EPERM) || errors.Is(err, unix.EINVAL) || errors.Is(err, unix.ENOSPC)
+}
+
+// RunSandboxed restricts the current process and replaces it with the
+// apparmor_parser at args[0], which gets the remaining args. It is the
+// entrypoint of SandboxCommand and only returns on failure.
+func RunSandboxed(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no parser provided")
+	}
+
+	// The no new privileges bit is set per thread, which has to be the one
+	// replaced by the parser.
+	runtime.LockOSThread()
+
+	for resource, limit := range sandboxLimits {
+		if err := unix.Setrlimit(resource, &unix.Rlimit{Cur: limit, Max: limit}); err != nil {
+			return fmt.Errorf("set resource limit %d: %w", resource, err)
+		}
+	}
+
+	if err := loadSandboxFilter(); err != nil {
+		return err
+	}
+
+	//nolint:gosec // the parser is looked up by FindParser
+	if err := unix.Exec(args[0], args, []string{}); err != nil {
+		return fmt.Errorf("exec %s: %w", args[0], err)
+	}
+
+	return nil
+}
+
+// loadSandboxFilter loads a seccomp filter which denies the syscalls the
+// parser does not need and opening files for writing. It also sets the no
+// new privileges bit.
+func loadSandboxFilter() error {
+	filter, err := seccomp.NewFilter(seccomp.ActAllow)
+	if err != nil {
+		return fmt.Errorf("create seccomp filter: %w", err)
+	}
+	defer filter.Release()
+
+	if err := filter.SetNoNewPrivsBit(true); err != nil {
+		return fmt.Errorf("set no new privileges: %w", err)
+	}
+
+	deny := seccomp.ActErrno.SetReturnCode(int16(unix.EPERM))
+
+	for _, name := range sandboxDeniedSyscalls {
+		id, err := seccomp.GetSyscallFromName(name)
+		if err != nil {
+			// Not available on this architecture
+			continue
+		}
+
+		if err := filter.AddRule(id, deny); err != nil {
+			return fmt.Errorf("add seccomp rule for %s: %w", name, err)
+		}
+	}
+
+	// openat2 passes the flags in a struct, let the C library fall back to
+	// openat.
+	if id, err := seccomp.GetSyscallFromName("openat2"); err == nil {
+		if err := filter.AddRule(id, seccomp.ActErrno.SetReturnCode(int16(unix.ENOSYS))); err != nil {
+			return fmt.Errorf("add seccomp rule for openat2: %w", err)
+		}
+	}
+
+	for name, flagsIndex := range sandboxOpenSyscalls {
+		id, err := seccomp.GetSyscallFromName(name)
+		if err != nil {
+			continue
+		}
+
+		for _, flag := range sandboxOpenFlags {
+			cond, err := seccomp.MakeCondition(flagsIndex, seccomp.CompareMaskedEqual, flag, flag)
+			if err != nil {
+				return fmt.Errorf("make seccomp condition: %w", err)
+			}
+
+			if err := filter.AddRuleConditional(id, deny, []seccomp.ScmpCondition{cond}); err != nil {
+				return fmt.Errorf("add seccomp rule for %s: %w", name, err)
+			}
+		}
+	}
+
+	if err := filter.Load(); err != nil {
+		return fmt.Errorf("load seccomp filter: %w", err)
+	}
+
+	return nil
+}
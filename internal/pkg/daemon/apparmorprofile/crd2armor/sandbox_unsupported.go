@@ -0,0 +1,37 @@
+//go:build !linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"errors"
+	"syscall"
+)
+
+func sandboxProcAttr(bool) *syscall.SysProcAttr {
+	return nil
+}
+
+func isNamespaceError(error) bool {
+	return false
+}
+
+// RunSandboxed is only supported on Linux.
+func RunSandboxed([]string) error {
+	return errors.New("sandbox is only supported on Linux")
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	Pull(context.Context, logr.Logger, string, string, string, *v1.Platform,
+		*artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)
+	PullResultType(*artifact.PullResult) artifact.PullResultType
+	PullResultApparmorProfile(*artifact.PullResult) *apparmorprofileapi.AppArmorProfile
+	PullResultDigest(*artifact.PullResult) digest.Digest
+}
+
+func (*defaultImpl) Pull(
+	ctx context.Context,
+	l logr.Logger,
+	from, username, password string,
+	platform *v1.Platform,
+	signOpts *artifact.PullSignatureOptions,
+	regOpts *artifact.RegistryOptions,
+) (*artifact.PullResult, error) {
+	return artifact.New(l).Pull(ctx, from, username, password, platform, signOpts, regOpts)
+}
+
+func (*defaultImpl) PullResultType(res *artifact.PullResult) artifact.PullResultType {
+	return res.Type()
+}
+
+func (*defaultImpl) PullResultApparmorProfile(res *artifact.PullResult) *apparmorprofileapi.AppArmorProfile {
+	return res.ApparmorProfile()
+}
+
+func (*defaultImpl) PullResultDigest(res *artifact.PullResult) digest.Digest {
+	return res.Digest()
+}
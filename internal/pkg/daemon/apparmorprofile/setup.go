@@ -33,6 +33,7 @@ func (r *Reconciler) Setup(
 	met *metrics.Metrics,
 ) error {
 	r.client = mgr.GetClient()
+	r.reader = mgr.GetAPIReader()
 	r.log = ctrl.Log.WithName(r.Name())
 	//nolint:staticcheck,nolintlint // TODO: migrate to GetEventRecorder
 	r.record = mgr.GetEventRecorderFor("apparmorprofile")
@@ -11,6 +11,8 @@
 #define MAX_ENTRIES 8 * 1024
 #define MAX_SYSCALLS 1024
 #define MAX_CHILD_PIDS 1024
+#define MAX_SYSCALL_ARGS 16 * 1024
+#define MAX_SYSCALL_ARG_INDEXES 2
 
 // We don't have TASK_COMM_LEN in userspace, so we define
 // a static MAX_COMM_LEN which is supposed to be >= TASK_COMM_LEN
@@ -23,6 +25,7 @@
 #define EVENT_TYPE_APPARMOR_CAP 4
 #define EVENT_TYPE_CLEAR_MNTNS 5
 #define EVENT_TYPE_EXECVE_ENTER 6
+#define EVENT_TYPE_SELINUX_AVC 7
 
 #define FLAG_READ 0x1
 #define FLAG_WRITE 0x2
@@ -51,6 +54,16 @@
 
 #define SOCK_RAW 3
 
+#define EEXIST 17
+
+// Layout of the SELinux AVC event data, which contains the NUL terminated
+// source context, target context and target class.
+#define SELINUX_CONTEXT_LEN 1024
+#define SELINUX_TCLASS_LEN 256
+#define SELINUX_SCONTEXT_OFFSET 0
+#define SELINUX_TCONTEXT_OFFSET SELINUX_CONTEXT_LEN
+#define SELINUX_TCLASS_OFFSET (2 * SELINUX_CONTEXT_LEN)
+
 char LICENSE[] SEC("license") = "Dual BSD/GPL";
 
 #ifndef likely
@@ -93,6 +106,43 @@ struct {
     __type(value, u8[MAX_SYSCALLS]);  // syscall IDs
 } mntns_syscalls SEC(".maps");
 
+// Selects the arguments to record per syscall ID. Populated from userspace
+// for the syscalls supporting argument level recording.
+typedef struct syscall_args_filter {
+    u32 num_args;
+    u32 index[MAX_SYSCALL_ARG_INDEXES];
+} syscall_args_filter_t;
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(max_entries, MAX_SYSCALLS);
+    __type(key, u32);                     // syscall ID
+    __type(value, syscall_args_filter_t);
+} syscall_args_filter SEC(".maps");
+
+typedef struct syscall_args_key {
+    u32 mntns;
+    u32 syscall_id;
+    u64 args[MAX_SYSCALL_ARG_INDEXES];
+} syscall_args_key_t;
+
+// The distinct argument values of the filtered syscalls per mntns.
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_SYSCALL_ARGS);
+    __type(key, syscall_args_key_t);
+    __type(value, u8);
+} syscall_args SEC(".maps");
+
+// Track syscalls for each mntns for which not all argument values could be
+// recorded because the syscall_args map is full.
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_ENTRIES);
+    __type(key, u32);                 // mntns
+    __type(value, u8[MAX_SYSCALLS]);  // syscall IDs
+} mntns_syscall_args_overflow SEC(".maps");
+
 // Track active (known) PIDs
 struct {
     __uint(type, BPF_MAP_TYPE_HASH);
@@ -196,6 +246,7 @@ static __always_inline u32 clear_mntns_seccomp(u32 mntns)
 {
     trace_hook("clear_mntns_seccomp mntns=%u", mntns);
     bpf_map_delete_elem(&mntns_syscalls, &mntns);
+    bpf_map_delete_elem(&mntns_syscall_args_overflow, &mntns);
     return 0;
 }
 
@@ -541,7 +592,17 @@ int sys_enter_socket(struct trace_event_raw_sys_enter * ctx)
             return 0;
         }
 
-        event->flags = type;
+        u64 family;
+        res = bpf_core_read(&family, sizeof(family), &ctx->args[0]);
+        if (res != 0) {
+            bpf_printk("failed to get socket family");
+            bpf_ringbuf_discard(event, 0);
+            return 0;
+        }
+
+        // The socket type is stored in the lower and the address family in
+        // the upper 32 bits of the flags.
+        event->flags = (type & 0xFFFFFFFF) | (family << 32);
 
         trace_hook("requesting raw socket");
         bpf_ringbuf_submit(event, 0);
@@ -585,6 +646,91 @@ int BPF_KPROBE(cap_capable)
     return 0;
 }
 
+// Copies a dynamic string field of a tracepoint. The lower 16 bits of the
+// data location contain the offset relative to the start of the record.
+static __always_inline void read_data_loc_str(char * dest, u32 max_len,
+                                              void * ctx, u32 data_loc)
+{
+    bpf_probe_read_kernel_str(dest, max_len, ctx + (data_loc & 0xFFFF));
+}
+
+/**
+From the file:
+/sys/kernel/debug/tracing/events/avc/selinux_audited/format
+format:
+    field:unsigned short common_type;	offset:0;	size:2;	signed:0;
+    field:unsigned char common_flags;	offset:2;	size:1;	signed:0;
+    field:unsigned char common_preempt_count;	offset:3;	size:1;	signed:0;
+    field:int common_pid;	offset:4;	size:4;	signed:1;
+
+    field:u32 requested;	offset:8;	size:4;	signed:0;
+    field:u32 denied;	offset:12;	size:4;	signed:0;
+    field:u32 audited;	offset:16;	size:4;	signed:0;
+    field:int result;	offset:20;	size:4;	signed:1;
+    field:__data_loc char[] scontext;	offset:24;	size:4;	signed:0;
+    field:__data_loc char[] tcontext;	offset:28;	size:4;	signed:0;
+    field:__data_loc char[] tclass;	offset:32;	size:4;	signed:0;
+
+The struct is defined here instead of using the one from vmlinux.h to avoid
+CO-RE relocations, which would fail loading the whole BPF object on kernels
+without SELinux support.
+*/
+struct selinux_audited_info {
+    __u16 common_type;          // Offset=0, size=2
+    __u8 common_flags;          // Offset=2, size=1
+    __u8 common_preempt_count;  // Offset=3, size=1
+    __s32 common_pid;           // Offset=4, size=4
+
+    __u32 requested;  // Offset=8, size=4
+    __u32 denied;     // Offset=12, size=4
+    __u32 audited;    // Offset=16, size=4
+    __s32 result;     // Offset=20, size=4
+    __u32 scontext;   // Offset=24, size=4 (data location)
+    __u32 tcontext;   // Offset=28, size=4 (data location)
+    __u32 tclass;     // Offset=32, size=4 (data location)
+};
+
+// The tracepoint is triggered for every AVC which gets audited by the kernel,
+// independent of whether an audit daemon is running. Recorded workloads run in
+// a permissive domain, so denials are reported but not enforced.
+SEC("tracepoint/avc/selinux_audited")
+int selinux_audited(struct selinux_audited_info * ctx)
+{
+    if (!_is_recording_cached)
+        return 0;
+    u32 mntns = get_mntns();
+    if (!mntns)
+        return 0;
+
+    u32 denied = ctx->denied;
+    if (!denied)
+        return 0;
+    trace_hook("selinux_audited: denied=%x", denied);
+
+    event_data_t * event =
+        bpf_ringbuf_reserve(&events, sizeof(event_data_t), 0);
+    if (event) {
+        event->pid = bpf_get_current_pid_tgid() >> 32;
+        event->mntns = mntns;
+        event->type = EVENT_TYPE_SELINUX_AVC;
+        event->flags = denied;
+
+        read_data_loc_str(&event->data[SELINUX_SCONTEXT_OFFSET],
+                          SELINUX_CONTEXT_LEN, ctx,
+                          ctx->scontext);
+        read_data_loc_str(&event->data[SELINUX_TCONTEXT_OFFSET],
+                          SELINUX_CONTEXT_LEN, ctx,
+                          ctx->tcontext);
+        read_data_loc_str(&event->data[SELINUX_TCLASS_OFFSET],
+                          SELINUX_TCLASS_LEN, ctx,
+                          ctx->tclass);
+
+        bpf_ringbuf_submit(event, 0);
+    }
+
+    return 0;
+}
+
 SEC("tracepoint/syscalls/sys_enter_prctl")
 int sys_enter_prctl(struct trace_event_raw_sys_enter * ctx)
 {
@@ -787,6 +933,81 @@ int sys_exit_clone(struct trace_event_raw_sys_exit * ctx)
     return 0;
 }
 
+// The context can only be accessed using constant offsets, which is why we
+// cannot index the arguments directly. The loads are volatile to prevent the
+// compiler from merging them into a single load from a computed offset.
+#define ctx_arg(ctx, i) (*(volatile u64 *)&(ctx)->args[i])
+
+static __always_inline u64 syscall_arg(struct trace_event_raw_sys_enter * ctx,
+                                       u32 index)
+{
+    u64 args[] = {ctx_arg(ctx, 0), ctx_arg(ctx, 1), ctx_arg(ctx, 2),
+                  ctx_arg(ctx, 3), ctx_arg(ctx, 4), ctx_arg(ctx, 5)};
+
+    switch (index) {
+    case 0:
+        return args[0];
+    case 1:
+        return args[1];
+    case 2:
+        return args[2];
+    case 3:
+        return args[3];
+    case 4:
+        return args[4];
+    case 5:
+        return args[5];
+    default:
+        return 0;
+    }
+}
+
+static __always_inline void
+record_syscall_args(struct trace_event_raw_sys_enter * ctx, u32 mntns,
+                    u32 syscall_id)
+{
+    syscall_args_filter_t * filter =
+        bpf_map_lookup_elem(&syscall_args_filter, &syscall_id);
+    if (!filter || filter->num_args == 0) {
+        return;
+    }
+
+    syscall_args_key_t key = {.mntns = mntns, .syscall_id = syscall_id};
+    for (int i = 0; i < MAX_SYSCALL_ARG_INDEXES; i++) {
+        if (i < filter->num_args) {
+            key.args[i] = syscall_arg(ctx, filter->index[i]);
+        }
+    }
+
+    // Already known argument combinations are rejected with -EEXIST.
+    long err = bpf_map_update_elem(&syscall_args, &key, &TRUE, BPF_NOEXIST);
+    if (err == 0 || err == -EEXIST) {
+        return;
+    }
+
+    // The map is full, mark the syscall arguments as incomplete.
+    // Bound the syscall ID again to make the ebpf verifier happy, it does not
+    // track it through the map helper calls above.
+    asm volatile("%[id] &= 0x3ff;\n" : [id] "+r"(syscall_id));
+    u8 * const overflow =
+        bpf_map_lookup_elem(&mntns_syscall_args_overflow, &mntns);
+    if (overflow) {
+        overflow[syscall_id] = 1;
+        return;
+    }
+
+    static const char init[MAX_SYSCALLS];
+    bpf_map_update_elem(&mntns_syscall_args_overflow, &mntns, &init, BPF_ANY);
+    u8 * const value = bpf_map_lookup_elem(&mntns_syscall_args_overflow, &mntns);
+    if (!value) {
+        bpf_printk("look up item in mntns_syscall_args_overflow map failed "
+                   "mntns: %u",
+                   mntns);
+        return;
+    }
+    value[syscall_id] = 1;
+}
+
 SEC("tracepoint/raw_syscalls/sys_enter")
 int sys_enter(struct trace_event_raw_sys_enter * args)
 {
@@ -845,6 +1066,8 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
         value[syscall_id] = 1;
     }
 
+    record_syscall_args(args, mntns, syscall_id);
+
     return 0;
 }
 
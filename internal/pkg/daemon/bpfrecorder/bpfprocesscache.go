@@ -65,7 +65,7 @@ type BpfProcessCache struct {
 
 func NewBpfProcessCache(logger logr.Logger) *BpfProcessCache {
 	bpfProcCache := &BpfProcessCache{
-		recorder: New("", logger, false, false),
+		recorder: New("", logger, false, false, false),
 		logger:   logger,
 		cache: ttlcache.New(
 			ttlcache.WithTTL[int, *BpfProcessInfo](processCacheTimeout),
@@ -67,6 +67,7 @@ const (
 	eventTypeAppArmorSocket int           = 3
 	eventTypeAppArmorCap    int           = 4
 	eventTypeClearMntns     int           = 5
+	eventTypeSelinuxAvc     int           = 7
 	excludeMntnsEnabled     byte          = 1
 )
 
@@ -91,6 +92,7 @@ type BpfRecorder struct {
 
 	AppArmor *AppArmorRecorder
 	Seccomp  *SeccompRecorder
+	Selinux  *SelinuxRecorder
 
 	recordedExits sync.Map
 }
@@ -106,7 +108,7 @@ type bpfEvent struct {
 }
 
 // New returns a new BpfRecorder instance.
-func New(programName string, logger logr.Logger, recordSeccomp, recordAppArmor bool) *BpfRecorder {
+func New(programName string, logger logr.Logger, recordSeccomp, recordAppArmor, recordSelinux bool) *BpfRecorder {
 	var seccomp *SeccompRecorder
 	if recordSeccomp {
 		seccomp = newSeccompRecorder(logger)
@@ -117,6 +119,11 @@ func New(programName string, logger logr.Logger, recordSeccomp, recordAppArmor b
 		appArmor = newAppArmorRecorder(logger, programName)
 	}
 
+	var selinux *SelinuxRecorder
+	if recordSelinux {
+		selinux = newSelinuxRecorder(logger)
+	}
+
 	return &BpfRecorder{
 		impl:   &defaultImpl{},
 		logger: logger,
@@ -131,6 +138,7 @@ func New(programName string, logger logr.Logger, recordSeccomp, recordAppArmor b
 		programName:             programName,
 		AppArmor:                appArmor,
 		Seccomp:                 seccomp,
+		Selinux:                 selinux,
 		recordedExits:           sync.Map{},
 	}
 }
@@ -344,6 +352,18 @@ func (b *BpfRecorder) SyscallsForProfile(
 
 	b.attachUnattachMutex.RLock()
 	syscalls, err := b.Seccomp.PopSyscalls(b, mntns)
+
+	var syscallArgs []*api.SyscallArgs
+
+	if err == nil {
+		syscallArgs, err = b.Seccomp.PopSyscallArgs(b, mntns)
+		if err != nil {
+			// The name only syscalls are still valid
+			b.logger.Error(err, "Failed to get syscall arguments for mntns", "mntns", mntns)
+
+			err = nil
+		}
+	}
 	b.attachUnattachMutex.RUnlock()
 
 	if err != nil {
@@ -359,8 +379,9 @@ func (b *BpfRecorder) SyscallsForProfile(
 	)
 
 	return &api.SyscallsResponse{
-		Syscalls: syscalls,
-		GoArch:   runtime.GOARCH,
+		Syscalls:    syscalls,
+		GoArch:      runtime.GOARCH,
+		SyscallArgs: syscallArgs,
 	}, nil
 }
 
@@ -386,6 +407,14 @@ func (b *BpfRecorder) ApparmorForProfile(
 	apparmor := b.AppArmor.GetAppArmorProcessed(mntns)
 	b.attachUnattachMutex.RUnlock()
 
+	networkRules := make([]*api.ApparmorResponse_Socket_NetworkRule, 0, len(apparmor.Socket.Rules))
+	for _, rule := range apparmor.Socket.Rules {
+		networkRules = append(networkRules, &api.ApparmorResponse_Socket_NetworkRule{
+			Family: rule.Family,
+			Type:   rule.Type,
+		})
+	}
+
 	return &api.ApparmorResponse{
 		Files: &api.ApparmorResponse_Files{
 			AllowedExecutables: apparmor.FileProcessed.AllowedExecutables,
@@ -399,10 +428,60 @@ func (b *BpfRecorder) ApparmorForProfile(
 			UseRaw: apparmor.Socket.UseRaw,
 			UseTcp: apparmor.Socket.UseTCP,
 			UseUdp: apparmor.Socket.UseUDP,
+			Rules:  networkRules,
 		},
 	}, nil
 }
 
+// SelinuxForProfile returns the SELinux AVCs for the provided profile name.
+func (b *BpfRecorder) SelinuxForProfile(
+	_ context.Context, r *api.ProfileRequest,
+) (*api.SelinuxResponse, error) {
+	if b.startRequests == 0 {
+		return nil, errors.New("bpf recorder not running")
+	}
+
+	if b.Selinux == nil {
+		return nil, errors.New("no selinux profiles recording running")
+	}
+
+	if b.Selinux.loadErr != nil {
+		return nil, fmt.Errorf("selinux bpf hooks not loaded: %w", b.Selinux.loadErr)
+	}
+
+	b.logger.Info("Getting selinux AVCs for profile " + r.GetName())
+
+	mntns, err := b.getMntnsForProfileWithRetry(r.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	b.attachUnattachMutex.RLock()
+	avcs := b.Selinux.GetSelinuxAvcs(mntns)
+	b.attachUnattachMutex.RUnlock()
+
+	b.logger.Info(
+		fmt.Sprintf("Found %d AVCs for profile", len(avcs)),
+		"profile", r.GetName(),
+		"mntns", mntns,
+	)
+
+	response := &api.SelinuxResponse{
+		Avc: make([]*api.SelinuxResponse_SelinuxAvc, 0, len(avcs)),
+	}
+
+	for _, avc := range avcs {
+		response.Avc = append(response.Avc, &api.SelinuxResponse_SelinuxAvc{
+			Perm:     avc.Perm,
+			Scontext: avc.Scontext,
+			Tcontext: avc.Tcontext,
+			Tclass:   avc.Tclass,
+		})
+	}
+
+	return response, nil
+}
+
 func (b *BpfRecorder) getMntnsForProfileWithRetry(profile string) (uint32, error) {
 	// There is a chance to miss the PID if concurrent processes are being
 	// analyzed. If we request the `SyscallsForProfile` exactly between two
@@ -548,6 +627,16 @@ func (b *BpfRecorder) Load() (err error) {
 		}
 	}
 
+	if b.Selinux != nil {
+		if err := b.Selinux.Load(b); err != nil {
+			// Same as for AppArmor, only log the error because SELinux is
+			// not available on all Linux distributions. Collecting a
+			// SELinux profile fails with this error later on.
+			b.logger.Error(err, "load SELinux bpf hooks")
+			b.Selinux.loadErr = err
+		}
+	}
+
 	if b.Seccomp != nil {
 		if err := b.Seccomp.Load(b); err != nil {
 			return err
@@ -626,6 +715,12 @@ func (b *BpfRecorder) StartRecording() (err error) {
 		}
 	}
 
+	if b.Selinux != nil {
+		if err := b.Selinux.StartRecording(b); err != nil {
+			b.logger.Error(err, "attach SELinux bpf hooks")
+		}
+	}
+
 	if b.Seccomp != nil {
 		if err := b.Seccomp.StartRecording(b); err != nil {
 			return err
@@ -661,6 +756,12 @@ func (b *BpfRecorder) StopRecording() error {
 		}
 	}
 
+	if b.Selinux != nil {
+		if err := b.Selinux.StopRecording(b); err != nil {
+			return err
+		}
+	}
+
 	b.logger.Info("Recording stopped.")
 
 	// XXX: It may be useful to clear out all existing maps here.
@@ -718,6 +819,10 @@ func (b *BpfRecorder) handleEvent(eventBytes []byte) {
 		if b.AppArmor != nil {
 			b.AppArmor.clearMntns(&event)
 		}
+	case uint8(eventTypeSelinuxAvc):
+		if b.Selinux != nil {
+			b.Selinux.handleAvcEvent(&event)
+		}
 	}
 }
 
@@ -898,6 +1003,7 @@ func (b *BpfRecorder) findProfileForContainerID(id string) (string, error) {
 					for _, annotation := range []string{
 						config.SeccompProfileRecordBpfAnnotationKey,
 						config.ApparmorProfileRecordBpfAnnotationKey,
+						config.SelinuxProfileRecordBpfAnnotationKey,
 					} {
 						key := annotation + containerName
 
@@ -41,6 +41,11 @@ const (
 	sockDgram    uint64 = 2
 	sockRaw      uint64 = 3
 	sockTypeMask uint64 = 0xF
+	// The address family of socket events is stored in the upper 32 bits of the flags.
+	sockFamilyShift        = 32
+	afUnspec        uint64 = 0
+	afInet          uint64 = 2
+	afInet6         uint64 = 10
 
 	// maxTrackedPaths limits the number of unique paths recorded per mount namespace
 	// to prevent memory exhaustion (OOM) attacks from malicious workloads.
@@ -110,6 +115,13 @@ type BpfAppArmorSocketTypes struct {
 	UseRaw bool
 	UseTCP bool
 	UseUDP bool
+	Rules  []BpfAppArmorNetworkRule
+}
+
+// BpfAppArmorNetworkRule is a socket of a non-inet address family.
+type BpfAppArmorNetworkRule struct {
+	Family string
+	Type   string
 }
 
 type BpfAppArmorProcessed struct {
@@ -245,6 +257,26 @@ func (b *AppArmorRecorder) handleSocketEvent(socketEvent *bpfEvent) {
 	}
 
 	socketType := socketEvent.Flags & sockTypeMask
+
+	// Sockets of other address families than inet are recorded as separate
+	// network rules. Events without family are recorded by older BPF
+	// programs and treated as inet sockets.
+	if family := socketEvent.Flags >> sockFamilyShift; family != afUnspec && family != afInet && family != afInet6 {
+		familyName, ok := socketFamilies[family]
+		if !ok {
+			b.logger.V(config.VerboseLevel).Info("Unknown socket family", "family", family, "mntns", mid)
+
+			return
+		}
+
+		rule := BpfAppArmorNetworkRule{Family: familyName, Type: socketTypes[socketType]}
+		if !slices.Contains(b.recordedSocketsUse[mid].Rules, rule) {
+			b.recordedSocketsUse[mid].Rules = append(b.recordedSocketsUse[mid].Rules, rule)
+		}
+
+		return
+	}
+
 	switch socketType {
 	case sockRaw:
 		b.recordedSocketsUse[mid].UseRaw = true
@@ -588,6 +620,64 @@ var capabilities = map[int]string{
 	40: "checkpoint_restore",
 }
 
+// socketFamilies maps the address families to their AppArmor names.
+var socketFamilies = map[uint64]string{
+	1:  "unix",
+	2:  "inet",
+	3:  "ax25",
+	4:  "ipx",
+	5:  "appletalk",
+	6:  "netrom",
+	7:  "bridge",
+	8:  "atmpvc",
+	9:  "x25",
+	10: "inet6",
+	11: "rose",
+	13: "netbeui",
+	14: "security",
+	15: "key",
+	16: "netlink",
+	17: "packet",
+	18: "ash",
+	19: "econet",
+	20: "atmsvc",
+	21: "rds",
+	22: "sna",
+	23: "irda",
+	24: "pppox",
+	25: "wanpipe",
+	26: "llc",
+	27: "ib",
+	28: "mpls",
+	29: "can",
+	30: "tipc",
+	31: "bluetooth",
+	32: "iucv",
+	33: "rxrpc",
+	34: "isdn",
+	35: "phonet",
+	36: "ieee802154",
+	37: "caif",
+	38: "alg",
+	39: "nfc",
+	40: "vsock",
+	41: "kcm",
+	42: "qipcrtr",
+	43: "smc",
+	44: "xdp",
+	45: "mctp",
+}
+
+// socketTypes maps the socket types to their AppArmor names.
+var socketTypes = map[uint64]string{
+	1:  "stream",
+	2:  "dgram",
+	3:  "raw",
+	4:  "rdm",
+	5:  "seqpacket",
+	10: "packet",
+}
+
 func capabilityToString(capID int) string {
 	val, ok := capabilities[capID]
 	if !ok {
@@ -21,6 +21,7 @@ package bpfrecorder
 import (
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 )
 
@@ -171,3 +172,30 @@ func TestShouldExcludeFile(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleSocketEvent(t *testing.T) {
+	t.Parallel()
+
+	const mntns = 1
+
+	sut := newAppArmorRecorder(logr.Discard(), "")
+	for _, flags := range []uint64{
+		sockStream,                                // legacy event without family
+		afInet6<<sockFamilyShift | sockDgram,      // inet6 datagram
+		1<<sockFamilyShift | sockStream,           // unix stream
+		1<<sockFamilyShift | sockStream | 0x80000, // unix stream with SOCK_CLOEXEC
+		16<<sockFamilyShift | sockRaw,             // netlink raw
+		99<<sockFamilyShift | sockRaw,             // unknown family
+	} {
+		sut.handleSocketEvent(&bpfEvent{Mntns: mntns, Flags: flags})
+	}
+
+	processed := sut.GetAppArmorProcessed(mntns)
+	require.True(t, processed.Socket.UseTCP)
+	require.True(t, processed.Socket.UseUDP)
+	require.False(t, processed.Socket.UseRaw)
+	require.Equal(t, []BpfAppArmorNetworkRule{
+		{Family: "unix", Type: "stream"},
+		{Family: "netlink", Type: "raw"},
+	}, processed.Socket.Rules)
+}
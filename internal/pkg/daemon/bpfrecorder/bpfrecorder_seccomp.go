@@ -19,7 +19,10 @@ limitations under the License.
 package bpfrecorder
 
 import (
+	"cmp"
+	"encoding/binary"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"unsafe"
@@ -27,11 +30,44 @@ import (
 	bpf "github.com/aquasecurity/libbpfgo"
 	"github.com/go-logr/logr"
 	seccomp "github.com/seccomp/libseccomp-golang"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+)
+
+const (
+	// Have to match the definitions in recorder.bpf.c.
+	maxSyscallArgIndexes = 2
+	syscallArgsKeySize   = 8 + 8*maxSyscallArgIndexes
 )
 
+// syscallArg selects a syscall argument to be recorded. A non-zero mask
+// results in a SCMP_CMP_MASKED_EQ instead of a SCMP_CMP_EQ comparison.
+type syscallArg struct {
+	index uint32
+	mask  uint64
+}
+
+// recordableSyscallArgs are the syscalls supporting argument level
+// recording.
+var recordableSyscallArgs = map[string][]syscallArg{
+	"clone":       {{index: 0}},
+	"fcntl":       {{index: 1}},
+	"ioctl":       {{index: 1}},
+	"personality": {{index: 0}},
+	"prctl":       {{index: 0}},
+	// Only the socket type is compared, SOCK_NONBLOCK and SOCK_CLOEXEC may
+	// be set independently.
+	"socket":  {{index: 0}, {index: 1, mask: sockTypeMask}},
+	"unshare": {{index: 0}},
+}
+
 type SeccompRecorder struct {
 	logger               logr.Logger
 	syscalls             *bpf.BPFMap
+	syscallArgsFilter    *bpf.BPFMap
+	syscallArgs          *bpf.BPFMap
+	syscallArgsOverflow  *bpf.BPFMap
 	syscallIDtoNameCache map[string]string
 }
 
@@ -52,6 +88,57 @@ func (s *SeccompRecorder) Load(b *BpfRecorder) error {
 
 	s.syscalls = syscalls
 
+	s.logger.Info("Getting syscall arguments maps")
+
+	syscallArgsFilter, err := b.GetMap(b.module, "syscall_args_filter")
+	if err != nil {
+		return fmt.Errorf("get syscall arguments filter map: %w", err)
+	}
+
+	s.syscallArgsFilter = syscallArgsFilter
+
+	syscallArgs, err := b.GetMap(b.module, "syscall_args")
+	if err != nil {
+		return fmt.Errorf("get syscall arguments map: %w", err)
+	}
+
+	s.syscallArgs = syscallArgs
+
+	syscallArgsOverflow, err := b.GetMap(b.module, "mntns_syscall_args_overflow")
+	if err != nil {
+		return fmt.Errorf("get syscall arguments overflow map: %w", err)
+	}
+
+	s.syscallArgsOverflow = syscallArgsOverflow
+
+	if err := s.loadSyscallArgsFilter(b); err != nil {
+		return fmt.Errorf("load syscall arguments filter: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SeccompRecorder) loadSyscallArgsFilter(b *BpfRecorder) error {
+	for name, args := range recordableSyscallArgs {
+		id, err := b.GetSyscallFromName(name)
+		if err != nil {
+			s.logger.Info("Skipping syscall arguments recording", "syscall", name, "err", err.Error())
+
+			continue
+		}
+
+		value := make([]byte, 4+4*maxSyscallArgIndexes)
+		binary.LittleEndian.PutUint32(value, uint32(len(args)))
+
+		for i, arg := range args {
+			binary.LittleEndian.PutUint32(value[4+4*i:], arg.index)
+		}
+
+		if err := b.UpdateValue(s.syscallArgsFilter, uint32(id), value); err != nil {
+			return fmt.Errorf("update filter for syscall %s: %w", name, err)
+		}
+	}
+
 	return nil
 }
 
@@ -69,6 +156,16 @@ func (s *SeccompRecorder) StopRecording(b *BpfRecorder) error {
 		}
 	}
 
+	for _, m := range []*bpf.BPFMap{s.syscallArgs, s.syscallArgsOverflow} {
+		it = b.BPFMapIterator(m)
+		for b.BPFMapIteratorNext(it) {
+			key := b.BPFMapIteratorKey(it)
+			if err := b.DeleteKeyBytes(m, key); err != nil {
+				return fmt.Errorf("failed to clean up syscall arguments map: %w", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -89,6 +186,136 @@ func (s *SeccompRecorder) PopSyscalls(b *BpfRecorder, mntns uint32) ([]string, e
 	return sortUnique(syscallNames), nil
 }
 
+// PopSyscallArgs returns the recorded argument combinations for the
+// syscalls supporting argument level recording. Syscalls for which not all
+// combinations could be recorded are skipped, because the resulting rules
+// would be too strict.
+func (s *SeccompRecorder) PopSyscallArgs(b *BpfRecorder, mntns uint32) ([]*api.SyscallArgs, error) {
+	overflow, err := b.GetValue(s.syscallArgsOverflow, mntns)
+	if err == nil {
+		if err := b.DeleteKey(s.syscallArgsOverflow, mntns); err != nil {
+			s.logger.Error(err, "Unable to cleanup syscall arguments overflow map", "mntns", mntns)
+		}
+	}
+
+	keys := [][]byte{}
+
+	it := b.BPFMapIterator(s.syscallArgs)
+	for b.BPFMapIteratorNext(it) {
+		key := b.BPFMapIteratorKey(it)
+		if len(key) != syscallArgsKeySize {
+			return nil, fmt.Errorf("invalid syscall arguments key size: %d", len(key))
+		}
+
+		if binary.LittleEndian.Uint32(key) == mntns {
+			keys = append(keys, key)
+		}
+	}
+
+	// Delete the keys after iterating, otherwise the iteration restarts.
+	for _, key := range keys {
+		if err := b.DeleteKeyBytes(s.syscallArgs, key); err != nil {
+			s.logger.Error(err, "Unable to cleanup syscall arguments map", "mntns", mntns)
+		}
+	}
+
+	combinations := map[string][]*api.SyscallArgs_Combination{}
+
+	for _, key := range keys {
+		id := int(binary.LittleEndian.Uint32(key[4:]))
+
+		if id < len(overflow) && overflow[id] == 1 {
+			continue
+		}
+
+		name, err := s.syscallNameForID(b, id)
+		if err != nil {
+			s.logger.Error(err, "unable to convert syscall ID", "id", id)
+
+			continue
+		}
+
+		args, ok := recordableSyscallArgs[name]
+		if !ok {
+			continue
+		}
+
+		combination := &api.SyscallArgs_Combination{}
+		for i, arg := range args {
+			combination.Args = append(combination.Args, syscallArgRule(arg, binary.LittleEndian.Uint64(key[8+8*i:])))
+		}
+
+		combinations[name] = append(combinations[name], combination)
+	}
+
+	for id, set := range overflow {
+		if set != 1 {
+			continue
+		}
+
+		name, err := s.syscallNameForID(b, id)
+		if err != nil {
+			s.logger.Error(err, "unable to convert syscall ID", "id", id)
+
+			continue
+		}
+
+		s.logger.Info(
+			"Too many syscall argument combinations, skipping argument rules",
+			"mntns", mntns, "syscall", name,
+		)
+	}
+
+	result := []*api.SyscallArgs{}
+
+	for name, combinations := range combinations {
+		// Masked comparisons can map different values onto the same rule
+		slices.SortFunc(combinations, compareCombinations)
+		combinations = slices.CompactFunc(combinations, func(a, b *api.SyscallArgs_Combination) bool {
+			return compareCombinations(a, b) == 0
+		})
+
+		result = append(result, &api.SyscallArgs{Name: name, Combinations: combinations})
+	}
+
+	slices.SortFunc(result, func(a, b *api.SyscallArgs) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	return result, nil
+}
+
+func syscallArgRule(arg syscallArg, value uint64) *api.SyscallArgs_Arg {
+	if arg.mask == 0 {
+		return &api.SyscallArgs_Arg{
+			Index: arg.index,
+			Value: value,
+			Op:    string(seccompprofileapi.OpEqualTo),
+		}
+	}
+
+	return &api.SyscallArgs_Arg{
+		Index:    arg.index,
+		Value:    arg.mask,
+		ValueTwo: value & arg.mask,
+		Op:       string(seccompprofileapi.OpMaskedEqual),
+	}
+}
+
+func compareCombinations(a, b *api.SyscallArgs_Combination) int {
+	for i := range min(len(a.GetArgs()), len(b.GetArgs())) {
+		if c := cmp.Compare(a.GetArgs()[i].GetValue(), b.GetArgs()[i].GetValue()); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(a.GetArgs()[i].GetValueTwo(), b.GetArgs()[i].GetValueTwo()); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(a.GetArgs()), len(b.GetArgs()))
+}
+
 func sortUnique(input []string) (result []string) {
 	tmp := map[string]bool{}
 	for _, val := range input {
@@ -0,0 +1,208 @@
+//go:build linux && !no_bpf
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	// Offsets of the NUL terminated strings in the data of AVC events.
+	selinuxContextLen     = 1024
+	selinuxTclassLen      = 256
+	selinuxScontextOffset = 0
+	selinuxTcontextOffset = selinuxContextLen
+	selinuxTclassOffset   = 2 * selinuxContextLen
+
+	// maxTrackedAvcs limits the number of unique AVCs recorded per mount
+	// namespace to prevent memory exhaustion.
+	maxTrackedAvcs = 10000
+
+	selinuxEnforcePath = "/sys/fs/selinux/enforce"
+)
+
+var selinuxHooks = []string{
+	"selinux_audited",
+}
+
+// BpfSelinuxAvc is a single permission denied by the SELinux AVC.
+type BpfSelinuxAvc struct {
+	Perm     string
+	Scontext string
+	Tcontext string
+	Tclass   string
+}
+
+type SelinuxRecorder struct {
+	logger  logr.Logger
+	loaded  bool
+	loadErr error
+
+	recordedAvcs     map[mntnsID]map[BpfSelinuxAvc]bool
+	lockRecordedAvcs sync.Mutex
+
+	maxAvcsWarned  map[mntnsID]bool
+	maxMntnsWarned bool
+}
+
+func newSelinuxRecorder(logger logr.Logger) *SelinuxRecorder {
+	return &SelinuxRecorder{
+		logger:           logger,
+		recordedAvcs:     map[mntnsID]map[BpfSelinuxAvc]bool{},
+		lockRecordedAvcs: sync.Mutex{},
+		maxAvcsWarned:    map[mntnsID]bool{},
+	}
+}
+
+func (s *SelinuxRecorder) Load(r *BpfRecorder) error {
+	if _, err := r.Stat(selinuxEnforcePath); err != nil {
+		return fmt.Errorf("SELinux is not enabled for this kernel: %w", err)
+	}
+
+	if err := r.loadPrograms(selinuxHooks); err != nil {
+		return fmt.Errorf("load selinux hooks: %w", err)
+	}
+
+	s.loaded = true
+
+	return nil
+}
+
+func (s *SelinuxRecorder) StartRecording(r *BpfRecorder) error {
+	if !s.loaded {
+		return ErrStartBeforeLoad
+	}
+
+	return nil
+}
+
+func (s *SelinuxRecorder) StopRecording(r *BpfRecorder) error {
+	s.lockRecordedAvcs.Lock()
+	defer s.lockRecordedAvcs.Unlock()
+
+	clear(s.recordedAvcs)
+	clear(s.maxAvcsWarned)
+	s.maxMntnsWarned = false
+
+	return nil
+}
+
+func (s *SelinuxRecorder) handleAvcEvent(avcEvent *bpfEvent) {
+	s.lockRecordedAvcs.Lock()
+	defer s.lockRecordedAvcs.Unlock()
+
+	scontext := avcDataToString(avcEvent.Data[selinuxScontextOffset : selinuxScontextOffset+selinuxContextLen])
+	tcontext := avcDataToString(avcEvent.Data[selinuxTcontextOffset : selinuxTcontextOffset+selinuxContextLen])
+	tclass := avcDataToString(avcEvent.Data[selinuxTclassOffset : selinuxTclassOffset+selinuxTclassLen])
+
+	perms, unknown := selinuxPermissions(tclass, uint32(avcEvent.Flags))
+	if unknown != 0 {
+		s.logger.Info("Unknown SELinux permissions",
+			"tclass", tclass, "permissions", fmt.Sprintf("0x%x", unknown), "mntns", avcEvent.Mntns)
+	}
+
+	s.logger.V(config.VerboseLevel).Info("SELinux AVC",
+		"perms", perms, "scontext", scontext, "tcontext", tcontext, "tclass", tclass,
+		"pid", avcEvent.Pid, "mntns", avcEvent.Mntns)
+
+	mid := mntnsID(avcEvent.Mntns)
+	if _, ok := s.recordedAvcs[mid]; !ok {
+		if len(s.recordedAvcs) >= maxTrackedMntns {
+			if !s.maxMntnsWarned {
+				s.logger.Info("Max tracked mount namespaces reached, new containers will not be recorded",
+					"limit", maxTrackedMntns)
+				s.maxMntnsWarned = true
+			}
+
+			return
+		}
+
+		s.recordedAvcs[mid] = map[BpfSelinuxAvc]bool{}
+	}
+
+	for _, perm := range perms {
+		avc := BpfSelinuxAvc{
+			Perm:     perm,
+			Scontext: scontext,
+			Tcontext: tcontext,
+			Tclass:   tclass,
+		}
+
+		if s.recordedAvcs[mid][avc] {
+			continue
+		}
+
+		// Enforce a limit on max tracked AVCs to avoid OOM.
+		if len(s.recordedAvcs[mid]) >= maxTrackedAvcs {
+			if !s.maxAvcsWarned[mid] {
+				s.logger.Info("Max tracked AVCs reached, profile will be truncated",
+					"mntns", mid, "limit", maxTrackedAvcs)
+				s.maxAvcsWarned[mid] = true
+			}
+
+			return
+		}
+
+		s.recordedAvcs[mid][avc] = true
+	}
+}
+
+// GetSelinuxAvcs returns the recorded AVCs of the mount namespace sorted by
+// class, target context and permission, and removes them from the recorder.
+func (s *SelinuxRecorder) GetSelinuxAvcs(mntns uint32) []BpfSelinuxAvc {
+	s.lockRecordedAvcs.Lock()
+	defer s.lockRecordedAvcs.Unlock()
+
+	mid := mntnsID(mntns)
+
+	avcs := make([]BpfSelinuxAvc, 0, len(s.recordedAvcs[mid]))
+	for avc := range s.recordedAvcs[mid] {
+		avcs = append(avcs, avc)
+	}
+
+	slices.SortFunc(avcs, func(a, b BpfSelinuxAvc) int {
+		return cmp.Or(
+			cmp.Compare(a.Tclass, b.Tclass),
+			cmp.Compare(a.Tcontext, b.Tcontext),
+			cmp.Compare(a.Perm, b.Perm),
+			cmp.Compare(a.Scontext, b.Scontext),
+		)
+	})
+
+	delete(s.recordedAvcs, mid)
+	delete(s.maxAvcsWarned, mid)
+
+	return avcs
+}
+
+func avcDataToString(data []uint8) string {
+	if eos := bytes.IndexByte(data, 0); eos >= 0 {
+		return string(data[:eos])
+	}
+
+	return string(data)
+}
@@ -0,0 +1,128 @@
+//go:build linux && !no_bpf
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelinuxPermissions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		class       string
+		av          uint32
+		wantPerms   []string
+		wantUnknown uint32
+	}{
+		{
+			name:      "file read open",
+			class:     "file",
+			av:        1<<1 | 1<<18,
+			wantPerms: []string{"read", "open"},
+		},
+		{
+			name:      "dir search",
+			class:     "dir",
+			av:        1 << 28,
+			wantPerms: []string{"search"},
+		},
+		{
+			name:      "tcp socket name_connect",
+			class:     "tcp_socket",
+			av:        1<<12 | 1<<22,
+			wantPerms: []string{"connect", "name_connect"},
+		},
+		{
+			name:      "common socket permissions",
+			class:     "vsock_socket",
+			av:        1 << 13,
+			wantPerms: []string{"listen"},
+		},
+		{
+			name:        "unknown bits",
+			class:       "fd",
+			av:          1<<0 | 1<<5,
+			wantPerms:   []string{"use"},
+			wantUnknown: 1 << 5,
+		},
+		{
+			name:        "unknown class",
+			class:       "dbus",
+			av:          1 << 0,
+			wantUnknown: 1 << 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			perms, unknown := selinuxPermissions(tc.class, tc.av)
+			require.Equal(t, tc.wantPerms, perms)
+			require.Equal(t, tc.wantUnknown, unknown)
+		})
+	}
+}
+
+func newAvcEvent(mntns uint32, scontext, tcontext, tclass string, av uint64) *bpfEvent {
+	event := &bpfEvent{
+		Mntns: mntns,
+		Type:  uint8(eventTypeSelinuxAvc),
+		Flags: av,
+	}
+
+	copy(event.Data[selinuxScontextOffset:], scontext)
+	copy(event.Data[selinuxTcontextOffset:], tcontext)
+	copy(event.Data[selinuxTclassOffset:], tclass)
+
+	return event
+}
+
+func TestHandleAvcEvent(t *testing.T) {
+	t.Parallel()
+
+	const (
+		scontext = "system_u:system_r:selinuxrecording.process:s0:c1,c2"
+		tcontext = "system_u:object_r:container_file_t:s0:c1,c2"
+	)
+
+	sut := newSelinuxRecorder(logr.Discard())
+
+	sut.handleAvcEvent(newAvcEvent(1, scontext, tcontext, "file", 1<<1|1<<18))
+	sut.handleAvcEvent(newAvcEvent(1, scontext, tcontext, "file", 1<<1))
+	sut.handleAvcEvent(newAvcEvent(1, scontext, scontext, "process", 1<<4))
+	sut.handleAvcEvent(newAvcEvent(2, scontext, tcontext, "dir", 1<<28))
+
+	require.Equal(t, []BpfSelinuxAvc{
+		{Perm: "open", Scontext: scontext, Tcontext: tcontext, Tclass: "file"},
+		{Perm: "read", Scontext: scontext, Tcontext: tcontext, Tclass: "file"},
+		{Perm: "sigstop", Scontext: scontext, Tcontext: scontext, Tclass: "process"},
+	}, sut.GetSelinuxAvcs(1))
+
+	// The AVCs are removed after retrieving them.
+	require.Empty(t, sut.GetSelinuxAvcs(1))
+
+	require.NoError(t, sut.StopRecording(nil))
+	require.Empty(t, sut.GetSelinuxAvcs(2))
+}
@@ -259,7 +259,7 @@ func TestRun(t *testing.T) {
 		mock := &bpfrecorderfakes.FakeImpl{}
 		tc.prepare(mock)
 
-		sut := New("test", logr.Discard(), true, false)
+		sut := New("test", logr.Discard(), true, false, false)
 		sut.impl = mock
 
 		err := sut.Run()
@@ -298,11 +298,35 @@ func TestLoad(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{ // Syscall arguments map missing
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapCalls(func(_ *libbpfgo.Module, name string) (*libbpfgo.BPFMap, error) {
+					if name == "syscall_args" {
+						return nil, errTest
+					}
+
+					return &libbpfgo.BPFMap{}, nil
+				})
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{ // Error loading syscall arguments filter
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.UpdateValueReturns(errTest)
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
 	} {
 		mock := &bpfrecorderfakes.FakeImpl{}
 		tc.prepare(mock)
 
-		sut := New("", logr.Discard(), true, true)
+		sut := New("", logr.Discard(), true, true, false)
 		sut.impl = mock
 
 		err := sut.Load()
@@ -338,7 +362,21 @@ func TestStart(t *testing.T) {
 		},
 		{ // Error attaching
 			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
-				mock.UpdateValueReturns(errTest)
+				isRecording := &libbpfgo.BPFMap{}
+				mock.GetMapCalls(func(_ *libbpfgo.Module, name string) (*libbpfgo.BPFMap, error) {
+					if name == "is_recording" {
+						return isRecording, nil
+					}
+
+					return &libbpfgo.BPFMap{}, nil
+				})
+				mock.UpdateValueCalls(func(m *libbpfgo.BPFMap, _ uint32, _ []byte) error {
+					if m == isRecording {
+						return errTest
+					}
+
+					return nil
+				})
 			},
 			assert: func(sut *BpfRecorder, err error) {
 				require.Error(t, err)
@@ -348,7 +386,7 @@ func TestStart(t *testing.T) {
 		mock := &bpfrecorderfakes.FakeImpl{}
 		tc.prepare(mock)
 
-		sut := New("", logr.Discard(), true, true)
+		sut := New("", logr.Discard(), true, true, false)
 		sut.impl = mock
 
 		mock.GoArchReturns(validGoArch)
@@ -366,7 +404,7 @@ func TestStartNotLoaded(t *testing.T) {
 	t.Parallel()
 
 	mock := &bpfrecorderfakes.FakeImpl{}
-	sut := New("", logr.Discard(), true, true)
+	sut := New("", logr.Discard(), true, true, false)
 	sut.impl = mock
 	err := sut.StartRecording()
 	require.Equal(t, err, ErrStartBeforeLoad)
@@ -419,7 +457,7 @@ func TestStop(t *testing.T) {
 			},
 		},
 	} {
-		sut := New("", logr.Discard(), true, false)
+		sut := New("", logr.Discard(), true, false, false)
 
 		mock := &bpfrecorderfakes.FakeImpl{}
 		sut.impl = mock
@@ -560,8 +598,106 @@ func TestSyscallsForProfile(t *testing.T) {
 				require.Equal(t, "syscall_c", resp.GetSyscalls()[2])
 			},
 		},
+		{ // Success with syscall arguments
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.NewModuleFromBufferArgsReturns(&libbpfgo.Module{}, nil)
+				mock.GetMapReturns(&libbpfgo.BPFMap{}, nil)
+
+				err := sut.Load()
+				require.NoError(t, err)
+				_, err = sut.Start(t.Context(), &api.EmptyRequest{})
+				require.NoError(t, err)
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				mock.GetValueReturnsOnCall(0, []byte{0, 1}, nil)
+				mock.GetValueReturnsOnCall(1, nil, errTest)
+				mockSyscallArgs(mock,
+					syscallArgsKey(mntns, 1, 10, 2),
+					syscallArgsKey(mntns, 1, 2, 1|0x80000),
+					syscallArgsKey(mntns, 1, 2, 1),
+					syscallArgsKey(mntns+1, 1, 3, 1),
+				)
+				mock.GetNameReturns("socket", nil)
+			},
+			assert: func(sut *BpfRecorder, resp *api.SyscallsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"socket"}, resp.GetSyscalls())
+				require.Len(t, resp.GetSyscallArgs(), 1)
+				require.Equal(t, "socket", resp.GetSyscallArgs()[0].GetName())
+
+				combinations := resp.GetSyscallArgs()[0].GetCombinations()
+				require.Len(t, combinations, 2)
+
+				args := combinations[0].GetArgs()
+				require.Len(t, args, 2)
+				require.EqualValues(t, 0, args[0].GetIndex())
+				require.EqualValues(t, 2, args[0].GetValue())
+				require.Equal(t, "SCMP_CMP_EQ", args[0].GetOp())
+				require.EqualValues(t, 1, args[1].GetIndex())
+				require.EqualValues(t, 0xf, args[1].GetValue())
+				require.EqualValues(t, 1, args[1].GetValueTwo())
+				require.Equal(t, "SCMP_CMP_MASKED_EQ", args[1].GetOp())
+
+				args = combinations[1].GetArgs()
+				require.EqualValues(t, 10, args[0].GetValue())
+				require.EqualValues(t, 2, args[1].GetValueTwo())
+			},
+		},
+		{ // Success with overflowing syscall arguments
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.NewModuleFromBufferArgsReturns(&libbpfgo.Module{}, nil)
+				mock.GetMapReturns(&libbpfgo.BPFMap{}, nil)
+
+				err := sut.Load()
+				require.NoError(t, err)
+				_, err = sut.Start(t.Context(), &api.EmptyRequest{})
+				require.NoError(t, err)
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				mock.GetValueReturnsOnCall(0, []byte{0, 1, 1}, nil)
+				mock.GetValueReturnsOnCall(1, []byte{0, 1, 0}, nil)
+				mockSyscallArgs(mock,
+					syscallArgsKey(mntns, 1, 2, 1),
+					syscallArgsKey(mntns, 2, 15),
+				)
+				mock.GetNameReturnsOnCall(0, "socket", nil)
+				mock.GetNameReturnsOnCall(1, "prctl", nil)
+			},
+			assert: func(sut *BpfRecorder, resp *api.SyscallsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"prctl", "socket"}, resp.GetSyscalls())
+				require.Len(t, resp.GetSyscallArgs(), 1)
+				require.Equal(t, "prctl", resp.GetSyscallArgs()[0].GetName())
+				require.Len(t, resp.GetSyscallArgs()[0].GetCombinations(), 1)
+			},
+		},
+		{ // Success with invalid syscall arguments key
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.NewModuleFromBufferArgsReturns(&libbpfgo.Module{}, nil)
+				mock.GetMapReturns(&libbpfgo.BPFMap{}, nil)
+
+				err := sut.Load()
+				require.NoError(t, err)
+				_, err = sut.Start(t.Context(), &api.EmptyRequest{})
+				require.NoError(t, err)
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				mock.GetValueReturnsOnCall(0, []byte{0, 1}, nil)
+				mock.GetValueReturnsOnCall(1, nil, errTest)
+				mockSyscallArgs(mock, []byte{1})
+				mock.GetNameReturns("socket", nil)
+			},
+			assert: func(sut *BpfRecorder, resp *api.SyscallsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"socket"}, resp.GetSyscalls())
+				require.Empty(t, resp.GetSyscallArgs())
+			},
+		},
 	} {
-		sut := New("", logr.Discard(), true, false)
+		sut := New("", logr.Discard(), true, false, false)
 
 		mock := &bpfrecorderfakes.FakeImpl{}
 		sut.impl = mock
@@ -575,6 +711,30 @@ func TestSyscallsForProfile(t *testing.T) {
 	}
 }
 
+// syscallArgsKey creates a syscall_args map key from the mntns, syscall ID
+// and the recorded argument values.
+func syscallArgsKey(mntns, id uint32, args ...uint64) []byte {
+	key := make([]byte, syscallArgsKeySize)
+	binary.LittleEndian.PutUint32(key, mntns)
+	binary.LittleEndian.PutUint32(key[4:], id)
+
+	for i, arg := range args {
+		binary.LittleEndian.PutUint64(key[8+8*i:], arg)
+	}
+
+	return key
+}
+
+// mockSyscallArgs lets the map iterator return the provided keys.
+func mockSyscallArgs(mock *bpfrecorderfakes.FakeImpl, keys ...[]byte) {
+	mock.BPFMapIteratorNextCalls(func(*libbpfgo.BPFMapIterator) bool {
+		return mock.BPFMapIteratorKeyCallCount() < len(keys)
+	})
+	mock.BPFMapIteratorKeyCalls(func(*libbpfgo.BPFMapIterator) []byte {
+		return keys[mock.BPFMapIteratorKeyCallCount()-1]
+	})
+}
+
 func TestApparmorForProfile(t *testing.T) {
 	t.Parallel()
 
@@ -697,7 +857,7 @@ func TestApparmorForProfile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			sut := New("", logr.Discard(), true, true)
+			sut := New("", logr.Discard(), true, true, false)
 
 			mock := &bpfrecorderfakes.FakeImpl{}
 			sut.impl = mock
@@ -712,6 +872,99 @@ func TestApparmorForProfile(t *testing.T) {
 	}
 }
 
+func TestSelinuxForProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*BpfRecorder, *bpfrecorderfakes.FakeImpl)
+		assert  func(*api.SelinuxResponse, error)
+	}{
+		{
+			name: "success",
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.NewModuleFromBufferArgsReturns(&libbpfgo.Module{}, nil)
+
+				err := sut.Load()
+				require.NoError(t, err)
+				_, err = sut.Start(t.Context(), &api.EmptyRequest{})
+				require.NoError(t, err)
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				sut.Selinux.recordedAvcs = map[mntnsID]map[BpfSelinuxAvc]bool{
+					mntnsID(mntns): {
+						{Perm: "read", Tclass: "file"}:  true,
+						{Perm: "write", Tclass: "file"}: true,
+					},
+				}
+			},
+			assert: func(resp *api.SelinuxResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, resp.GetAvc(), 2)
+				require.Equal(t, "read", resp.GetAvc()[0].GetPerm())
+				require.Equal(t, "file", resp.GetAvc()[0].GetTclass())
+			},
+		},
+		{
+			name:    "recorder not running",
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {},
+			assert: func(resp *api.SelinuxResponse, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "selinux hooks not loaded",
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.NewModuleFromBufferArgsReturns(&libbpfgo.Module{}, nil)
+				mock.GetProgramCalls(func(_ *libbpfgo.Module, name string) (*libbpfgo.BPFProg, error) {
+					if name == "selinux_audited" {
+						return nil, errTest
+					}
+
+					return &libbpfgo.BPFProg{}, nil
+				})
+
+				err := sut.Load()
+				require.NoError(t, err)
+				_, err = sut.Start(t.Context(), &api.EmptyRequest{})
+				require.NoError(t, err)
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+			},
+			assert: func(resp *api.SelinuxResponse, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "selinux recorder disabled",
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				sut.Selinux = nil
+			},
+			assert: func(resp *api.SelinuxResponse, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sut := New("", logr.Discard(), true, false, true)
+
+			mock := &bpfrecorderfakes.FakeImpl{}
+			sut.impl = mock
+
+			tc.prepare(sut, mock)
+
+			resp, err := sut.SelinuxForProfile(
+				t.Context(), &api.ProfileRequest{Name: profile},
+			)
+			tc.assert(resp, err)
+		})
+	}
+}
+
 type Logger struct {
 	messages []string
 	mutex    sync.RWMutex
@@ -737,7 +990,7 @@ func (l *Logger) Error(_ error, msg string, _ ...any) {
 func TestProcessEvents(t *testing.T) {
 	t.Parallel()
 
-	sut := New("", logr.Discard(), true, true)
+	sut := New("", logr.Discard(), true, true, false)
 	mock := &bpfrecorderfakes.FakeImpl{}
 	sut.impl = mock
 
@@ -770,7 +1023,7 @@ func TestHandleEvent(t *testing.T) {
 	logSink := &Logger{}
 	logger := logr.New(logSink)
 
-	sut := New("", logger, true, true)
+	sut := New("", logger, true, true, false)
 	mock := &bpfrecorderfakes.FakeImpl{}
 	sut.impl = mock
 
@@ -891,7 +1144,7 @@ func TestNewPidEvent(t *testing.T) {
 	} {
 		logSink := &Logger{}
 		logger := logr.New(logSink)
-		sut := New("", logger, false, false)
+		sut := New("", logger, false, false, false)
 		mock := &bpfrecorderfakes.FakeImpl{}
 		sut.impl = mock
 		// pretend that we're running in a kubernetes context
@@ -74,6 +74,17 @@ type FakeImpl struct {
 	bPFMapIteratorReturnsOnCall map[int]struct {
 		result1 *libbpfgo.BPFMapIterator
 	}
+	BPFMapIteratorKeyStub        func(*libbpfgo.BPFMapIterator) []byte
+	bPFMapIteratorKeyMutex       sync.RWMutex
+	bPFMapIteratorKeyArgsForCall []struct {
+		arg1 *libbpfgo.BPFMapIterator
+	}
+	bPFMapIteratorKeyReturns struct {
+		result1 []byte
+	}
+	bPFMapIteratorKeyReturnsOnCall map[int]struct {
+		result1 []byte
+	}
 	BPFMapIteratorNextStub        func(*libbpfgo.BPFMapIterator) bool
 	bPFMapIteratorNextMutex       sync.RWMutex
 	bPFMapIteratorNextArgsForCall []struct {
@@ -165,6 +176,18 @@ type FakeImpl struct {
 	deleteKey64ReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteKeyBytesStub        func(*libbpfgo.BPFMap, []byte) error
+	deleteKeyBytesMutex       sync.RWMutex
+	deleteKeyBytesArgsForCall []struct {
+		arg1 *libbpfgo.BPFMap
+		arg2 []byte
+	}
+	deleteKeyBytesReturns struct {
+		result1 error
+	}
+	deleteKeyBytesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DestroyLinkStub        func(*libbpfgo.BPFLink) error
 	destroyLinkMutex       sync.RWMutex
 	destroyLinkArgsForCall []struct {
@@ -229,6 +252,19 @@ type FakeImpl struct {
 		result1 *libbpfgo.BPFProg
 		result2 error
 	}
+	GetSyscallFromNameStub        func(string) (seccomp.ScmpSyscall, error)
+	getSyscallFromNameMutex       sync.RWMutex
+	getSyscallFromNameArgsForCall []struct {
+		arg1 string
+	}
+	getSyscallFromNameReturns struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
+	getSyscallFromNameReturnsOnCall map[int]struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
 	GetValueStub        func(*libbpfgo.BPFMap, uint32) ([]byte, error)
 	getValueMutex       sync.RWMutex
 	getValueArgsForCall []struct {
@@ -735,6 +771,67 @@ func (fake *FakeImpl) BPFMapIteratorReturnsOnCall(i int, result1 *libbpfgo.BPFMa
 	}{result1}
 }
 
+func (fake *FakeImpl) BPFMapIteratorKey(arg1 *libbpfgo.BPFMapIterator) []byte {
+	fake.bPFMapIteratorKeyMutex.Lock()
+	ret, specificReturn := fake.bPFMapIteratorKeyReturnsOnCall[len(fake.bPFMapIteratorKeyArgsForCall)]
+	fake.bPFMapIteratorKeyArgsForCall = append(fake.bPFMapIteratorKeyArgsForCall, struct {
+		arg1 *libbpfgo.BPFMapIterator
+	}{arg1})
+	stub := fake.BPFMapIteratorKeyStub
+	fakeReturns := fake.bPFMapIteratorKeyReturns
+	fake.recordInvocation("BPFMapIteratorKey", []interface{}{arg1})
+	fake.bPFMapIteratorKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) BPFMapIteratorKeyCallCount() int {
+	fake.bPFMapIteratorKeyMutex.RLock()
+	defer fake.bPFMapIteratorKeyMutex.RUnlock()
+	return len(fake.bPFMapIteratorKeyArgsForCall)
+}
+
+func (fake *FakeImpl) BPFMapIteratorKeyCalls(stub func(*libbpfgo.BPFMapIterator) []byte) {
+	fake.bPFMapIteratorKeyMutex.Lock()
+	defer fake.bPFMapIteratorKeyMutex.Unlock()
+	fake.BPFMapIteratorKeyStub = stub
+}
+
+func (fake *FakeImpl) BPFMapIteratorKeyArgsForCall(i int) *libbpfgo.BPFMapIterator {
+	fake.bPFMapIteratorKeyMutex.RLock()
+	defer fake.bPFMapIteratorKeyMutex.RUnlock()
+	argsForCall := fake.bPFMapIteratorKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) BPFMapIteratorKeyReturns(result1 []byte) {
+	fake.bPFMapIteratorKeyMutex.Lock()
+	defer fake.bPFMapIteratorKeyMutex.Unlock()
+	fake.BPFMapIteratorKeyStub = nil
+	fake.bPFMapIteratorKeyReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeImpl) BPFMapIteratorKeyReturnsOnCall(i int, result1 []byte) {
+	fake.bPFMapIteratorKeyMutex.Lock()
+	defer fake.bPFMapIteratorKeyMutex.Unlock()
+	fake.BPFMapIteratorKeyStub = nil
+	if fake.bPFMapIteratorKeyReturnsOnCall == nil {
+		fake.bPFMapIteratorKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.bPFMapIteratorKeyReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
 func (fake *FakeImpl) BPFMapIteratorNext(arg1 *libbpfgo.BPFMapIterator) bool {
 	fake.bPFMapIteratorNextMutex.Lock()
 	ret, specificReturn := fake.bPFMapIteratorNextReturnsOnCall[len(fake.bPFMapIteratorNextArgsForCall)]
@@ -1205,6 +1302,73 @@ func (fake *FakeImpl) DeleteKey64ReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) DeleteKeyBytes(arg1 *libbpfgo.BPFMap, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.deleteKeyBytesMutex.Lock()
+	ret, specificReturn := fake.deleteKeyBytesReturnsOnCall[len(fake.deleteKeyBytesArgsForCall)]
+	fake.deleteKeyBytesArgsForCall = append(fake.deleteKeyBytesArgsForCall, struct {
+		arg1 *libbpfgo.BPFMap
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.DeleteKeyBytesStub
+	fakeReturns := fake.deleteKeyBytesReturns
+	fake.recordInvocation("DeleteKeyBytes", []interface{}{arg1, arg2Copy})
+	fake.deleteKeyBytesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) DeleteKeyBytesCallCount() int {
+	fake.deleteKeyBytesMutex.RLock()
+	defer fake.deleteKeyBytesMutex.RUnlock()
+	return len(fake.deleteKeyBytesArgsForCall)
+}
+
+func (fake *FakeImpl) DeleteKeyBytesCalls(stub func(*libbpfgo.BPFMap, []byte) error) {
+	fake.deleteKeyBytesMutex.Lock()
+	defer fake.deleteKeyBytesMutex.Unlock()
+	fake.DeleteKeyBytesStub = stub
+}
+
+func (fake *FakeImpl) DeleteKeyBytesArgsForCall(i int) (*libbpfgo.BPFMap, []byte) {
+	fake.deleteKeyBytesMutex.RLock()
+	defer fake.deleteKeyBytesMutex.RUnlock()
+	argsForCall := fake.deleteKeyBytesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) DeleteKeyBytesReturns(result1 error) {
+	fake.deleteKeyBytesMutex.Lock()
+	defer fake.deleteKeyBytesMutex.Unlock()
+	fake.DeleteKeyBytesStub = nil
+	fake.deleteKeyBytesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DeleteKeyBytesReturnsOnCall(i int, result1 error) {
+	fake.deleteKeyBytesMutex.Lock()
+	defer fake.deleteKeyBytesMutex.Unlock()
+	fake.DeleteKeyBytesStub = nil
+	if fake.deleteKeyBytesReturnsOnCall == nil {
+		fake.deleteKeyBytesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteKeyBytesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) DestroyLink(arg1 *libbpfgo.BPFLink) error {
 	fake.destroyLinkMutex.Lock()
 	ret, specificReturn := fake.destroyLinkReturnsOnCall[len(fake.destroyLinkArgsForCall)]
@@ -1516,6 +1680,70 @@ func (fake *FakeImpl) GetProgramReturnsOnCall(i int, result1 *libbpfgo.BPFProg,
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) GetSyscallFromName(arg1 string) (seccomp.ScmpSyscall, error) {
+	fake.getSyscallFromNameMutex.Lock()
+	ret, specificReturn := fake.getSyscallFromNameReturnsOnCall[len(fake.getSyscallFromNameArgsForCall)]
+	fake.getSyscallFromNameArgsForCall = append(fake.getSyscallFromNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetSyscallFromNameStub
+	fakeReturns := fake.getSyscallFromNameReturns
+	fake.recordInvocation("GetSyscallFromName", []interface{}{arg1})
+	fake.getSyscallFromNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSyscallFromNameCallCount() int {
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	return len(fake.getSyscallFromNameArgsForCall)
+}
+
+func (fake *FakeImpl) GetSyscallFromNameCalls(stub func(string) (seccomp.ScmpSyscall, error)) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = stub
+}
+
+func (fake *FakeImpl) GetSyscallFromNameArgsForCall(i int) string {
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	argsForCall := fake.getSyscallFromNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSyscallFromNameReturns(result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = nil
+	fake.getSyscallFromNameReturns = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSyscallFromNameReturnsOnCall(i int, result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = nil
+	if fake.getSyscallFromNameReturnsOnCall == nil {
+		fake.getSyscallFromNameReturnsOnCall = make(map[int]struct {
+			result1 seccomp.ScmpSyscall
+			result2 error
+		})
+	}
+	fake.getSyscallFromNameReturnsOnCall[i] = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetValue(arg1 *libbpfgo.BPFMap, arg2 uint32) ([]byte, error) {
 	fake.getValueMutex.Lock()
 	ret, specificReturn := fake.getValueReturnsOnCall[len(fake.getValueArgsForCall)]
@@ -3070,6 +3298,94 @@ func (fake *FakeImpl) WriteReturnsOnCall(i int, result1 int, result2 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.attachGenericMutex.RLock()
+	defer fake.attachGenericMutex.RUnlock()
+	fake.bPFLoadObjectMutex.RLock()
+	defer fake.bPFLoadObjectMutex.RUnlock()
+	fake.bPFMapIteratorMutex.RLock()
+	defer fake.bPFMapIteratorMutex.RUnlock()
+	fake.bPFMapIteratorKeyMutex.RLock()
+	defer fake.bPFMapIteratorKeyMutex.RUnlock()
+	fake.bPFMapIteratorNextMutex.RLock()
+	defer fake.bPFMapIteratorNextMutex.RUnlock()
+	fake.bpfIncClientMutex.RLock()
+	defer fake.bpfIncClientMutex.RUnlock()
+	fake.chownMutex.RLock()
+	defer fake.chownMutex.RUnlock()
+	fake.closeGRPCMutex.RLock()
+	defer fake.closeGRPCMutex.RUnlock()
+	fake.closeModuleMutex.RLock()
+	defer fake.closeModuleMutex.RUnlock()
+	fake.containerIDForPIDMutex.RLock()
+	defer fake.containerIDForPIDMutex.RUnlock()
+	fake.deleteKeyMutex.RLock()
+	defer fake.deleteKeyMutex.RUnlock()
+	fake.deleteKey64Mutex.RLock()
+	defer fake.deleteKey64Mutex.RUnlock()
+	fake.deleteKeyBytesMutex.RLock()
+	defer fake.deleteKeyBytesMutex.RUnlock()
+	fake.destroyLinkMutex.RLock()
+	defer fake.destroyLinkMutex.RUnlock()
+	fake.dialMetricsMutex.RLock()
+	defer fake.dialMetricsMutex.RUnlock()
+	fake.getMapMutex.RLock()
+	defer fake.getMapMutex.RUnlock()
+	fake.getNameMutex.RLock()
+	defer fake.getNameMutex.RUnlock()
+	fake.getProgramMutex.RLock()
+	defer fake.getProgramMutex.RUnlock()
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	fake.getValueMutex.RLock()
+	defer fake.getValueMutex.RUnlock()
+	fake.getValue64Mutex.RLock()
+	defer fake.getValue64Mutex.RUnlock()
+	fake.getenvMutex.RLock()
+	defer fake.getenvMutex.RUnlock()
+	fake.goArchMutex.RLock()
+	defer fake.goArchMutex.RUnlock()
+	fake.inClusterConfigMutex.RLock()
+	defer fake.inClusterConfigMutex.RUnlock()
+	fake.initGlobalVariableMutex.RLock()
+	defer fake.initGlobalVariableMutex.RUnlock()
+	fake.initRingBufMutex.RLock()
+	defer fake.initRingBufMutex.RUnlock()
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	fake.newForConfigMutex.RLock()
+	defer fake.newForConfigMutex.RUnlock()
+	fake.newModuleFromBufferArgsMutex.RLock()
+	defer fake.newModuleFromBufferArgsMutex.RUnlock()
+	fake.parseUintMutex.RLock()
+	defer fake.parseUintMutex.RUnlock()
+	fake.pollRingBufferMutex.RLock()
+	defer fake.pollRingBufferMutex.RUnlock()
+	fake.readOSReleaseMutex.RLock()
+	defer fake.readOSReleaseMutex.RUnlock()
+	fake.readlinkMutex.RLock()
+	defer fake.readlinkMutex.RUnlock()
+	fake.removeAllMutex.RLock()
+	defer fake.removeAllMutex.RUnlock()
+	fake.sendMetricMutex.RLock()
+	defer fake.sendMetricMutex.RUnlock()
+	fake.serveMutex.RLock()
+	defer fake.serveMutex.RUnlock()
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	fake.tempFileMutex.RLock()
+	defer fake.tempFileMutex.RUnlock()
+	fake.unameMutex.RLock()
+	defer fake.unameMutex.RUnlock()
+	fake.unmarshalMutex.RLock()
+	defer fake.unmarshalMutex.RUnlock()
+	fake.updateValueMutex.RLock()
+	defer fake.updateValueMutex.RUnlock()
+	fake.updateValue64Mutex.RLock()
+	defer fake.updateValue64Mutex.RUnlock()
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -58,6 +58,7 @@ type impl interface {
 	NewModuleFromBufferArgs(*bpf.NewModuleArgs) (*bpf.Module, error)
 	BPFMapIterator(*bpf.BPFMap) *bpf.BPFMapIterator
 	BPFMapIteratorNext(*bpf.BPFMapIterator) bool
+	BPFMapIteratorKey(*bpf.BPFMapIterator) []byte
 	BPFLoadObject(*bpf.Module) error
 	GetProgram(*bpf.Module, string) (*bpf.BPFProg, error)
 	AttachGeneric(*bpf.BPFProg) (*bpf.BPFLink, error)
@@ -77,8 +78,10 @@ type impl interface {
 	UpdateValue64(*bpf.BPFMap, uint64, []byte) error
 	DeleteKey(*bpf.BPFMap, uint32) error
 	DeleteKey64(*bpf.BPFMap, uint64) error
+	DeleteKeyBytes(*bpf.BPFMap, []byte) error
 	ListPods(context.Context, *kubernetes.Clientset, string) (*v1.PodList, error)
 	GetName(seccomp.ScmpSyscall) (string, error)
+	GetSyscallFromName(string) (seccomp.ScmpSyscall, error)
 	RemoveAll(string) error
 	Chown(string, int, int) error
 	CloseModule(*bpf.Module)
@@ -127,6 +130,10 @@ func (*defaultImpl) BPFMapIteratorNext(it *bpf.BPFMapIterator) bool {
 	return it.Next()
 }
 
+func (*defaultImpl) BPFMapIteratorKey(it *bpf.BPFMapIterator) []byte {
+	return it.Key()
+}
+
 func (d *defaultImpl) BPFLoadObject(module *bpf.Module) error {
 	return module.BPFLoadObject()
 }
@@ -227,6 +234,18 @@ func (d *defaultImpl) DeleteKey64(m *bpf.BPFMap, key uint64) error {
 	return m.DeleteKey(unsafe.Pointer(&key))
 }
 
+func (d *defaultImpl) DeleteKeyBytes(m *bpf.BPFMap, key []byte) error {
+	if m == nil {
+		return errors.New("provided bpf map is nil")
+	}
+
+	if len(key) == 0 {
+		return errors.New("provided bpf map key is empty")
+	}
+
+	return m.DeleteKey(unsafe.Pointer(&key[0]))
+}
+
 func (d *defaultImpl) ListPods(
 	ctx context.Context, c *kubernetes.Clientset, nodeName string,
 ) (*v1.PodList, error) {
@@ -239,6 +258,10 @@ func (d *defaultImpl) GetName(s seccomp.ScmpSyscall) (string, error) {
 	return s.GetName()
 }
 
+func (d *defaultImpl) GetSyscallFromName(name string) (seccomp.ScmpSyscall, error) {
+	return seccomp.GetSyscallFromName(name)
+}
+
 func (d *defaultImpl) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
@@ -0,0 +1,175 @@
+//go:build linux && !no_bpf
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"slices"
+	"strings"
+)
+
+// The permission vectors reported by the kernel AVC use the kernel internal
+// class mapping, which is independent of the order of the permissions in the
+// loaded policy. The lists below follow the kernel class mapping from
+// security/selinux/include/classmap.h, where the index of a permission is the
+// bit set in the access vector.
+var (
+	commonFileSockPerms = []string{
+		"ioctl", "read", "write", "create", "getattr", "setattr", "lock",
+		"relabelfrom", "relabelto", "append", "map",
+	}
+
+	commonFilePerms = slices.Concat(commonFileSockPerms, []string{
+		"unlink", "link", "rename", "execute", "quotaon", "mounton",
+		"audit_access", "open", "execmod", "watch", "watch_mount", "watch_sb",
+		"watch_with_perm", "watch_reads",
+	})
+
+	commonSockPerms = slices.Concat(commonFileSockPerms, []string{
+		"bind", "connect", "listen", "accept", "getopt", "setopt", "shutdown",
+		"recvfrom", "sendto", "name_bind",
+	})
+
+	commonIPCPerms = []string{
+		"create", "destroy", "getattr", "setattr", "read", "write",
+		"associate", "unix_read", "unix_write",
+	}
+
+	commonCapPerms = []string{
+		"chown", "dac_override", "dac_read_search", "fowner", "fsetid",
+		"kill", "setgid", "setuid", "setpcap", "linux_immutable",
+		"net_bind_service", "net_broadcast", "net_admin", "net_raw",
+		"ipc_lock", "ipc_owner", "sys_module", "sys_rawio", "sys_chroot",
+		"sys_ptrace", "sys_pacct", "sys_admin", "sys_boot", "sys_nice",
+		"sys_resource", "sys_time", "sys_tty_config", "mknod", "lease",
+		"audit_write", "audit_control", "setfcap",
+	}
+
+	commonCap2Perms = []string{
+		"mac_override", "mac_admin", "syslog", "wake_alarm", "block_suspend",
+		"audit_read", "perfmon", "bpf", "checkpoint_restore",
+	}
+)
+
+// selinuxClassPermissions maps the object classes to their permissions.
+var selinuxClassPermissions = map[string][]string{
+	"security": {
+		"compute_av", "compute_create", "compute_member", "check_context",
+		"load_policy", "compute_relabel", "compute_user", "setenforce",
+		"setbool", "setsecparam", "setcheckreqprot", "read_policy",
+		"validate_trans",
+	},
+	"process": {
+		"fork", "transition", "sigchld", "sigkill", "sigstop", "signull",
+		"signal", "ptrace", "getsched", "setsched", "getsession", "getpgid",
+		"setpgid", "getcap", "setcap", "share", "getattr", "setexec",
+		"setfscreate", "noatsecure", "siginh", "setrlimit", "rlimitinh",
+		"dyntransition", "setcurrent", "execmem", "execstack", "execheap",
+		"setkeycreate", "setsockcreate", "getrlimit",
+	},
+	"process2": {"nnp_transition", "nosuid_transition"},
+	"system": {
+		"ipc_info", "syslog_read", "syslog_mod", "syslog_console",
+		"module_request", "module_load", "firmware_load", "kexec_image_load",
+		"kexec_initramfs_load", "policy_load", "x509_certificate_load",
+	},
+	"capability":  commonCapPerms,
+	"capability2": commonCap2Perms,
+	"cap_userns":  commonCapPerms,
+	"cap2_userns": commonCap2Perms,
+	"filesystem": {
+		"mount", "remount", "unmount", "getattr", "relabelfrom", "relabelto",
+		"associate", "quotamod", "quotaget", "watch",
+	},
+	"file": slices.Concat(commonFilePerms, []string{"execute_no_trans", "entrypoint"}),
+	"dir": slices.Concat(commonFilePerms, []string{
+		"add_name", "remove_name", "reparent", "search", "rmdir",
+	}),
+	"fd":                 {"use"},
+	"lnk_file":           commonFilePerms,
+	"chr_file":           commonFilePerms,
+	"blk_file":           commonFilePerms,
+	"sock_file":          commonFilePerms,
+	"fifo_file":          commonFilePerms,
+	"anon_inode":         commonFilePerms,
+	"tcp_socket":         slices.Concat(commonSockPerms, []string{"node_bind", "name_connect"}),
+	"dccp_socket":        slices.Concat(commonSockPerms, []string{"node_bind", "name_connect"}),
+	"sctp_socket":        slices.Concat(commonSockPerms, []string{"node_bind", "name_connect", "association"}),
+	"udp_socket":         slices.Concat(commonSockPerms, []string{"node_bind"}),
+	"rawip_socket":       slices.Concat(commonSockPerms, []string{"node_bind"}),
+	"icmp_socket":        slices.Concat(commonSockPerms, []string{"node_bind"}),
+	"unix_stream_socket": slices.Concat(commonSockPerms, []string{"connectto"}),
+	"tun_socket":         slices.Concat(commonSockPerms, []string{"attach_queue"}),
+	"netlink_route_socket": slices.Concat(commonSockPerms, []string{
+		"nlmsg_read", "nlmsg_write", "nlmsg_readpriv", "nlmsg_getneigh",
+	}),
+	"netlink_tcpdiag_socket": slices.Concat(commonSockPerms, []string{"nlmsg_read", "nlmsg_write"}),
+	"netlink_xfrm_socket":    slices.Concat(commonSockPerms, []string{"nlmsg_read", "nlmsg_write"}),
+	"netlink_audit_socket": slices.Concat(commonSockPerms, []string{
+		"nlmsg_read", "nlmsg_write", "nlmsg_relay", "nlmsg_readpriv",
+		"nlmsg_tty_audit",
+	}),
+	"node":           {"recvfrom", "sendto"},
+	"netif":          {"ingress", "egress"},
+	"sem":            commonIPCPerms,
+	"msg":            {"send", "receive"},
+	"msgq":           slices.Concat(commonIPCPerms, []string{"enqueue"}),
+	"shm":            slices.Concat(commonIPCPerms, []string{"lock"}),
+	"ipc":            commonIPCPerms,
+	"association":    {"sendto", "recvfrom", "setcontext", "polmatch"},
+	"packet":         {"send", "recv", "relabelto", "forward_in", "forward_out"},
+	"key":            {"view", "read", "write", "search", "link", "setattr", "create"},
+	"memprotect":     {"mmap_zero"},
+	"peer":           {"recv"},
+	"kernel_service": {"use_as_override", "create_files_as"},
+	"binder":         {"impersonate", "call", "set_context_mgr", "transfer"},
+	"bpf":            {"map_create", "map_read", "map_write", "prog_load", "prog_run"},
+	"perf_event":     {"open", "cpu", "kernel", "tracepoint", "read", "write"},
+	"io_uring":       {"override_creds", "sqpoll", "cmd", "allowed"},
+	"user_namespace": {"create"},
+	"lockdown":       {"integrity", "confidentiality"},
+}
+
+// selinuxPermissions returns the names of the permissions set in the access
+// vector of class. Bits which cannot be resolved are returned separately.
+func selinuxPermissions(class string, av uint32) (perms []string, unknown uint32) {
+	classPerms, ok := selinuxClassPermissions[class]
+	if !ok && strings.HasSuffix(class, "socket") {
+		// All other socket classes only use the common socket permissions.
+		classPerms, ok = commonSockPerms, true
+	}
+
+	if !ok {
+		return nil, av
+	}
+
+	for i := range 32 {
+		bit := uint32(1) << i
+		if av&bit == 0 {
+			continue
+		}
+
+		if i < len(classPerms) {
+			perms = append(perms, classPerms[i])
+		} else {
+			unknown |= bit
+		}
+	}
+
+	return perms, unknown
+}
@@ -18,13 +18,19 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/release-utils/helpers"
 
@@ -55,6 +61,100 @@ func GetSPOD(ctx context.Context, cli client.Client) (*spodapi.SecurityProfilesO
 	return spod, nil
 }
 
+// ErrSignatureVerificationKeyNotFound is the error returned if the referenced
+// key does not exist in the signature verification keys object.
+var ErrSignatureVerificationKeyNotFound = errors.New("signature verification key not found")
+
+// GetSignatureVerificationKeys returns the PEM encoded public keys of the
+// Secret or ConfigMap in the operator namespace referenced by ref. The values
+// of all keys are concatenated if ref does not specify a key.
+func GetSignatureVerificationKeys(
+	ctx context.Context, reader client.Reader, ref *spodapi.SignatureVerificationKeysRef,
+) ([]byte, error) {
+	key := types.NamespacedName{Name: ref.Name, Namespace: config.GetOperatorNamespace()}
+	data := map[string][]byte{}
+
+	switch ref.Kind {
+	case spodapi.SignatureVerificationKeysKindSecret:
+		secret := &corev1.Secret{}
+		if err := reader.Get(ctx, key, secret); err != nil {
+			return nil, fmt.Errorf("get signature verification keys secret: %w", err)
+		}
+
+		maps.Copy(data, secret.Data)
+
+	case spodapi.SignatureVerificationKeysKindConfigMap:
+		configMap := &corev1.ConfigMap{}
+		if err := reader.Get(ctx, key, configMap); err != nil {
+			return nil, fmt.Errorf("get signature verification keys config map: %w", err)
+		}
+
+		maps.Copy(data, configMap.BinaryData)
+
+		for k, v := range configMap.Data {
+			data[k] = []byte(v)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported signature verification keys kind: %s", ref.Kind)
+	}
+
+	if ref.Key != "" {
+		value, ok := data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSignatureVerificationKeyNotFound, ref.Key)
+		}
+
+		return value, nil
+	}
+
+	keys := []byte{}
+	for _, k := range slices.Sorted(maps.Keys(data)) {
+		keys = append(keys, data[k]...)
+		keys = append(keys, '\n')
+	}
+
+	return keys, nil
+}
+
+// ErrCABundleKeyNotFound is the error returned if the referenced key does not
+// exist in the CA bundle ConfigMap.
+var ErrCABundleKeyNotFound = errors.New("CA bundle key not found")
+
+// GetCABundle returns the PEM encoded CA certificates of the ConfigMap key in
+// the operator namespace referenced by ref. An empty bundle is returned if
+// the reference is optional and the ConfigMap or key does not exist.
+func GetCABundle(
+	ctx context.Context, reader client.Reader, ref *corev1.ConfigMapKeySelector,
+) ([]byte, error) {
+	optional := ptr.Deref(ref.Optional, false)
+
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, types.NamespacedName{
+		Name: ref.Name, Namespace: config.GetOperatorNamespace(),
+	}, configMap); err != nil {
+		if optional && kerrors.IsNotFound(err) {
+			return []byte{}, nil
+		}
+
+		return nil, fmt.Errorf("get CA bundle config map: %w", err)
+	}
+
+	if value, ok := configMap.Data[ref.Key]; ok {
+		return []byte(value), nil
+	}
+
+	if value, ok := configMap.BinaryData[ref.Key]; ok {
+		return value, nil
+	}
+
+	if optional {
+		return []byte{}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrCABundleKeyNotFound, ref.Key)
+}
+
 // LogFilePath returns either the path to the audit logs or falls back to
 // syslog if the audit log path does not exist.
 func LogFilePath() string {
@@ -20,7 +20,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -49,3 +54,109 @@ func Test_AuditTimeToIso(t *testing.T) {
 	_, errInvalid2 := AuditTimeToIso("invalid.invalid")
 	require.Error(t, errInvalid2)
 }
+
+func Test_GetSignatureVerificationKeys(t *testing.T) {
+	t.Setenv(config.OperatorNamespaceEnvKey, "operator")
+
+	reader := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: "operator"},
+			Data: map[string][]byte{
+				"b.pub": []byte("second"),
+				"a.pub": []byte("first"),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: "operator"},
+			Data:       map[string]string{"cosign.pub": "key"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "default"},
+		},
+	).Build()
+
+	for _, tc := range []struct {
+		ref     spodapi.SignatureVerificationKeysRef
+		want    string
+		wantErr bool
+	}{
+		{ // all secret keys
+			ref:  spodapi.SignatureVerificationKeysRef{Kind: spodapi.SignatureVerificationKeysKindSecret, Name: "keys"},
+			want: "first\nsecond\n",
+		},
+		{ // single secret key
+			ref: spodapi.SignatureVerificationKeysRef{
+				Kind: spodapi.SignatureVerificationKeysKindSecret, Name: "keys", Key: "b.pub",
+			},
+			want: "second",
+		},
+		{ // config map key
+			ref: spodapi.SignatureVerificationKeysRef{
+				Kind: spodapi.SignatureVerificationKeysKindConfigMap, Name: "keys", Key: "cosign.pub",
+			},
+			want: "key",
+		},
+		{ // missing key
+			ref: spodapi.SignatureVerificationKeysRef{
+				Kind: spodapi.SignatureVerificationKeysKindConfigMap, Name: "keys", Key: "missing",
+			},
+			wantErr: true,
+		},
+		{ // object outside of the operator namespace
+			ref: spodapi.SignatureVerificationKeysRef{
+				Kind: spodapi.SignatureVerificationKeysKindConfigMap, Name: "other-namespace",
+			},
+			wantErr: true,
+		},
+	} {
+		keys, err := GetSignatureVerificationKeys(t.Context(), reader, &tc.ref)
+		if tc.wantErr {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.want, string(keys))
+	}
+}
+
+func Test_GetCABundle(t *testing.T) {
+	t.Setenv(config.OperatorNamespaceEnvKey, "operator")
+
+	reader := fake.NewClientBuilder().WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "operator"},
+			Data:       map[string]string{"ca.crt": "cert"},
+			BinaryData: map[string][]byte{"binary.crt": []byte("binary")},
+		},
+	).Build()
+
+	caBundle, err := GetCABundle(t.Context(), reader, &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "ca.crt",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cert", string(caBundle))
+
+	caBundle, err = GetCABundle(t.Context(), reader, &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "binary.crt",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "binary", string(caBundle))
+
+	_, err = GetCABundle(t.Context(), reader, &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "missing",
+	})
+	require.ErrorIs(t, err, ErrCABundleKeyNotFound)
+
+	_, err = GetCABundle(t.Context(), reader, &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "ca.crt",
+	})
+	require.Error(t, err)
+
+	caBundle, err = GetCABundle(t.Context(), reader, &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "ca.crt", Optional: ptr.To(true),
+	})
+	require.NoError(t, err)
+	require.Empty(t, caBundle)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const ReasonReResolvedBaseProfile string = "ReResolvedBaseProfile"
+
+// ResolvedBaseProfile is a remote base profile pinned to its digest,
+// independent of the profile kind.
+type ResolvedBaseProfile struct {
+	Reference string
+	Digest    string
+}
+
+// BaseProfileKind describes where a profile kind stores the digests of its
+// remote base profiles.
+type BaseProfileKind[P client.Object] struct {
+	// Annotation requests a re-resolution of the base profiles if its value
+	// changes.
+	Annotation string
+
+	// Status returns the resolved base profiles and the observed value of
+	// Annotation from the status of the profile.
+	Status func(profile P) (resolved []ResolvedBaseProfile, observed string)
+
+	// SetStatus stores the resolved base profiles and the observed value of
+	// Annotation in the status of the profile.
+	SetStatus func(profile P, resolved []ResolvedBaseProfile, observed string)
+}
+
+// BaseProfileDigests tracks the digests of the remote base profiles of a
+// single profile.
+type BaseProfileDigests struct {
+	// pinned are the digests per reference from the profile status, which
+	// are used instead of resolving the reference again.
+	pinned map[string]string
+
+	// resolved are the digests of all remote base profiles used during the
+	// current resolution.
+	resolved []ResolvedBaseProfile
+}
+
+// NewDigests returns the base profile digests for profile. The digests pinned
+// in the status are ignored if a re-resolution has been requested.
+func (k *BaseProfileKind[P]) NewDigests(profile P) *BaseProfileDigests {
+	digests := &BaseProfileDigests{pinned: map[string]string{}}
+	if k.ReResolveRequested(profile) {
+		return digests
+	}
+
+	resolved, _ := k.Status(profile)
+	for _, r := range resolved {
+		digests.pinned[r.Reference] = r.Digest
+	}
+
+	return digests
+}
+
+// ReResolveRequested returns true if the resolve base profiles annotation
+// changed since the last resolution.
+func (k *BaseProfileKind[P]) ReResolveRequested(profile P) bool {
+	_, observed := k.Status(profile)
+
+	return profile.GetAnnotations()[k.Annotation] != observed
+}
+
+// UpdateStatus pins the resolved digests in the status of profile. The update
+// fails on a conflict if another node resolved the base profiles in the
+// meantime, which ensures that all nodes use the same digests.
+func (k *BaseProfileKind[P]) UpdateStatus(
+	ctx context.Context,
+	cl client.Client,
+	rec record.EventRecorder,
+	profile P,
+	digests *BaseProfileDigests,
+	l logr.Logger,
+) error {
+	reResolved := k.ReResolveRequested(profile)
+
+	current, _ := k.Status(profile)
+	if !reResolved && slices.Equal(current, digests.resolved) {
+		return nil
+	}
+
+	previous := map[string]string{}
+	for _, r := range current {
+		previous[r.Reference] = r.Digest
+	}
+
+	l.Info("Updating resolved base profiles", "resolvedBaseProfiles", digests.resolved)
+
+	k.SetStatus(profile, digests.resolved, profile.GetAnnotations()[k.Annotation])
+
+	if err := cl.Status().Update(ctx, profile); err != nil {
+		return fmt.Errorf("update resolved base profiles: %w", err)
+	}
+
+	if !reResolved {
+		return nil
+	}
+
+	for _, r := range digests.resolved {
+		oldDigest := previous[r.Reference]
+		if oldDigest == "" {
+			oldDigest = "none"
+		}
+
+		rec.Event(profile, util.EventTypeNormal, ReasonReResolvedBaseProfile, fmt.Sprintf(
+			"Re-resolved base profile %s from digest %s to %s",
+			r.Reference, oldDigest, r.Digest,
+		))
+	}
+
+	return nil
+}
+
+// Pinned returns the digest reference is pinned to, if any.
+func (b *BaseProfileDigests) Pinned(reference string) (string, bool) {
+	digest, ok := b.pinned[reference]
+
+	return digest, ok
+}
+
+// Add records the digest a reference resolved to. The reference is pinned to
+// that digest for the rest of the resolution.
+func (b *BaseProfileDigests) Add(reference, digest string) {
+	b.pinned[reference] = digest
+
+	for _, r := range b.resolved {
+		if r.Reference == reference {
+			return
+		}
+	}
+
+	b.resolved = append(b.resolved, ResolvedBaseProfile{
+		Reference: reference,
+		Digest:    digest,
+	})
+}
+
+// Resolved returns the digests of all remote base profiles used during the
+// current resolution.
+func (b *BaseProfileDigests) Resolved() []ResolvedBaseProfile {
+	return b.resolved
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const testDigest = "sha256:2d5e1ef4b52b0a5fb1b1e17ae3a5ca83c3b9b8d16c0a6f2b1f2a2c4d3e5f6a7b"
+
+func testBaseProfileKind() *BaseProfileKind[*seccompprofileapi.SeccompProfile] {
+	return &BaseProfileKind[*seccompprofileapi.SeccompProfile]{
+		Annotation: seccompprofileapi.ResolveBaseProfilesAnnotation,
+		Status: func(sp *seccompprofileapi.SeccompProfile) ([]ResolvedBaseProfile, string) {
+			var resolved []ResolvedBaseProfile
+			for _, r := range sp.Status.ResolvedBaseProfiles {
+				resolved = append(resolved, ResolvedBaseProfile(r))
+			}
+
+			return resolved, sp.Status.ObservedResolveBaseProfiles
+		},
+		SetStatus: func(sp *seccompprofileapi.SeccompProfile, resolved []ResolvedBaseProfile, observed string) {
+			sp.Status.ResolvedBaseProfiles = nil
+			for _, r := range resolved {
+				sp.Status.ResolvedBaseProfiles = append(
+					sp.Status.ResolvedBaseProfiles, seccompprofileapi.ResolvedBaseProfile(r),
+				)
+			}
+
+			sp.Status.ObservedResolveBaseProfiles = observed
+		},
+	}
+}
+
+func TestNewDigests(t *testing.T) {
+	t.Parallel()
+
+	resolved := []seccompprofileapi.ResolvedBaseProfile{
+		{Reference: "test:v1", Digest: testDigest},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		annotation string
+		observed   string
+		wantPinned bool
+	}{
+		{
+			name:       "pinned",
+			wantPinned: true,
+		},
+		{
+			name:       "pinned already observed re-resolution",
+			annotation: "1",
+			observed:   "1",
+			wantPinned: true,
+		},
+		{
+			name:       "re-resolution requested",
+			annotation: "2",
+			observed:   "1",
+			wantPinned: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &seccompprofileapi.SeccompProfile{
+				Status: seccompprofileapi.SeccompProfileStatus{
+					ResolvedBaseProfiles:        resolved,
+					ObservedResolveBaseProfiles: tc.observed,
+				},
+			}
+			if tc.annotation != "" {
+				sp.SetAnnotations(map[string]string{
+					seccompprofileapi.ResolveBaseProfilesAnnotation: tc.annotation,
+				})
+			}
+
+			digests := testBaseProfileKind().NewDigests(sp)
+
+			pinned, ok := digests.Pinned("test:v1")
+			require.Equal(t, tc.wantPinned, ok)
+
+			if tc.wantPinned {
+				require.Equal(t, testDigest, pinned)
+			}
+
+			require.Empty(t, digests.Resolved())
+		})
+	}
+}
+
+func TestDigestsAdd(t *testing.T) {
+	t.Parallel()
+
+	digests := testBaseProfileKind().NewDigests(&seccompprofileapi.SeccompProfile{})
+	digests.Add("test:v1", testDigest)
+	digests.Add("test:v1", testDigest)
+
+	pinned, ok := digests.Pinned("test:v1")
+	require.True(t, ok)
+	require.Equal(t, testDigest, pinned)
+	require.Equal(t, []ResolvedBaseProfile{
+		{Reference: "test:v1", Digest: testDigest},
+	}, digests.Resolved())
+}
+
+func TestUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	resolved := []seccompprofileapi.ResolvedBaseProfile{
+		{Reference: "test:v1", Digest: testDigest},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		status     seccompprofileapi.SeccompProfileStatus
+		annotation string
+		updateErr  error
+		wantUpdate bool
+		wantEvents int
+		wantErr    error
+	}{
+		{
+			name:       "no update when unchanged",
+			status:     seccompprofileapi.SeccompProfileStatus{ResolvedBaseProfiles: resolved},
+			wantUpdate: false,
+		},
+		{
+			name:       "update on first resolution",
+			wantUpdate: true,
+		},
+		{
+			name:       "update and events on re-resolution",
+			status:     seccompprofileapi.SeccompProfileStatus{ResolvedBaseProfiles: resolved},
+			annotation: "1",
+			wantUpdate: true,
+			wantEvents: 1,
+		},
+		{
+			name:       "failure on status update",
+			annotation: "1",
+			updateErr:  errTest,
+			wantUpdate: true,
+			wantErr:    errTest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			updated := false
+			cl := &util.MockClient{
+				MockSubResourceWriterUpdate: func(
+					context.Context, client.Object, ...client.SubResourceUpdateOption,
+				) error {
+					updated = true
+
+					return tc.updateErr
+				},
+			}
+			recorder := record.NewFakeRecorder(10)
+
+			sp := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Status:     tc.status,
+			}
+			if tc.annotation != "" {
+				sp.SetAnnotations(map[string]string{
+					seccompprofileapi.ResolveBaseProfilesAnnotation: tc.annotation,
+				})
+			}
+
+			kind := testBaseProfileKind()
+			digests := kind.NewDigests(sp)
+			digests.Add("test:v1", testDigest)
+
+			err := kind.UpdateStatus(t.Context(), cl, recorder, sp, digests, logr.Discard())
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, resolved, sp.Status.ResolvedBaseProfiles)
+				require.Equal(t, tc.annotation, sp.Status.ObservedResolveBaseProfiles)
+			}
+
+			require.Equal(t, tc.wantUpdate, updated)
+			require.Len(t, recorder.Events, tc.wantEvents)
+		})
+	}
+}
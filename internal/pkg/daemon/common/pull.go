@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+)
+
+const allowedAllRegexp = ".*"
+
+// PullOptions returns the signature verification and registry options for
+// pulling the OCI artifact ref as configured in the SPOD.
+func PullOptions(
+	ctx context.Context,
+	reader client.Reader,
+	spod *spodapi.SecurityProfilesOperatorDaemon,
+	ref string,
+) (*artifact.PullSignatureOptions, *artifact.RegistryOptions, error) {
+	security := &spod.Spec.Security
+
+	signOpts := &artifact.PullSignatureOptions{
+		DisableSignatureVerification: ptr.Deref(security.DisableOCIArtifactSignatureVerification, false),
+		AllowedIdentityRegexp:        security.AllowedIdentityRegexp,
+		AllowedOidcIssuerRegexp:      security.AllowedOidcIssuerRegexp,
+		IgnoreTlog:                   ptr.Deref(security.IgnoreTransparencyLog, false),
+	}
+
+	if signOpts.AllowedIdentityRegexp == "" {
+		signOpts.AllowedIdentityRegexp = allowedAllRegexp
+	}
+
+	if signOpts.AllowedOidcIssuerRegexp == "" {
+		signOpts.AllowedOidcIssuerRegexp = allowedAllRegexp
+	}
+
+	if security.SignatureVerificationKeys != nil && !signOpts.DisableSignatureVerification {
+		keys, err := GetSignatureVerificationKeys(ctx, reader, security.SignatureVerificationKeys)
+		if err != nil {
+			return nil, nil, fmt.Errorf("retrieving the signature verification keys: %w", err)
+		}
+
+		signOpts.PublicKeys = keys
+	}
+
+	host, _, _ := strings.Cut(ref, "/")
+	regOpts := &artifact.RegistryOptions{}
+
+	for i := range security.OCIRegistries {
+		registry := &security.OCIRegistries[i]
+		if registry.Host != host {
+			continue
+		}
+
+		regOpts.PlainHTTP = ptr.Deref(registry.PlainHTTP, false)
+		regOpts.InsecureSkipTLSVerify = ptr.Deref(registry.InsecureSkipTLSVerify, false)
+
+		if registry.CABundle != nil {
+			caBundle, err := GetCABundle(ctx, reader, registry.CABundle)
+			if err != nil {
+				return nil, nil, fmt.Errorf("retrieving the CA bundle for registry %s: %w", host, err)
+			}
+
+			regOpts.CABundle = caBundle
+		}
+
+		break
+	}
+
+	return signOpts, regOpts, nil
+}
@@ -110,12 +110,30 @@ var (
 	)
 
 	uidGidRegex = regexp.MustCompile(`.*?\suid=(\d+).*?\sgid=(\d+).*`)
+
+	seccompCodeRegex   = regexp.MustCompile(`\scode=0x([0-9a-fA-F]{1,8})`)
+	selinuxActionRegex = regexp.MustCompile(`avc:\s+(denied|granted)`)
 )
 
+// seccompActions maps the SECCOMP_RET_ACTION_FULL bits of the audited code
+// to the action name.
+var seccompActions = map[uint32]string{
+	0x00000000: "kill_thread",
+	0x80000000: "kill_process",
+	0x00030000: "trap",
+	0x00050000: "errno",
+	0x7fc00000: "user_notif",
+	0x7ff00000: "trace",
+	0x7ffc0000: "log",
+	0x7fff0000: "allow",
+}
+
 var (
 	minSeccompCapturesExpected  = 5
 	minSelinuxCapturesExpected  = 7
 	minAppArmorCapturesExpected = 9
+
+	seccompRetActionFull uint32 = 0xffff0000
 )
 
 // IsAuditLine checks whether logLine is a supported audit line.
@@ -170,6 +188,12 @@ func extractSeccompLine(logLine string) *types.AuditLine {
 		line.SystemCallID = int32(syscallID)
 	}
 
+	if code := seccompCodeRegex.FindStringSubmatch(logLine); len(code) > 1 {
+		if value, err := strconv.ParseUint(code[1], 16, 32); err == nil {
+			line.Action = seccompActions[uint32(value)&seccompRetActionFull]
+		}
+	}
+
 	return &line
 }
 
@@ -197,6 +221,10 @@ func extractSelinuxLine(logLine string) *types.AuditLine {
 	line.Tcontext = captures[5]
 	line.Tclass = captures[6]
 
+	if action := selinuxActionRegex.FindStringSubmatch(logLine); len(action) > 1 {
+		line.Action = action[1]
+	}
+
 	return &line
 }
 
@@ -214,6 +242,7 @@ func extractApparmorLine(logLine string) *types.AuditLine {
 		Profile:     captures[5],
 		Name:        captures[6],
 		Executable:  captures[8],
+		Action:      ApparmorAction(captures[3]),
 	}
 
 	extractProcessId(&line, captures[7])
@@ -225,6 +254,15 @@ func extractApparmorLine(logLine string) *types.AuditLine {
 	return &line
 }
 
+// ApparmorAction converts the apparmor field of an audit line to its action.
+func ApparmorAction(apparmor string) string {
+	if strings.EqualFold(apparmor, "ALLOW") {
+		return "allowed"
+	}
+
+	return strings.ToLower(apparmor)
+}
+
 func GetUidGid(auditLine string) (uid, gid uint32, err error) {
 	captures := uidGidRegex.FindStringSubmatch(auditLine)
 	if len(captures) < 2 {
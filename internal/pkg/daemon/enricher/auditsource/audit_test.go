@@ -122,6 +122,7 @@ func Test_extractAuditLine(t *testing.T) {
 				SystemCallID: 0,
 				ProcessID:    3109464,
 				Executable:   "/bin/busybox",
+				Action:       "log",
 			},
 			nil,
 		},
@@ -135,6 +136,7 @@ func Test_extractAuditLine(t *testing.T) {
 				SystemCallID: 3,
 				ProcessID:    2039886,
 				Executable:   "/bin/ls",
+				Action:       "log",
 			},
 			nil,
 		},
@@ -149,6 +151,7 @@ func Test_extractAuditLine(t *testing.T) {
 				ProcessID:    75593,
 				Executable:   "",
 				Perm:         "read",
+				Action:       "denied",
 				Scontext:     "system_u:system_r:container_t:s0:c4,c808",
 				Tcontext:     "system_u:object_r:var_lib_t:s0",
 				Tclass:       "lnk_file",
@@ -166,6 +169,7 @@ func Test_extractAuditLine(t *testing.T) {
 				ProcessID:    94509,
 				Executable:   "",
 				Perm:         "read write open",
+				Action:       "denied",
 				Scontext:     "system_u:system_r:selinuxrecording.process:s0:c218,c875",
 				Tcontext:     "system_u:object_r:kubernetes_file_t:s0",
 				Tclass:       "file",
@@ -181,6 +185,7 @@ func Test_extractAuditLine(t *testing.T) {
 				TimestampID: "1668191154.949:64",
 				ProcessID:   4166,
 				Apparmor:    "DENIED",
+				Action:      "denied",
 				Operation:   "exec",
 				Profile:     "profile-name",
 				Name:        "/usr/local/bin/sample-app",
@@ -197,6 +202,7 @@ func Test_extractAuditLine(t *testing.T) {
 				TimestampID: "1668191154.949:64",
 				ProcessID:   4166,
 				Apparmor:    "DENIED",
+				Action:      "denied",
 				Operation:   "exec",
 				Profile:     "profile-name",
 				Name:        "/usr/local/bin/sample-app",
@@ -132,6 +132,7 @@ func (b *BpfSource) StartTail() (chan *types.AuditLine, error) {
 				ProcessID:   pid,
 				TimestampID: timestamp,
 				Apparmor:    apparmor,
+				Action:      ApparmorAction(apparmor),
 				Operation:   op,
 				Name:        name,
 				Executable:  comm,
@@ -30,7 +30,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 
-	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
@@ -151,17 +150,21 @@ func populateCacheEntryForContainer(
 				continue
 			}
 
-			recordProfile, ok := pod.Annotations[config.SeccompProfileRecordLogsAnnotationKey+containerName]
-			if !ok {
-				recordProfile = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
-			}
+			recordProfile, ephemeral := containerRecordProfile(pod, containerName)
+
+			seccompProfile, selinuxProfile, appArmorProfile := installedProfiles(pod, containerName)
 
 			info := &types.ContainerInfo{
-				PodName:       pod.Name,
-				ContainerName: containerStatus.Name,
-				Namespace:     pod.Namespace,
-				ContainerID:   rawContainerID,
-				RecordProfile: recordProfile,
+				PodName:         pod.Name,
+				ContainerName:   containerStatus.Name,
+				Namespace:       pod.Namespace,
+				ContainerID:     rawContainerID,
+				RecordProfile:   recordProfile,
+				Labels:          pod.Labels,
+				SeccompProfile:  seccompProfile,
+				SelinuxProfile:  selinuxProfile,
+				AppArmorProfile: appArmorProfile,
+				Ephemeral:       ephemeral,
 			}
 
 			// Update the cache
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	driftProfileKeyPrefix = "drift/"
+	selinuxProcessSuffix  = ".process"
+	seccompProfileSuffix  = ".json"
+)
+
+// DriftProfileKey returns the key for retrieving the behavior observed for
+// containers running with an installed profile via the Syscalls, Avcs and
+// ApparmorPaths APIs. The key cannot collide with recorded profiles, because
+// profile names are not allowed to contain a slash.
+func DriftProfileKey(profile string) string {
+	return driftProfileKeyPrefix + profile
+}
+
+// insertIntoSet adds the value to the string set stored for the key.
+func insertIntoSet(m *sync.Map, key, value string) {
+	s, _ := m.LoadOrStore(key, sets.New[string]())
+
+	stringSet, ok := s.(sets.Set[string])
+	if ok {
+		stringSet.Insert(value)
+	}
+}
+
+// installedProfiles returns the names of the seccomp, SELinux and AppArmor
+// profiles managed by the operator which are used by the container.
+func installedProfiles(pod *v1.Pod, containerName string) (seccomp, selinux, apparmor string) {
+	var securityContext *v1.SecurityContext
+
+	for i := range pod.Spec.InitContainers {
+		if pod.Spec.InitContainers[i].Name == containerName {
+			securityContext = pod.Spec.InitContainers[i].SecurityContext
+		}
+	}
+
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == containerName {
+			securityContext = pod.Spec.Containers[i].SecurityContext
+		}
+	}
+
+	for i := range pod.Spec.EphemeralContainers {
+		if pod.Spec.EphemeralContainers[i].Name == containerName {
+			securityContext = pod.Spec.EphemeralContainers[i].SecurityContext
+		}
+	}
+
+	podSecurityContext := pod.Spec.SecurityContext
+	if podSecurityContext == nil {
+		podSecurityContext = &v1.PodSecurityContext{}
+	}
+
+	if securityContext == nil {
+		securityContext = &v1.SecurityContext{}
+	}
+
+	seccompProfile := securityContext.SeccompProfile
+	if seccompProfile == nil {
+		seccompProfile = podSecurityContext.SeccompProfile
+	}
+
+	if seccompProfile != nil &&
+		seccompProfile.Type == v1.SeccompProfileTypeLocalhost &&
+		seccompProfile.LocalhostProfile != nil {
+		seccomp = operatorProfileName(*seccompProfile.LocalhostProfile, seccompProfileSuffix)
+	}
+
+	seLinuxOptions := securityContext.SELinuxOptions
+	if seLinuxOptions == nil {
+		seLinuxOptions = podSecurityContext.SELinuxOptions
+	}
+
+	if seLinuxOptions != nil {
+		if name, ok := strings.CutSuffix(seLinuxOptions.Type, selinuxProcessSuffix); ok {
+			selinux = name
+		}
+	}
+
+	appArmorProfile := securityContext.AppArmorProfile
+	if appArmorProfile == nil {
+		appArmorProfile = podSecurityContext.AppArmorProfile
+	}
+
+	if appArmorProfile != nil &&
+		appArmorProfile.Type == v1.AppArmorProfileTypeLocalhost &&
+		appArmorProfile.LocalhostProfile != nil {
+		apparmor = *appArmorProfile.LocalhostProfile
+	}
+
+	return seccomp, selinux, apparmor
+}
+
+// operatorProfileName returns the profile name for a localhost profile path
+// like "operator/name.json", or an empty string if the profile is not managed
+// by the operator.
+func operatorProfileName(localhostProfile, suffix string) string {
+	name, ok := strings.CutPrefix(localhostProfile, config.OperatorProfilesFolder+"/")
+	if !ok {
+		return ""
+	}
+
+	name, ok = strings.CutSuffix(name, suffix)
+	if !ok || strings.Contains(name, "/") {
+		return ""
+	}
+
+	return name
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func TestInstalledProfiles(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                       string
+		pod                        *v1.Pod
+		seccomp, selinux, apparmor string
+	}{
+		{
+			name: "no security context",
+			pod: &v1.Pod{Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "nginx"}},
+			}},
+		},
+		{
+			name: "container security context",
+			pod: &v1.Pod{Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name: "nginx",
+					SecurityContext: &v1.SecurityContext{
+						SeccompProfile: &v1.SeccompProfile{
+							Type:             v1.SeccompProfileTypeLocalhost,
+							LocalhostProfile: ptr.To("operator/nginx.json"),
+						},
+						SELinuxOptions: &v1.SELinuxOptions{Type: "nginx-selinux.process"},
+						AppArmorProfile: &v1.AppArmorProfile{
+							Type:             v1.AppArmorProfileTypeLocalhost,
+							LocalhostProfile: ptr.To("nginx-apparmor"),
+						},
+					},
+				}},
+			}},
+			seccomp:  "nginx",
+			selinux:  "nginx-selinux",
+			apparmor: "nginx-apparmor",
+		},
+		{
+			name: "pod security context",
+			pod: &v1.Pod{Spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{
+					SeccompProfile: &v1.SeccompProfile{
+						Type:             v1.SeccompProfileTypeLocalhost,
+						LocalhostProfile: ptr.To("operator/nginx.json"),
+					},
+				},
+				EphemeralContainers: []v1.EphemeralContainer{{
+					EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "nginx"},
+				}},
+			}},
+			seccomp: "nginx",
+		},
+		{
+			name: "profiles not managed by the operator",
+			pod: &v1.Pod{Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name: "nginx",
+					SecurityContext: &v1.SecurityContext{
+						SeccompProfile: &v1.SeccompProfile{
+							Type:             v1.SeccompProfileTypeLocalhost,
+							LocalhostProfile: ptr.To("custom/nginx.json"),
+						},
+						SELinuxOptions: &v1.SELinuxOptions{Type: "container_t"},
+					},
+				}},
+			}},
+		},
+	} {
+		pod := tc.pod
+		seccomp, selinux, apparmor := tc.seccomp, tc.selinux, tc.apparmor
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotSeccomp, gotSelinux, gotApparmor := installedProfiles(pod, "nginx")
+			require.Equal(t, seccomp, gotSeccomp)
+			require.Equal(t, selinux, gotSelinux)
+			require.Equal(t, apparmor, gotApparmor)
+		})
+	}
+}
+
+func TestDriftRecording(t *testing.T) {
+	t.Parallel()
+
+	sut, err := New(logr.Discard(), nil)
+	require.NoError(t, err)
+
+	sut.impl = &enricherfakes.FakeImpl{}
+
+	info := &types.ContainerInfo{
+		Namespace:       namespace,
+		PodName:         pod,
+		ContainerName:   "nginx",
+		SeccompProfile:  "nginx-seccomp",
+		SelinuxProfile:  "nginx-selinux",
+		AppArmorProfile: "nginx-apparmor",
+	}
+
+	require.NoError(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		SystemCallID: 10,
+	}, info))
+	require.NoError(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+		AuditType: types.AuditTypeSelinux,
+		Perm:      "read",
+		Scontext:  "system_u:system_r:nginx-selinux.process:s0",
+		Tcontext:  "system_u:object_r:var_log_t:s0",
+		Tclass:    "file",
+	}, info))
+	require.NoError(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+		AuditType: types.AuditTypeApparmor,
+		Profile:   "nginx-apparmor",
+		Name:      "/etc/shadow",
+	}, info))
+	require.NoError(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+		AuditType: types.AuditTypeApparmor,
+		Profile:   "other",
+		Name:      "/etc/passwd",
+	}, info))
+
+	syscalls, err := sut.Syscalls(context.Background(), &api.SyscallsRequest{
+		Profile: DriftProfileKey("nginx-seccomp"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{syscall}, syscalls.GetSyscalls())
+
+	avcs, err := sut.Avcs(context.Background(), &api.AvcRequest{
+		Profile: DriftProfileKey("nginx-selinux"),
+	})
+	require.NoError(t, err)
+	require.Len(t, avcs.GetAvc(), 1)
+	require.Equal(t, "read", avcs.GetAvc()[0].GetPerm())
+
+	paths, err := sut.ApparmorPaths(context.Background(), &api.ApparmorPathsRequest{
+		Profile: DriftProfileKey("nginx-apparmor"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/etc/shadow"}, paths.GetPaths())
+
+	_, err = sut.ApparmorPaths(context.Background(), &api.ApparmorPathsRequest{
+		Profile: DriftProfileKey("other"),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
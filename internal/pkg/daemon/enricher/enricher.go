@@ -36,6 +36,7 @@ import (
 
 	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/auditsource"
@@ -57,6 +58,9 @@ const (
 type LogEnricherOptions struct {
 	EnricherFiltersJson string
 	AuditSource         string
+	// SeccompNotifyRecorder enables recording syscalls via seccomp user
+	// notifications.
+	SeccompNotifyRecorder bool
 }
 
 var LogEnricherDefaultOptions = LogEnricherOptions{
@@ -74,9 +78,15 @@ type Enricher struct {
 	infoCache        *ttlcache.Cache[string, *types.ContainerInfo]
 	syscalls         sync.Map
 	avcs             sync.Map
+	apparmorPaths    sync.Map
 	auditLineCache   *ttlcache.Cache[string, []*types.AuditLine]
+	processCache     *ttlcache.Cache[int, *types.ProcessInfo]
 	clientset        kubernetes.Interface
 	enricherFilters  []types.EnricherFilterOptions
+	watchers         eventWatchers
+
+	seccompNotifyRecorder bool
+	seccompNotifyLock     sync.Mutex
 }
 
 // New returns a new Enricher instance.
@@ -130,7 +140,12 @@ func New(logger logr.Logger, opts *LogEnricherOptions) (*Enricher, error) {
 			// if/when the cache is full.
 			ttlcache.WithDisableTouchOnHit[string, []*types.AuditLine](),
 		),
-		enricherFilters: enricherFilters,
+		processCache: ttlcache.New(
+			ttlcache.WithTTL[int, *types.ProcessInfo](defaultCacheTimeout),
+			ttlcache.WithCapacity[int, *types.ProcessInfo](maxCacheItems),
+		),
+		enricherFilters:       enricherFilters,
+		seccompNotifyRecorder: opts != nil && opts.SeccompNotifyRecorder,
 	}, nil
 }
 
@@ -152,6 +167,7 @@ func (e *Enricher) Run() error {
 	go e.containerIDCache.Start()
 	go e.infoCache.Start()
 	go e.auditLineCache.Start()
+	go e.processCache.Start()
 
 	nodeName := e.Getenv(config.NodeNameEnvKey)
 	if nodeName == "" {
@@ -196,6 +212,12 @@ func (e *Enricher) Run() error {
 		return fmt.Errorf("start GRPC server: %w", err)
 	}
 
+	if e.seccompNotifyRecorder {
+		if err := e.startSeccompNotifyListener(nodeName); err != nil {
+			return fmt.Errorf("start seccomp notify listener: %w", err)
+		}
+	}
+
 	log, err := e.StartTail(e.source)
 	if err != nil {
 		return fmt.Errorf("tail audit log: %w", err)
@@ -368,6 +390,8 @@ func (e *Enricher) dispatchAuditLine(
 	auditLine *types.AuditLine,
 	info *types.ContainerInfo,
 ) error {
+	e.publishEvent(nodeName, auditLine, info)
+
 	switch auditLine.AuditType {
 	case types.AuditTypeSelinux:
 		e.dispatchSelinuxLine(metricsClient, nodeName, auditLine, info)
@@ -426,6 +450,8 @@ func (e *Enricher) dispatchSelinuxLine(
 	}
 
 	if info.RecordProfile != "" {
+		recordKey := e.recordProfileKey(auditLine, info)
+
 		for perm := range strings.SplitSeq(auditLine.Perm, " ") {
 			avc := &apienricher.AvcResponse_SelinuxAvc{
 				Perm:     perm,
@@ -439,13 +465,29 @@ func (e *Enricher) dispatchSelinuxLine(
 				e.logger.Error(err, "marshall protobuf")
 			}
 
-			a, _ := e.avcs.LoadOrStore(info.RecordProfile, sets.New[string]())
+			a, _ := e.avcs.LoadOrStore(recordKey, sets.New[string]())
 
 			stringSet, ok := a.(sets.Set[string])
 			if ok {
 				stringSet.Insert(string(jsonBytes))
 			}
 		}
+	} else if info.SelinuxProfile != "" {
+		for perm := range strings.SplitSeq(auditLine.Perm, " ") {
+			jsonBytes, err := protojson.Marshal(&apienricher.AvcResponse_SelinuxAvc{
+				Perm:     perm,
+				Scontext: auditLine.Scontext,
+				Tcontext: auditLine.Tcontext,
+				Tclass:   auditLine.Tclass,
+			})
+			if err != nil {
+				e.logger.Error(err, "marshall protobuf")
+
+				continue
+			}
+
+			insertIntoSet(&e.avcs, DriftProfileKey(info.SelinuxProfile), string(jsonBytes))
+		}
 	}
 }
 
@@ -479,6 +521,10 @@ func (e *Enricher) dispatchSeccompLine(
 		"syscallID", auditLine.SystemCallID,
 		"syscallName", syscallName)
 
+	if name, ok := auditProfileName(info.SeccompProfile); ok {
+		logMap.Put("auditProfile", name)
+	}
+
 	logLevel := ApplyEnricherFilters(logMap.Values(), e.enricherFilters)
 	if logLevel == types.EnricherLogLevelNone {
 		e.logger.V(config.VerboseLevel).Info("Skip logging", logMap.BulkGet()...)
@@ -503,15 +549,23 @@ func (e *Enricher) dispatchSeccompLine(
 	}
 
 	if info.RecordProfile != "" {
-		s, _ := e.syscalls.LoadOrStore(info.RecordProfile, sets.New[string]())
+		s, _ := e.syscalls.LoadOrStore(e.recordProfileKey(auditLine, info), sets.New[string]())
 
 		stringSet, ok := s.(sets.Set[string])
 		if ok {
 			stringSet.Insert(syscallName)
 		}
+	} else if info.SeccompProfile != "" {
+		insertIntoSet(&e.syscalls, DriftProfileKey(info.SeccompProfile), syscallName)
 	}
 }
 
+// auditProfileName returns the name of the enforcing profile if the provided
+// one is the non-enforcing variant used by profile bindings in Audit mode.
+func auditProfileName(profile string) (string, bool) {
+	return strings.CutSuffix(profile, profilebaseapi.AuditProfileSuffix)
+}
+
 func (e *Enricher) dispatchApparmorLine(
 	metricsClient apimetrics.Metrics_AuditIncClient,
 	nodeName string,
@@ -536,6 +590,14 @@ func (e *Enricher) dispatchApparmorLine(
 		logMap.Put("extra_info", auditLine.ExtraInfo)
 	}
 
+	if name, ok := auditProfileName(auditLine.Profile); ok {
+		logMap.Put("auditProfile", name)
+	}
+
+	if info.AppArmorProfile != "" && info.AppArmorProfile == auditLine.Profile && auditLine.Name != "" {
+		insertIntoSet(&e.apparmorPaths, DriftProfileKey(info.AppArmorProfile), auditLine.Name)
+	}
+
 	logLevel := ApplyEnricherFilters(logMap.Values(), e.enricherFilters)
 	if logLevel == types.EnricherLogLevelNone {
 		e.logger.V(1).Info("skip logging", logMap.BulkGet()...)
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"errors"
+
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+const debugProfileKeyPrefix = "debug/"
+
+// DebugProfileKey returns the key for retrieving the behavior of exec
+// sessions and ephemeral containers recorded for a profile via the Syscalls
+// and Avcs APIs. Like DriftProfileKey, the key cannot collide with recorded
+// profiles.
+func DebugProfileKey(profile string) string {
+	return debugProfileKeyPrefix + profile
+}
+
+// containerRecordProfile returns the profile to record for the container and
+// whether the container is an ephemeral one. Ephemeral containers are
+// recorded for the profile of the container they target.
+func containerRecordProfile(pod *v1.Pod, containerName string) (profile string, ephemeral bool) {
+	for i := range pod.Spec.EphemeralContainers {
+		if pod.Spec.EphemeralContainers[i].Name == containerName {
+			containerName = pod.Spec.EphemeralContainers[i].TargetContainerName
+			ephemeral = true
+
+			break
+		}
+	}
+
+	if containerName == "" {
+		return "", ephemeral
+	}
+
+	profile, ok := pod.Annotations[config.SeccompProfileRecordLogsAnnotationKey+containerName]
+	if !ok {
+		profile = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
+	}
+
+	return profile, ephemeral
+}
+
+// recordProfileKey returns the key to record the audit line for. Lines of
+// ephemeral containers and of processes started by an exec session, which
+// got their request UID injected by the execmetadata webhook, are kept
+// apart from the ones of the container itself. The detection is best-effort:
+// processes which exited before their environment got read are recorded
+// into the profile of the container.
+func (e *Enricher) recordProfileKey(auditLine *types.AuditLine, info *types.ContainerInfo) string {
+	if info.Ephemeral {
+		return DebugProfileKey(info.RecordProfile)
+	}
+
+	processInfo, err := GetProcessInfo(auditLine.ProcessID, auditLine.Executable, 0, 0, e.processCache, e.impl)
+	switch {
+	case errors.Is(err, ErrProcessExited):
+		e.logger.Info(
+			"Unable to detect exec session, recording into the container profile",
+			"pid", auditLine.ProcessID, "profile", info.RecordProfile, "err", err,
+		)
+	case err != nil:
+		e.logger.V(config.VerboseLevel).Info("get process info", "err", err)
+	}
+
+	if processInfo != nil && processInfo.ExecRequestId != nil {
+		return DebugProfileKey(info.RecordProfile)
+	}
+
+	return info.RecordProfile
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func TestContainerRecordProfile(t *testing.T) {
+	t.Parallel()
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordLogsAnnotationKey + "nginx": "recording_nginx_abcde_1",
+				config.SelinuxProfileRecordLogsAnnotationKey + "redis": "recording_redis_fghij_1",
+			},
+		},
+		Spec: v1.PodSpec{
+			EphemeralContainers: []v1.EphemeralContainer{
+				{
+					EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debugger"},
+					TargetContainerName:      "nginx",
+				},
+				{
+					EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "untargeted"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		container     string
+		wantProfile   string
+		wantEphemeral bool
+	}{
+		{container: "nginx", wantProfile: "recording_nginx_abcde_1"},
+		{container: "redis", wantProfile: "recording_redis_fghij_1"},
+		{container: "other"},
+		{container: "debugger", wantProfile: "recording_nginx_abcde_1", wantEphemeral: true},
+		{container: "untargeted", wantEphemeral: true},
+	} {
+		t.Run(tc.container, func(t *testing.T) {
+			t.Parallel()
+
+			profile, ephemeral := containerRecordProfile(pod, tc.container)
+			require.Equal(t, tc.wantProfile, profile)
+			require.Equal(t, tc.wantEphemeral, ephemeral)
+		})
+	}
+}
+
+func TestRecordExecSessions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		profile = "recording_nginx_abcde_1"
+		execPID = 2
+	)
+
+	sut, err := New(logr.Discard(), nil)
+	require.NoError(t, err)
+
+	mock := &enricherfakes.FakeImpl{}
+	mock.EnvForPidCalls(func(pid int) (map[string]string, error) {
+		if pid == execPID {
+			return map[string]string{requestIdEnv: "dbbf5fca-c955-4922-99d2-27a50212071c"}, nil
+		}
+
+		return map[string]string{}, nil
+	})
+	sut.impl = mock
+
+	info := &types.ContainerInfo{
+		Namespace:     namespace,
+		PodName:       pod,
+		ContainerName: "nginx",
+		RecordProfile: profile,
+	}
+	ephemeralInfo := &types.ContainerInfo{
+		Namespace:     namespace,
+		PodName:       pod,
+		ContainerName: "debugger",
+		RecordProfile: profile,
+		Ephemeral:     true,
+	}
+
+	for _, line := range []struct {
+		pid  int
+		perm string
+		info *types.ContainerInfo
+	}{
+		{pid: 1, perm: "read", info: info},
+		{pid: execPID, perm: "write", info: info},
+		{pid: 3, perm: "execute", info: ephemeralInfo},
+	} {
+		require.NoError(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+			AuditType: types.AuditTypeSelinux,
+			ProcessID: line.pid,
+			Perm:      line.perm,
+			Scontext:  "system_u:system_r:container_t:s0",
+			Tcontext:  "system_u:object_r:var_log_t:s0",
+			Tclass:    "file",
+		}, line.info))
+	}
+
+	perms := func(profile string) []string {
+		avcs, err := sut.Avcs(context.Background(), &api.AvcRequest{Profile: profile})
+		require.NoError(t, err)
+
+		res := []string{}
+		for _, avc := range avcs.GetAvc() {
+			res = append(res, avc.GetPerm())
+		}
+
+		return res
+	}
+
+	require.Equal(t, []string{"read"}, perms(profile))
+	require.ElementsMatch(t, []string{"write", "execute"}, perms(DebugProfileKey(profile)))
+}
+
+func TestRecordProfileKeyProcessCache(t *testing.T) {
+	t.Parallel()
+
+	const (
+		profile = "recording_nginx_abcde_1"
+		pid     = 2
+	)
+
+	sut, err := New(logr.Discard(), nil)
+	require.NoError(t, err)
+
+	exited := true
+	execEnv := map[string]string{requestIdEnv: "dbbf5fca-c955-4922-99d2-27a50212071c"}
+	env := execEnv
+
+	mock := &enricherfakes.FakeImpl{}
+	mock.CmdlineForPIDCalls(func(int) (string, error) {
+		if exited {
+			return "", ErrProcessNotFound
+		}
+
+		return "", nil
+	})
+	mock.EnvForPidCalls(func(int) (map[string]string, error) {
+		if exited {
+			return map[string]string{}, ErrProcessNotFound
+		}
+
+		return env, nil
+	})
+	sut.impl = mock
+
+	info := &types.ContainerInfo{RecordProfile: profile}
+	key := func(executable string) string {
+		return sut.recordProfileKey(&types.AuditLine{ProcessID: pid, Executable: executable}, info)
+	}
+
+	// Exited processes fall back to the container profile and are not cached
+	require.Equal(t, profile, key("/bin/ls"))
+	require.Nil(t, sut.processCache.Get(pid))
+
+	exited = false
+
+	require.Equal(t, DebugProfileKey(profile), key("/bin/ls"))
+	require.NotNil(t, sut.processCache.Get(pid))
+
+	// A recycled PID does not inherit the cached exec session
+	env = map[string]string{}
+
+	require.Equal(t, profile, key("/usr/sbin/nginx"))
+	require.Nil(t, sut.processCache.Get(pid).Value().ExecRequestId)
+}
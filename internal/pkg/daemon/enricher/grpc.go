@@ -35,6 +35,9 @@ const (
 	ErrorNoSyscalls = "no syscalls recorded for profile"
 	// ErrorNoAvcs is returned when no AVCs are recorded for a profile.
 	ErrorNoAvcs = "no avcs recorded for profile"
+	// ErrorNoApparmorPaths is returned when no AppArmor paths are recorded
+	// for a profile.
+	ErrorNoApparmorPaths = "no apparmor paths recorded for profile"
 )
 
 // Syscalls returns the syscalls for a provided profile.
@@ -109,3 +112,23 @@ func (e *Enricher) ResetAvcs(
 
 	return &api.EmptyResponse{}, nil
 }
+
+// ApparmorPaths returns the paths accessed by containers running with the
+// provided AppArmor profile.
+func (e *Enricher) ApparmorPaths(
+	_ context.Context, r *api.ApparmorPathsRequest,
+) (*api.ApparmorPathsResponse, error) {
+	paths, ok := e.apparmorPaths.Load(r.GetProfile())
+	if !ok {
+		st := status.New(codes.NotFound, ErrorNoApparmorPaths)
+
+		return nil, st.Err()
+	}
+
+	stringSet, ok := paths.(sets.Set[string])
+	if !ok {
+		return nil, errors.New("apparmor paths are no string set")
+	}
+
+	return &api.ApparmorPathsResponse{Paths: sets.List(stringSet)}, nil
+}
@@ -50,6 +50,13 @@ const (
 		`exe="` + executableNginx + `" sig=0 arch=c000003e syscall=10 compat=0 ` +
 		`ip=0x7f4ce626349b code=0x7ffc0000 AUID="user" UID="root" ` +
 		`GID="root" ARCH=x86_64 SYSCALL=` + executableNginx
+	selinuxLineJsonTest = `type=AVC msg=audit(1666691794.882:1434): avc:  denied  { read write } ` +
+		`for  pid=2060396 comm="aide" path="/hostroot/etc/kubernetes/aide.log.new" dev="nvme0n1p4" ` +
+		`ino=167774224 scontext=system_u:system_r:container_t:s0:c218,c875 ` +
+		`tcontext=system_u:object_r:kubernetes_file_t:s0 tclass=file permissive=1`
+	apparmorLineJsonTest = `audit: type=1400 audit(1668191154.949:64): apparmor="DENIED" operation="exec" ` +
+		`profile="profile-name" name="/usr/local/bin/sample-app" pid=2060396 comm="tini" ` +
+		`requested_mask="x" denied_mask="x" fsuid=65534 ouid=0`
 	containerIDJsonTest      = "218ce99dd8b33f6f9b6565863d7cd47dc880963ddd2cd987bcb2d330c65144bf"
 	cmdLineJsonTest          = "/bin/sh "
 	invalidLineJsonTest      = "this line is not a valid line for the parser"
@@ -310,6 +317,83 @@ func TestJsonRun(t *testing.T) {
 					require.Equal(t, "da83c434-91f0-4696-a04e-75d08b6d80b2", auditMap["requestUID"])
 				},
 			},
+			{ // test selinux and apparmor lines
+				runAsync: true,
+				prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
+					mock.GetenvReturns(nodeJsonTest)
+					mock.LinesReturns(lineChan)
+					mock.ContainerIDForPIDReturns(containerIDJsonTest, nil)
+					mock.CmdlineForPIDReturns(cmdLineJsonTest, nil)
+					mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      podJsonTest,
+							Namespace: namespaceJsonTest,
+						},
+						Status: v1.PodStatus{
+							ContainerStatuses: []v1.ContainerStatus{{
+								ContainerID: crioPrefixJsonTest + containerIDJsonTest,
+							}},
+						},
+					}}}, nil)
+				},
+				assert: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line, err chan error) {
+					for mock.LinesCallCount() != 1 {
+						// Wait for Lines() to be called
+					}
+
+					lineChan <- &tail.Line{
+						Text: selinuxLineJsonTest,
+						Time: time.Now(),
+					}
+
+					lineChan <- &tail.Line{
+						Text: apparmorLineJsonTest,
+						Time: time.Now(),
+					}
+
+					for mock.PrintJsonOutputCallCount() != 2 {
+						// Wait for PrintJsonOutputCallCount() to be called
+					}
+
+					auditMap := make(map[string]any)
+					_, output := mock.PrintJsonOutputArgsForCall(0)
+					errUnmarshal := json.Unmarshal([]byte(output), &auditMap)
+					require.NoError(t, errUnmarshal)
+
+					require.Equal(t, cmdLineJsonTest, auditMap["cmdLine"])
+					require.NotContains(t, auditMap, "syscalls")
+					require.Equal(t, []any{
+						map[string]any{
+							"perm":     "read",
+							"scontext": "system_u:system_r:container_t:s0:c218,c875",
+							"tcontext": "system_u:object_r:kubernetes_file_t:s0",
+							"tclass":   "file",
+						},
+						map[string]any{
+							"perm":     "write",
+							"scontext": "system_u:system_r:container_t:s0:c218,c875",
+							"tcontext": "system_u:object_r:kubernetes_file_t:s0",
+							"tclass":   "file",
+						},
+					}, auditMap["avcs"])
+
+					auditMap = make(map[string]any)
+					_, output = mock.PrintJsonOutputArgsForCall(1)
+					errUnmarshal = json.Unmarshal([]byte(output), &auditMap)
+					require.NoError(t, errUnmarshal)
+
+					require.Equal(t, "tini", auditMap["executable"])
+					require.Equal(t, []any{
+						map[string]any{
+							"apparmor":  "DENIED",
+							"operation": "exec",
+							"profile":   "profile-name",
+							"name":      "/usr/local/bin/sample-app",
+							"extraInfo": "requested_mask='x' denied_mask='x' fsuid=65534 ouid=0",
+						},
+					}, auditMap["apparmor"])
+				},
+			},
 			{ // test invalid
 				runAsync: true,
 				prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
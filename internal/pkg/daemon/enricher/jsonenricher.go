@@ -23,6 +23,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -204,7 +206,7 @@ func (e *JsonEnricher) Run(ctx context.Context, runErr chan<- error) {
 
 			e.logger.V(config.VerboseLevel).Info("Emit audit log for process",
 				"pid", logItem.Key())
-			e.dispatchSeccompLine(auditLogBucket, nodeName)
+			e.dispatchLogBucket(auditLogBucket, nodeName)
 		})
 
 	e.logger.Info(fmt.Sprintf("Setting up caches with expiry of %v", defaultCacheTimeout))
@@ -294,21 +296,17 @@ func (e *JsonEnricher) Run(ctx context.Context, runErr chan<- error) {
 			continue
 		}
 
-		if auditLine.AuditType != types.AuditTypeSeccomp {
-			e.logger.V(config.VerboseLevel).Info("Only seccomp supported")
-
-			continue
-		}
-
 		var logBucket *types.LogBucket
 		if e.logLinesCache.Has(auditLine.ProcessID) {
 			logBucket = e.logLinesCache.Get(auditLine.ProcessID).Value()
 		} else {
 			logBucket = &types.LogBucket{
-				SyscallIds:    sync.Map{},
-				ContainerInfo: nil,
-				ProcessInfo:   nil,
-				TimestampID:   auditLine.TimestampID,
+				SyscallIds:     sync.Map{},
+				Avcs:           sync.Map{},
+				AppArmorEvents: sync.Map{},
+				ContainerInfo:  nil,
+				ProcessInfo:    nil,
+				TimestampID:    auditLine.TimestampID,
 			}
 		}
 
@@ -324,13 +322,18 @@ func (e *JsonEnricher) Run(ctx context.Context, runErr chan<- error) {
 				auditLine.Executable, uid, gid)
 		}
 
+		// SELinux AVC lines do not contain the executable.
+		if logBucket.ProcessInfo != nil && logBucket.ProcessInfo.Executable == "" {
+			logBucket.ProcessInfo.Executable = auditLine.Executable
+		}
+
 		e.processEbpf(logBucket, auditLine)
 
 		if logBucket.ContainerInfo == nil {
 			logBucket.ContainerInfo = e.fetchContainerInfo(ctx, auditLine.ProcessID, nodeName)
 		}
 
-		logBucket.SyscallIds.LoadOrStore(auditLine.SystemCallID, struct{}{})
+		addToLogBucket(logBucket, auditLine)
 
 		if !e.logLinesCache.Has(auditLine.ProcessID) {
 			e.logLinesCache.Set(auditLine.ProcessID, logBucket, ttlcache.DefaultTTL)
@@ -340,6 +343,30 @@ func (e *JsonEnricher) Run(ctx context.Context, runErr chan<- error) {
 	runErr <- fmt.Errorf("enricher failed: %w", e.Reason(tailFile))
 }
 
+func addToLogBucket(logBucket *types.LogBucket, auditLine *types.AuditLine) {
+	switch auditLine.AuditType {
+	case types.AuditTypeSeccomp:
+		logBucket.SyscallIds.LoadOrStore(auditLine.SystemCallID, struct{}{})
+	case types.AuditTypeSelinux:
+		for perm := range strings.FieldsSeq(auditLine.Perm) {
+			logBucket.Avcs.LoadOrStore(types.AvcEvent{
+				Perm:     perm,
+				Scontext: auditLine.Scontext,
+				Tcontext: auditLine.Tcontext,
+				Tclass:   auditLine.Tclass,
+			}, struct{}{})
+		}
+	case types.AuditTypeApparmor:
+		logBucket.AppArmorEvents.LoadOrStore(types.AppArmorEvent{
+			Apparmor:  auditLine.Apparmor,
+			Operation: auditLine.Operation,
+			Profile:   auditLine.Profile,
+			Name:      auditLine.Name,
+			ExtraInfo: auditLine.ExtraInfo,
+		}, struct{}{})
+	}
+}
+
 func (e *JsonEnricher) processEbpf(logBucket *types.LogBucket, auditLine *types.AuditLine) {
 	if e.bpfProcessCache != nil && logBucket.ProcessInfo != nil && logBucket.ProcessInfo.CmdLine == "" {
 		cmdLine, errCmdLine := e.bpfProcessCache.GetCmdLine(auditLine.ProcessID)
@@ -411,9 +438,33 @@ func (e *JsonEnricher) fetchProcessInfo(processId int, executable string, uid, g
 	return processInfo
 }
 
-func (e *JsonEnricher) dispatchSeccompLine(
+func (e *JsonEnricher) dispatchLogBucket(
 	logBucket *types.LogBucket, nodeName string,
 ) {
+	if logBucket.ProcessInfo == nil {
+		e.logger.V(config.VerboseLevel).Info("process info not found")
+
+		return
+	}
+
+	if logBucket.ContainerInfo == nil {
+		e.logger.V(config.VerboseLevel).Info("Container info not found in cache")
+	}
+
+	if syscallNames := e.syscallNames(logBucket); len(syscallNames) > 0 {
+		e.dispatchAuditLine(logBucket, nodeName, "syscalls", syscallNames)
+	}
+
+	if avcs := avcsForLogBucket(logBucket); len(avcs) > 0 {
+		e.dispatchAuditLine(logBucket, nodeName, "avcs", avcs)
+	}
+
+	if apparmorEvents := apparmorEventsForLogBucket(logBucket); len(apparmorEvents) > 0 {
+		e.dispatchAuditLine(logBucket, nodeName, "apparmor", apparmorEvents)
+	}
+}
+
+func (e *JsonEnricher) syscallNames(logBucket *types.LogBucket) []string {
 	var syscallNames []string
 
 	logBucket.SyscallIds.Range(func(k, _ any) bool {
@@ -435,17 +486,80 @@ func (e *JsonEnricher) dispatchSeccompLine(
 		return true
 	})
 
-	var resource map[string]string
+	return syscallNames
+}
 
-	if logBucket.ProcessInfo == nil {
-		e.logger.V(config.VerboseLevel).Info("process info not found")
+func avcsForLogBucket(logBucket *types.LogBucket) []map[string]string {
+	avcs := []map[string]string{}
 
-		return
-	}
+	logBucket.Avcs.Range(func(k, _ any) bool {
+		avc, ok := k.(types.AvcEvent)
+		if !ok {
+			return false
+		}
 
-	if logBucket.ContainerInfo == nil {
-		e.logger.V(config.VerboseLevel).Info("Container info not found in cache")
-	}
+		avcs = append(avcs, map[string]string{
+			"perm":     avc.Perm,
+			"scontext": avc.Scontext,
+			"tcontext": avc.Tcontext,
+			"tclass":   avc.Tclass,
+		})
+
+		return true
+	})
+
+	sortEvents(avcs, "tclass", "perm", "scontext", "tcontext")
+
+	return avcs
+}
+
+func apparmorEventsForLogBucket(logBucket *types.LogBucket) []map[string]string {
+	events := []map[string]string{}
+
+	logBucket.AppArmorEvents.Range(func(k, _ any) bool {
+		event, ok := k.(types.AppArmorEvent)
+		if !ok {
+			return false
+		}
+
+		eventMap := map[string]string{
+			"apparmor":  event.Apparmor,
+			"operation": event.Operation,
+			"profile":   event.Profile,
+			"name":      event.Name,
+		}
+
+		if event.ExtraInfo != "" {
+			eventMap["extraInfo"] = event.ExtraInfo
+		}
+
+		events = append(events, eventMap)
+
+		return true
+	})
+
+	sortEvents(events, "operation", "name", "apparmor", "profile", "extraInfo")
+
+	return events
+}
+
+// sortEvents sorts the events by the provided keys to get a stable output.
+func sortEvents(events []map[string]string, keys ...string) {
+	slices.SortFunc(events, func(a, b map[string]string) int {
+		for _, key := range keys {
+			if c := strings.Compare(a[key], b[key]); c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	})
+}
+
+func (e *JsonEnricher) dispatchAuditLine(
+	logBucket *types.LogBucket, nodeName, eventsKey string, events any,
+) {
+	var resource map[string]string
 
 	if logBucket.ContainerInfo != nil {
 		resource = map[string]string{
@@ -478,7 +592,7 @@ func (e *JsonEnricher) dispatchSeccompLine(
 		"resource":   resource,
 		"pid":        logBucket.ProcessInfo.Pid,
 		"node":       node,
-		"syscalls":   syscallNames,
+		eventsKey:    events,
 		"timestamp":  isoTimestamp,
 	}
 
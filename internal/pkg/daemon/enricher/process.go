@@ -35,6 +35,11 @@ var (
 	// ErrCmdlineNotFound is the error returned by ContainerIDForPID if the
 	// process path could not be found in /proc.
 	ErrCmdlineNotFound = errors.New("cmdline empty or not found for the process")
+
+	// ErrProcessExited is the error returned by GetProcessInfo if neither
+	// the cmdline nor the environment of the process could be read, usually
+	// because it exited already.
+	ErrProcessExited = errors.New("process exited before it could be inspected")
 )
 
 const (
@@ -46,34 +51,40 @@ func GetProcessInfo(
 	processCache *ttlcache.Cache[int, *types.ProcessInfo],
 	impl impl,
 ) (*types.ProcessInfo, error) {
-	// Check the cache first
-	item := processCache.Get(pid)
-	if item != nil {
-		return item.Value(), nil
+	// Check the cache first, a different executable means that the PID got
+	// recycled.
+	if item := processCache.Get(pid); item != nil {
+		if executable == "" || item.Value().Executable == executable {
+			return item.Value(), nil
+		}
+
+		processCache.Delete(pid)
 	}
 
-	var errDetailsFetch error
+	procInfo, procErrors, exited := readProcessInfo(pid, executable, uid, gid, impl)
 
-	if procErrors := populateProcessCache(pid, executable, uid, gid, processCache, impl); len(procErrors) > 0 {
-		errDetailsFetch = fmt.Errorf("get process info for pid: %w", errors.Join(procErrors...))
+	// Do not cache the info of exited processes, their PID may get recycled
+	// before the entry expires.
+	if exited {
+		return procInfo, fmt.Errorf("get process info for pid %d: %w: %w", pid, ErrProcessExited, errors.Join(procErrors...))
 	}
 
-	item = processCache.Get(pid)
-	if item != nil {
-		return item.Value(), errDetailsFetch
+	processCache.Set(pid, procInfo, ttlcache.DefaultTTL)
+
+	if len(procErrors) > 0 {
+		return procInfo, fmt.Errorf("get process info for pid: %w", errors.Join(procErrors...))
 	}
 
-	return nil, errors.New("no process info for Pid")
+	return procInfo, nil
 }
 
-func populateProcessCache(
+// readProcessInfo reads the cmdline and the environment of the process. It
+// returns true if none of them could be read.
+func readProcessInfo(
 	pid int, executable string, uid, gid uint32,
-	processCache *ttlcache.Cache[int, *types.ProcessInfo],
 	impl impl,
-) []error {
-	var errs []error
-
-	procInfo := types.ProcessInfo{
+) (procInfo *types.ProcessInfo, errs []error, exited bool) {
+	procInfo = &types.ProcessInfo{
 		Pid:        pid,
 		Executable: executable,
 		Uid:        uid,
@@ -91,9 +102,12 @@ func populateProcessCache(
 	}
 
 	reqIdEnvFound := false
+	envFound := false
 
 	env, err := impl.EnvForPid(pid)
 	if err == nil {
+		envFound = true
+
 		reqId, ok := env[requestIdEnv]
 		if ok {
 			procInfo.ExecRequestId = &reqId
@@ -116,9 +130,7 @@ func populateProcessCache(
 		}
 	}
 
-	processCache.Set(pid, &procInfo, ttlcache.DefaultTTL)
-
-	return errs
+	return procInfo, errs, !cmdLineFound && !envFound
 }
 
 func extractSPORequestUID(input string) (string, bool) {
@@ -0,0 +1,219 @@
+//go:build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	seccomp "github.com/seccomp/libseccomp-golang"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompagent"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// seccompNotifyAlwaysAllowed is the syscall which cannot be traced by the
+// seccomp notify recorder profile, because it is required by the container
+// runtime after installing the filter.
+const seccompNotifyAlwaysAllowed = "write"
+
+// seccompNotifyResolveBackoff is used to wait for the container to show up in
+// the pod status, which happens only after the runtime connected to us.
+var seccompNotifyResolveBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   1.5,
+	Steps:    10,
+}
+
+// seccompNotifyRecording collects the syscalls of a container reported via
+// seccomp user notifications. The syscalls are kept back until the profile to
+// record is known.
+type seccompNotifyRecording struct {
+	lock     sync.Mutex
+	resolved bool
+	profile  string
+	pending  sets.Set[string]
+}
+
+func newSeccompNotifyRecording() *seccompNotifyRecording {
+	return &seccompNotifyRecording{pending: sets.New[string]()}
+}
+
+func (e *Enricher) startSeccompNotifyListener(nodeName string) error {
+	e.logger.Info("Starting seccomp notify listener", "socket", config.SeccompNotifyRecorderSocket)
+
+	if _, err := e.Stat(config.SeccompNotifyRecorderSocket); err == nil {
+		if err := e.RemoveAll(config.SeccompNotifyRecorderSocket); err != nil {
+			return fmt.Errorf("remove seccomp notify socket file: %w", err)
+		}
+	}
+
+	listener, err := e.Listen("unix", config.SeccompNotifyRecorderSocket)
+	if err != nil {
+		return fmt.Errorf("create seccomp notify listener: %w", err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			if err != nil {
+				e.logger.Error(err, "unable to accept seccomp notify connection")
+
+				continue
+			}
+
+			go e.handleSeccompAgentConn(conn, nodeName)
+		}
+	}()
+
+	return nil
+}
+
+func (e *Enricher) handleSeccompAgentConn(conn net.Conn, nodeName string) {
+	defer conn.Close()
+
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		e.logger.Info("Ignoring non unix seccomp notify connection")
+
+		return
+	}
+
+	state, notifyFd, err := seccompagent.ReceiveContainerProcessState(unixConn)
+	if err != nil {
+		e.logger.Error(err, "unable to receive container process state")
+
+		return
+	}
+	defer unix.Close(notifyFd)
+
+	containerID := state.State.ID
+	e.logger.Info("Tracing container via seccomp notify",
+		"containerID", containerID, "pid", state.Pid)
+
+	rec := newSeccompNotifyRecording()
+
+	go e.resolveSeccompNotifyRecording(rec, nodeName, containerID)
+
+	if err := e.serveSeccompNotifications(rec, notifyFd); err != nil {
+		e.logger.Error(err, "unable to serve seccomp notifications", "containerID", containerID)
+
+		return
+	}
+
+	e.logger.V(config.VerboseLevel).Info("Seccomp notify tracing done", "containerID", containerID)
+}
+
+// serveSeccompNotifications records every notified syscall and lets the
+// kernel continue its execution, until all processes of the filter exited.
+func (e *Enricher) serveSeccompNotifications(rec *seccompNotifyRecording, notifyFd int) error {
+	return seccompagent.ServeNotifications(notifyFd, func(
+		_ seccomp.ScmpFd, req *seccomp.ScmpNotifReq,
+	) *seccomp.ScmpNotifResp {
+		name, err := req.Data.Syscall.GetNameByArch(req.Data.Arch)
+		if err != nil {
+			e.logger.Info("No syscall name found for ID", "syscallID", req.Data.Syscall, "err", err.Error())
+		} else {
+			e.recordSeccompNotifySyscall(rec, name)
+		}
+
+		return &seccomp.ScmpNotifResp{
+			ID:    req.ID,
+			Flags: seccomp.NotifRespFlagContinue,
+		}
+	})
+}
+
+func (e *Enricher) resolveSeccompNotifyRecording(rec *seccompNotifyRecording, nodeName, containerID string) {
+	profile := ""
+
+	if err := util.RetryEx(
+		&seccompNotifyResolveBackoff,
+		func() error {
+			info, err := getContainerInfo(context.Background(),
+				nodeName, containerID, e.clientset, e.impl, e.infoCache, e.logger)
+			if err != nil {
+				return err
+			}
+
+			profile = info.RecordProfile
+
+			return nil
+		},
+		func(error) bool { return true },
+	); err != nil {
+		e.logger.Error(err, "container ID not found in cluster", "containerID", containerID)
+	}
+
+	e.resolveSeccompNotifyProfile(rec, profile)
+}
+
+// resolveSeccompNotifyProfile sets the profile to record for the container
+// and flushes the syscalls collected so far. An empty profile discards them.
+func (e *Enricher) resolveSeccompNotifyProfile(rec *seccompNotifyRecording, profile string) {
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+
+	rec.resolved = true
+	rec.profile = profile
+
+	if profile != "" {
+		e.logger.Info("Recording syscalls via seccomp notify", "profile", profile)
+		rec.pending.Insert(seccompNotifyAlwaysAllowed)
+
+		e.seccompNotifyLock.Lock()
+		for syscall := range rec.pending {
+			insertIntoSet(&e.syscalls, profile, syscall)
+		}
+		e.seccompNotifyLock.Unlock()
+	}
+
+	rec.pending = nil
+}
+
+func (e *Enricher) recordSeccompNotifySyscall(rec *seccompNotifyRecording, syscall string) {
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+
+	if !rec.resolved {
+		rec.pending.Insert(syscall)
+
+		return
+	}
+
+	if rec.profile == "" {
+		return
+	}
+
+	e.seccompNotifyLock.Lock()
+	insertIntoSet(&e.syscalls, rec.profile, syscall)
+	e.seccompNotifyLock.Unlock()
+}
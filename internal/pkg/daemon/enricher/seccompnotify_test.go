@@ -0,0 +1,64 @@
+//go:build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestRecordSeccompNotifySyscall(t *testing.T) {
+	t.Parallel()
+
+	sut := &Enricher{logger: logr.Discard()}
+
+	rec := newSeccompNotifyRecording()
+	sut.recordSeccompNotifySyscall(rec, "read")
+
+	_, ok := sut.syscalls.Load("profile")
+	require.False(t, ok)
+
+	sut.resolveSeccompNotifyProfile(rec, "profile")
+	sut.recordSeccompNotifySyscall(rec, "openat")
+
+	syscalls, ok := sut.syscalls.Load("profile")
+	require.True(t, ok)
+	require.ElementsMatch(t,
+		[]string{"read", "openat", "write"},
+		syscalls.(sets.Set[string]).UnsortedList(),
+	)
+
+	// Syscalls of containers which are not recorded are discarded.
+	other := newSeccompNotifyRecording()
+	sut.recordSeccompNotifySyscall(other, "read")
+	sut.resolveSeccompNotifyProfile(other, "")
+	sut.recordSeccompNotifySyscall(other, "openat")
+
+	count := 0
+
+	sut.syscalls.Range(func(any, any) bool {
+		count++
+
+		return true
+	})
+	require.Equal(t, 1, count)
+}
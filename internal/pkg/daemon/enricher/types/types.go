@@ -32,6 +32,9 @@ type AuditLine struct {
 	// common
 	ProcessID   int
 	TimestampID string
+	// Action is the outcome of the audited operation, for example "denied"
+	// for SELinux and AppArmor or "errno" for seccomp.
+	Action string
 
 	// seccomp
 	SystemCallID int32
@@ -61,6 +64,20 @@ type ContainerInfo struct {
 	Namespace     string
 	ContainerID   string
 	RecordProfile string
+	// Labels are the labels of the pod running the container.
+	Labels map[string]string
+	// SeccompProfile is the name of the installed SeccompProfile the
+	// container runs with.
+	SeccompProfile string
+	// SelinuxProfile is the name of the installed SelinuxProfile the
+	// container runs with.
+	SelinuxProfile string
+	// AppArmorProfile is the name of the installed AppArmorProfile the
+	// container runs with.
+	AppArmorProfile string
+	// Ephemeral is true if the container is an ephemeral container, which
+	// is recorded for the profile of the container it targets.
+	Ephemeral bool
 }
 
 type ProcessInfo struct {
@@ -72,10 +89,31 @@ type ProcessInfo struct {
 	ExecRequestId *string
 }
 type LogBucket struct {
-	SyscallIds    sync.Map
-	ContainerInfo *ContainerInfo
-	ProcessInfo   *ProcessInfo
-	TimestampID   string
+	SyscallIds sync.Map
+	// Avcs contains the distinct SELinux denials as AvcEvent keys.
+	Avcs sync.Map
+	// AppArmorEvents contains the distinct AppArmor events as AppArmorEvent keys.
+	AppArmorEvents sync.Map
+	ContainerInfo  *ContainerInfo
+	ProcessInfo    *ProcessInfo
+	TimestampID    string
+}
+
+// AvcEvent is a single SELinux permission denial of a process.
+type AvcEvent struct {
+	Perm     string
+	Scontext string
+	Tcontext string
+	Tclass   string
+}
+
+// AppArmorEvent is a single AppArmor audit or deny event of a process.
+type AppArmorEvent struct {
+	Apparmor  string
+	Operation string
+	Profile   string
+	Name      string
+	ExtraInfo string
 }
 
 type EnricherLogLevel string
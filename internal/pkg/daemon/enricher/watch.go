@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// watcherBufferSize is the amount of events buffered per watcher before
+// events get dropped for slow consumers.
+const watcherBufferSize = 256
+
+// eventWatcher is a single WatchEvents subscriber.
+type eventWatcher struct {
+	namespace  string
+	selector   labels.Selector
+	auditTypes sets.Set[string]
+	actions    sets.Set[string]
+	events     chan *api.AuditEvent
+}
+
+// eventWatchers is the registry of all active WatchEvents subscribers.
+type eventWatchers struct {
+	sync.RWMutex
+	watchers map[*eventWatcher]struct{}
+}
+
+func newEventWatcher(r *api.WatchEventsRequest) (*eventWatcher, error) {
+	selector, err := labels.Parse(r.GetLabelSelector())
+	if err != nil {
+		return nil, fmt.Errorf("parse label selector: %w", err)
+	}
+
+	return &eventWatcher{
+		namespace:  r.GetNamespace(),
+		selector:   selector,
+		auditTypes: sets.New(r.GetAuditTypes()...),
+		actions:    sets.New(r.GetActions()...),
+		events:     make(chan *api.AuditEvent, watcherBufferSize),
+	}, nil
+}
+
+// matches returns true if the audit line of the container should be sent to
+// the watcher. Empty filters match everything.
+func (w *eventWatcher) matches(auditLine *types.AuditLine, info *types.ContainerInfo) bool {
+	if w.namespace != "" && w.namespace != info.Namespace {
+		return false
+	}
+
+	if w.auditTypes.Len() > 0 && !w.auditTypes.Has(auditLine.AuditType) {
+		return false
+	}
+
+	if w.actions.Len() > 0 && !w.actions.Has(auditLine.Action) {
+		return false
+	}
+
+	return w.selector.Matches(labels.Set(info.Labels))
+}
+
+// WatchEvents streams the enriched audit events matching the request until
+// the client disconnects.
+func (e *Enricher) WatchEvents(
+	r *api.WatchEventsRequest, stream api.Enricher_WatchEventsServer,
+) error {
+	watcher, err := newEventWatcher(r)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	e.addWatcher(watcher)
+	defer e.removeWatcher(watcher)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case event := <-watcher.events:
+			if err := stream.Send(event); err != nil {
+				return fmt.Errorf("send audit event: %w", err)
+			}
+		}
+	}
+}
+
+func (e *Enricher) addWatcher(watcher *eventWatcher) {
+	e.watchers.Lock()
+	defer e.watchers.Unlock()
+
+	if e.watchers.watchers == nil {
+		e.watchers.watchers = map[*eventWatcher]struct{}{}
+	}
+
+	e.watchers.watchers[watcher] = struct{}{}
+}
+
+func (e *Enricher) removeWatcher(watcher *eventWatcher) {
+	e.watchers.Lock()
+	defer e.watchers.Unlock()
+
+	delete(e.watchers.watchers, watcher)
+}
+
+// publishEvent sends the audit line to all matching watchers. Events are
+// dropped for watchers which do not keep up, to never block the enricher.
+func (e *Enricher) publishEvent(
+	nodeName string, auditLine *types.AuditLine, info *types.ContainerInfo,
+) {
+	e.watchers.RLock()
+	defer e.watchers.RUnlock()
+
+	var event *api.AuditEvent
+
+	for watcher := range e.watchers.watchers {
+		if !watcher.matches(auditLine, info) {
+			continue
+		}
+
+		if event == nil {
+			event = e.auditEvent(nodeName, auditLine, info)
+		}
+
+		select {
+		case watcher.events <- event:
+		default:
+			e.logger.V(config.VerboseLevel).Info(
+				"Dropping audit event for slow watcher",
+				"timestamp", auditLine.TimestampID,
+			)
+		}
+	}
+}
+
+func (e *Enricher) auditEvent(
+	nodeName string, auditLine *types.AuditLine, info *types.ContainerInfo,
+) *api.AuditEvent {
+	event := &api.AuditEvent{
+		Timestamp: auditLine.TimestampID,
+		Node:      nodeName,
+		Namespace: info.Namespace,
+		Pod:       info.PodName,
+		Container: info.ContainerName,
+		AuditType: auditLine.AuditType,
+		Action:    auditLine.Action,
+		Process: &api.AuditEvent_Process{
+			Pid:        int32(auditLine.ProcessID), //nolint:gosec // pids fit into int32
+			Executable: auditLine.Executable,
+		},
+	}
+
+	// The process may be already gone, which makes the command line best
+	// effort only.
+	if cmdLine, err := e.CmdlineForPID(auditLine.ProcessID); err == nil {
+		event.Process.CmdLine = cmdLine
+	}
+
+	switch auditLine.AuditType {
+	case types.AuditTypeSeccomp:
+		event.Seccomp = &api.AuditEvent_Seccomp{
+			SyscallId: auditLine.SystemCallID,
+		}
+
+		if name, err := syscallName(auditLine.SystemCallID); err == nil {
+			event.Seccomp.SyscallName = name
+		}
+
+	case types.AuditTypeSelinux:
+		event.Selinux = &api.AuditEvent_Selinux{
+			Perm:     auditLine.Perm,
+			Scontext: auditLine.Scontext,
+			Tcontext: auditLine.Tcontext,
+			Tclass:   auditLine.Tclass,
+		}
+
+	case types.AuditTypeApparmor:
+		event.Apparmor = &api.AuditEvent_Apparmor{
+			Apparmor:  auditLine.Apparmor,
+			Operation: auditLine.Operation,
+			Profile:   auditLine.Profile,
+			Name:      auditLine.Name,
+			ExtraInfo: auditLine.ExtraInfo,
+		}
+	}
+
+	return event
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+type fakeWatchEventsServer struct {
+	grpc.ServerStream
+	ctx    context.Context //nolint:containedctx // required by the stream
+	events chan *api.AuditEvent
+}
+
+func (f *fakeWatchEventsServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchEventsServer) Send(event *api.AuditEvent) error {
+	f.events <- event
+
+	return nil
+}
+
+func TestEventWatcherMatches(t *testing.T) {
+	t.Parallel()
+
+	info := &types.ContainerInfo{
+		Namespace: namespace,
+		PodName:   pod,
+		Labels:    map[string]string{"app": "nginx", "tier": "web"},
+	}
+	auditLine := &types.AuditLine{
+		AuditType: types.AuditTypeApparmor,
+		Action:    "denied",
+	}
+
+	for _, tc := range []struct {
+		request *api.WatchEventsRequest
+		matches bool
+	}{
+		{ // empty filter
+			request: &api.WatchEventsRequest{},
+			matches: true,
+		},
+		{ // matching namespace
+			request: &api.WatchEventsRequest{Namespace: namespace},
+			matches: true,
+		},
+		{ // other namespace
+			request: &api.WatchEventsRequest{Namespace: "other"},
+			matches: false,
+		},
+		{ // matching label selector
+			request: &api.WatchEventsRequest{LabelSelector: "app=nginx,tier in (web,db)"},
+			matches: true,
+		},
+		{ // not matching label selector
+			request: &api.WatchEventsRequest{LabelSelector: "app!=nginx"},
+			matches: false,
+		},
+		{ // matching audit type
+			request: &api.WatchEventsRequest{AuditTypes: []string{
+				types.AuditTypeSeccomp, types.AuditTypeApparmor,
+			}},
+			matches: true,
+		},
+		{ // other audit type
+			request: &api.WatchEventsRequest{AuditTypes: []string{types.AuditTypeSelinux}},
+			matches: false,
+		},
+		{ // matching action
+			request: &api.WatchEventsRequest{Actions: []string{"denied"}},
+			matches: true,
+		},
+		{ // other action
+			request: &api.WatchEventsRequest{Actions: []string{"allowed"}},
+			matches: false,
+		},
+	} {
+		watcher, err := newEventWatcher(tc.request)
+		require.NoError(t, err)
+		require.Equal(t, tc.matches, watcher.matches(auditLine, info))
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	t.Parallel()
+
+	mock := &enricherfakes.FakeImpl{}
+	mock.CmdlineForPIDReturns("nginx -g daemon off;", nil)
+
+	sut, err := New(logr.Discard(), nil)
+	require.NoError(t, err)
+
+	sut.impl = mock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchEventsServer{ctx: ctx, events: make(chan *api.AuditEvent)}
+
+	errChan := make(chan error)
+
+	go func() {
+		errChan <- sut.WatchEvents(&api.WatchEventsRequest{
+			AuditTypes: []string{types.AuditTypeSeccomp},
+		}, stream)
+	}()
+
+	for {
+		sut.watchers.RLock()
+		registered := len(sut.watchers.watchers)
+		sut.watchers.RUnlock()
+
+		if registered == 1 {
+			break
+		}
+	}
+
+	info := &types.ContainerInfo{
+		Namespace:     namespace,
+		PodName:       pod,
+		ContainerName: "nginx",
+	}
+
+	sut.publishEvent(node, &types.AuditLine{
+		AuditType: types.AuditTypeSelinux,
+		ProcessID: 42,
+	}, info)
+	sut.publishEvent(node, &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		TimestampID:  "1612299677.115:549067",
+		ProcessID:    42,
+		Executable:   executable,
+		SystemCallID: 10,
+		Action:       "errno",
+	}, info)
+
+	event := <-stream.events
+	require.Equal(t, "1612299677.115:549067", event.GetTimestamp())
+	require.Equal(t, node, event.GetNode())
+	require.Equal(t, namespace, event.GetNamespace())
+	require.Equal(t, pod, event.GetPod())
+	require.Equal(t, "nginx", event.GetContainer())
+	require.Equal(t, types.AuditTypeSeccomp, event.GetAuditType())
+	require.Equal(t, "errno", event.GetAction())
+	require.EqualValues(t, 42, event.GetProcess().GetPid())
+	require.Equal(t, executable, event.GetProcess().GetExecutable())
+	require.Equal(t, "nginx -g daemon off;", event.GetProcess().GetCmdLine())
+	require.Equal(t, syscall, event.GetSeccomp().GetSyscallName())
+	require.Nil(t, event.GetSelinux())
+
+	cancel()
+	require.NoError(t, <-errChan)
+
+	sut.watchers.RLock()
+	require.Empty(t, sut.watchers.watchers)
+	sut.watchers.RUnlock()
+}
+
+func TestWatchEventsInvalidSelector(t *testing.T) {
+	t.Parallel()
+
+	sut, err := New(logr.Discard(), nil)
+	require.NoError(t, err)
+
+	err = sut.WatchEvents(&api.WatchEventsRequest{LabelSelector: "app in ("},
+		&fakeWatchEventsServer{ctx: context.Background()})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -144,3 +144,24 @@ func (m *Metrics) BpfInc(stream api.Metrics_BpfIncServer) error {
 		)
 	}
 }
+
+// SeccompNotifyInc updates the metrics for the seccomp notify counter.
+func (m *Metrics) SeccompNotifyInc(stream api.Metrics_SeccompNotifyIncServer) error {
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&api.EmptyResponse{})
+		}
+
+		if err != nil {
+			return fmt.Errorf("record seccomp notify metrics: %w", err)
+		}
+
+		m.IncSeccompProfileNotify(
+			r.GetNode(),
+			r.GetProfile(),
+			r.GetSyscall(),
+			r.GetAction(),
+		)
+	}
+}
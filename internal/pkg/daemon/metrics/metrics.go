@@ -44,10 +44,12 @@ const (
 	metricNameSelinuxProfileAudit   = "selinux_profile_audit_total"
 	metricNameAppArmorProfileAudit  = "apparmor_profile_audit_total"
 	metricNameSeccompProfileBpf     = "seccomp_profile_bpf_total"
+	metricNameSeccompProfileNotify  = "seccomp_profile_notify_total"
 	metricNameSeccompProfileError   = "seccomp_profile_error_total"
 	metricNameSelinuxProfileError   = "selinux_profile_error_total"
 	metricNameAppArmorProfileError  = "apparmor_profile_error_total"
 	metricNameAppArmorProfileDenial = "apparmor_profile_denial_total"
+	metricNameProfileDrift          = "profile_drift_total"
 
 	// Metrics label values.
 	metricLabelValueProfileUpdate = "update"
@@ -67,6 +69,8 @@ const (
 	metricsLabelTcontext       = "tcontext"
 	metricsLabelMountNamespace = "mount_namespace"
 	metricsLabelApparmor       = "apparmor"
+	metricsLabelKind           = "kind"
+	metricsLabelAction         = "action"
 
 	// HandlerPath is the default path for serving metrics.
 	HandlerPath = "/metrics-spod"
@@ -83,6 +87,7 @@ type Metrics struct {
 	metricSeccompProfile        *prometheus.CounterVec
 	metricSeccompProfileAudit   *prometheus.CounterVec
 	metricSeccompProfileBpf     *prometheus.CounterVec
+	metricSeccompProfileNotify  *prometheus.CounterVec
 	metricSeccompProfileError   *prometheus.CounterVec
 	metricSelinuxProfile        *prometheus.CounterVec
 	metricSelinuxProfileAudit   *prometheus.CounterVec
@@ -91,6 +96,7 @@ type Metrics struct {
 	metricAppArmorProfileAudit  *prometheus.CounterVec
 	metricAppArmorProfileError  *prometheus.CounterVec
 	metricAppArmorProfileDenial *prometheus.CounterVec
+	metricProfileDrift          *prometheus.CounterVec
 }
 
 // New returns a new Metrics instance.
@@ -132,6 +138,20 @@ func New() *Metrics {
 				metricsLabelProfile,
 			},
 		),
+		metricSeccompProfileNotify: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      metricNameSeccompProfileNotify,
+				Namespace: metricNamespace,
+				Help: "Counter about decisions of the seccomp agent on notified syscalls, " +
+					"requires the seccomp agent to be enabled.",
+			},
+			[]string{
+				metricsLabelNode,
+				metricsLabelProfile,
+				metricsLabelSyscall,
+				metricsLabelAction,
+			},
+		),
 		metricSeccompProfileError: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name:      metricNameSeccompProfileError,
@@ -217,6 +237,19 @@ func New() *Metrics {
 				metricLabelOperation,
 			},
 		),
+		metricProfileDrift: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      metricNameProfileDrift,
+				Namespace: metricNamespace,
+				Help: "Counter about operations observed on workloads which are not allowed by " +
+					"their profile, requires drift detection to be enabled.",
+			},
+			[]string{
+				metricsLabelNode,
+				metricsLabelKind,
+				metricsLabelProfile,
+			},
+		),
 	}
 }
 
@@ -226,6 +259,7 @@ func (m *Metrics) Register() error {
 		metricNameSeccompProfile:        m.metricSeccompProfile,
 		metricNameSeccompProfileAudit:   m.metricSeccompProfileAudit,
 		metricNameSeccompProfileBpf:     m.metricSeccompProfileBpf,
+		metricNameSeccompProfileNotify:  m.metricSeccompProfileNotify,
 		metricNameSeccompProfileError:   m.metricSeccompProfileError,
 		metricNameSelinuxProfile:        m.metricSelinuxProfile,
 		metricNameSelinuxProfileAudit:   m.metricSelinuxProfileAudit,
@@ -234,6 +268,7 @@ func (m *Metrics) Register() error {
 		metricNameAppArmorProfileAudit:  m.metricAppArmorProfileAudit,
 		metricNameAppArmorProfileError:  m.metricAppArmorProfileError,
 		metricNameAppArmorProfileDenial: m.metricAppArmorProfileDenial,
+		metricNameProfileDrift:          m.metricProfileDrift,
 	} {
 		m.log.Info("Registering metric: " + name)
 
@@ -285,6 +320,16 @@ func (m *Metrics) IncSeccompProfileBpf(
 	).Inc()
 }
 
+// IncSeccompProfileNotify increments the seccomp profile notify counter for
+// the provided labels.
+func (m *Metrics) IncSeccompProfileNotify(
+	node, profile, syscall, action string,
+) {
+	m.metricSeccompProfileNotify.WithLabelValues(
+		node, profile, syscall, action,
+	).Inc()
+}
+
 // IncSeccompProfileError increments the seccomp profile error counter for the
 // provided reason.
 func (m *Metrics) IncSeccompProfileError(reason string) {
@@ -356,3 +401,9 @@ func (m *Metrics) IncAppArmorProfileError(profile, reason string) {
 func (m *Metrics) IncAppArmorProfileDenial(profile, operation string) {
 	m.metricAppArmorProfileDenial.WithLabelValues(profile, operation).Inc()
 }
+
+// AddProfileDrift increases the profile drift counter by the amount of newly
+// observed denials for the provided profile.
+func (m *Metrics) AddProfileDrift(node, kind, profile string, denials int) {
+	m.metricProfileDrift.WithLabelValues(node, kind, profile).Add(float64(denials))
+}
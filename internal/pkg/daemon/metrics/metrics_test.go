@@ -226,3 +226,44 @@ func TestSeccompProfileBpf(t *testing.T) {
 		tc.then(sut)
 	}
 }
+
+func TestSeccompProfileNotify(t *testing.T) {
+	t.Parallel()
+
+	const (
+		node    = "node"
+		profile = "profile"
+		syscall = "mount"
+	)
+
+	getMetricValue := func(col prometheus.Collector) int {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.NoError(t, err)
+
+		return int(m.GetCounter().GetValue())
+	}
+
+	mock := &metricsfakes.FakeImpl{}
+	sut := New()
+	sut.impl = mock
+
+	sut.IncSeccompProfileNotify(node, profile, syscall, "Allow")
+	sut.IncSeccompProfileNotify(node, profile, syscall, "Deny")
+	sut.IncSeccompProfileNotify(node, profile, syscall, "Deny")
+
+	ctrAllow, err := sut.metricSeccompProfileNotify.GetMetricWithLabelValues(
+		node, profile, syscall, "Allow",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, getMetricValue(ctrAllow))
+
+	ctrDeny, err := sut.metricSeccompProfileNotify.GetMetricWithLabelValues(
+		node, profile, syscall, "Deny",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, getMetricValue(ctrDeny))
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profiledrift
+
+import (
+	"context"
+	"os"
+
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	ManagerGetClient(manager.Manager) client.Client
+	ManagerGetScheme(manager.Manager) *runtime.Scheme
+	ManagerGetEventRecorderFor(manager.Manager, string) record.EventRecorder
+	ManagerAdd(manager.Manager, manager.Runnable) error
+	ListProfiles(context.Context, client.Client, client.ObjectList) error
+	UpdateStatus(context.Context, client.Client, client.Object) error
+	ReadFile(string) ([]byte, error)
+	DialEnricher() (*grpc.ClientConn, error)
+	Syscalls(
+		context.Context, enricherapi.EnricherClient, *enricherapi.SyscallsRequest,
+	) (*enricherapi.SyscallsResponse, error)
+	Avcs(
+		context.Context, enricherapi.EnricherClient, *enricherapi.AvcRequest,
+	) (*enricherapi.AvcResponse, error)
+	ApparmorPaths(
+		context.Context, enricherapi.EnricherClient, *enricherapi.ApparmorPathsRequest,
+	) (*enricherapi.ApparmorPathsResponse, error)
+}
+
+func (*defaultImpl) ManagerGetClient(m manager.Manager) client.Client {
+	return m.GetClient()
+}
+
+func (*defaultImpl) ManagerGetScheme(m manager.Manager) *runtime.Scheme {
+	return m.GetScheme()
+}
+
+func (*defaultImpl) ManagerGetEventRecorderFor(
+	m manager.Manager, name string,
+) record.EventRecorder {
+	return m.GetEventRecorderFor(name) //nolint:staticcheck,nolintlint // TODO: migrate to GetEventRecorder
+}
+
+func (*defaultImpl) ManagerAdd(m manager.Manager, r manager.Runnable) error {
+	return m.Add(r)
+}
+
+func (*defaultImpl) ListProfiles(
+	ctx context.Context, c client.Client, list client.ObjectList,
+) error {
+	return c.List(ctx, list)
+}
+
+func (*defaultImpl) UpdateStatus(
+	ctx context.Context, c client.Client, obj client.Object,
+) error {
+	return c.Status().Update(ctx, obj)
+}
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
+func (*defaultImpl) DialEnricher() (*grpc.ClientConn, error) {
+	return enricher.Dial()
+}
+
+func (*defaultImpl) Syscalls(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.SyscallsRequest,
+) (*enricherapi.SyscallsResponse, error) {
+	return c.Syscalls(ctx, in)
+}
+
+func (*defaultImpl) Avcs(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.AvcRequest,
+) (*enricherapi.AvcResponse, error) {
+	return c.Avcs(ctx, in)
+}
+
+func (*defaultImpl) ApparmorPaths(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.ApparmorPathsRequest,
+) (*enricherapi.ApparmorPathsResponse, error) {
+	return c.ApparmorPaths(ctx, in)
+}
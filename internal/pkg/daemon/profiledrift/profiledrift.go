@@ -0,0 +1,483 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profiledrift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebasev1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+)
+
+const (
+	// defaultInterval is the time between two drift detection runs.
+	defaultInterval = time.Minute
+
+	// detectionTimeout is the maximum duration of a single detection run.
+	detectionTimeout = 30 * time.Second
+
+	reasonProfileDrift string = "ProfileDrift"
+
+	kindSeccomp  = "SeccompProfile"
+	kindSelinux  = "SelinuxProfile"
+	kindAppArmor = "AppArmorProfile"
+
+	seContextRequiredParts = 3
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &DriftDetector{
+		impl:     &defaultImpl{},
+		interval: defaultInterval,
+	}
+}
+
+// DriftDetector periodically compares the behavior of workloads observed by
+// the log enricher against the installed profiles of the local node.
+type DriftDetector struct {
+	impl
+	client   client.Client
+	log      logr.Logger
+	record   record.EventRecorder
+	metrics  *metrics.Metrics
+	nodeName string
+	interval time.Duration
+	kinds    sets.Set[string]
+}
+
+// Name returns the name of the controller.
+func (r *DriftDetector) Name() string {
+	return "drift-detector"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *DriftDetector) SchemeBuilder() *scheme.Builder {
+	return nil
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *DriftDetector) Healthz(*http.Request) error {
+	return nil
+}
+
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status;selinuxprofiles/status;apparmorprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Setup is the initialization of the controller.
+func (r *DriftDetector) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	met *metrics.Metrics,
+) error {
+	const name = "profiledrift"
+
+	r.log = ctrl.Log.WithName(r.Name())
+	r.client = r.ManagerGetClient(mgr)
+	r.record = r.ManagerGetEventRecorderFor(mgr, name)
+	r.metrics = met
+	r.nodeName = os.Getenv(config.NodeNameEnvKey)
+
+	// Only observe the profile kinds for which the daemon runs a controller.
+	r.kinds = sets.New[string]()
+	s := r.ManagerGetScheme(mgr)
+
+	for _, kind := range []string{kindSeccomp, kindSelinux, kindAppArmor} {
+		if s.Recognizes(seccompprofileapi.GroupVersion.WithKind(kind)) {
+			r.kinds.Insert(kind)
+		}
+	}
+
+	r.log.Info("Setting up drift detection", "kinds", sets.List(r.kinds))
+
+	return r.ManagerAdd(mgr, manager.RunnableFunc(r.run))
+}
+
+// run executes the drift detection periodically until the context is done.
+func (r *DriftDetector) run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			if err := r.detect(ctx); err != nil {
+				r.log.Error(err, "Unable to detect profile drift")
+			}
+		}
+	}
+}
+
+// detect runs a single drift detection for all profiles.
+func (r *DriftDetector) detect(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, detectionTimeout)
+	defer cancel()
+
+	conn, err := r.DialEnricher()
+	if err != nil {
+		return fmt.Errorf("connect to enricher: %w", err)
+	}
+	defer conn.Close()
+
+	enricherClient := enricherapi.NewEnricherClient(conn)
+
+	profiles, err := r.listProfiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, profile := range profiles {
+		kind, denials, err := r.denials(ctx, enricherClient, profile)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("get denials for %s: %w", profile.GetName(), err))
+
+			continue
+		}
+
+		if err := r.updateDrift(ctx, profile, kind, denials); err != nil {
+			errs = append(errs, fmt.Errorf("update drift for %s: %w", profile.GetName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *DriftDetector) listProfiles(ctx context.Context) ([]profilebasev1.StatusBaseUser, error) {
+	profiles := []profilebasev1.StatusBaseUser{}
+
+	if r.kinds.Has(kindSeccomp) {
+		list := &seccompprofileapi.SeccompProfileList{}
+		if err := r.ListProfiles(ctx, r.client, list); err != nil {
+			return nil, fmt.Errorf("list seccomp profiles: %w", err)
+		}
+
+		for i := range list.Items {
+			profiles = append(profiles, &list.Items[i])
+		}
+	}
+
+	if r.kinds.Has(kindSelinux) {
+		list := &selinuxprofileapi.SelinuxProfileList{}
+		if err := r.ListProfiles(ctx, r.client, list); err != nil {
+			return nil, fmt.Errorf("list selinux profiles: %w", err)
+		}
+
+		for i := range list.Items {
+			profiles = append(profiles, &list.Items[i])
+		}
+	}
+
+	if r.kinds.Has(kindAppArmor) {
+		list := &apparmorprofileapi.AppArmorProfileList{}
+		if err := r.ListProfiles(ctx, r.client, list); err != nil {
+			return nil, fmt.Errorf("list apparmor profiles: %w", err)
+		}
+
+		for i := range list.Items {
+			profiles = append(profiles, &list.Items[i])
+		}
+	}
+
+	return profiles, nil
+}
+
+// denials returns the kind of the profile and the observed operations which
+// are not allowed by it.
+func (r *DriftDetector) denials(
+	ctx context.Context,
+	enricherClient enricherapi.EnricherClient,
+	profile profilebasev1.StatusBaseUser,
+) (kind string, denials []string, err error) {
+	switch p := profile.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		denials, err = r.seccompDenials(ctx, enricherClient, p)
+
+		return kindSeccomp, denials, err
+
+	case *selinuxprofileapi.SelinuxProfile:
+		denials, err = r.selinuxDenials(ctx, enricherClient, p)
+
+		return kindSelinux, denials, err
+
+	case *apparmorprofileapi.AppArmorProfile:
+		denials, err = r.apparmorDenials(ctx, enricherClient, p)
+
+		return kindAppArmor, denials, err
+	}
+
+	return "", nil, fmt.Errorf("unsupported profile type %T", profile)
+}
+
+// seccompDenials returns the observed syscalls which are not allowed by the
+// profile installed on the node, including its base profiles.
+func (r *DriftDetector) seccompDenials(
+	ctx context.Context,
+	enricherClient enricherapi.EnricherClient,
+	profile *seccompprofileapi.SeccompProfile,
+) ([]string, error) {
+	response, err := r.Syscalls(ctx, enricherClient, &enricherapi.SyscallsRequest{
+		Profile: enricher.DriftProfileKey(profile.GetName()),
+	})
+	if isNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("retrieve syscalls: %w", err)
+	}
+
+	content, err := r.ReadFile(profile.GetProfilePath())
+	if errors.Is(err, os.ErrNotExist) {
+		// The profile is not installed on this node.
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read installed profile: %w", err)
+	}
+
+	installed := &seccompprofileapi.SeccompProfileSpec{}
+	if err := json.Unmarshal(content, installed); err != nil {
+		return nil, fmt.Errorf("unmarshal installed profile: %w", err)
+	}
+
+	allowed := sets.New[string]()
+
+	for i := range installed.Syscalls {
+		if action := installed.Syscalls[i].Action; action == seccompprofileapi.ActAllow ||
+			action == seccompprofileapi.ActLog {
+			allowed.Insert(installed.Syscalls[i].Names...)
+		}
+	}
+
+	return sets.List(sets.New(response.GetSyscalls()...).Difference(allowed)), nil
+}
+
+// selinuxDenials returns the observed AVCs which are not allowed by the
+// profile in the format "<label> <class> <permission>".
+func (r *DriftDetector) selinuxDenials(
+	ctx context.Context,
+	enricherClient enricherapi.EnricherClient,
+	profile *selinuxprofileapi.SelinuxProfile,
+) ([]string, error) {
+	response, err := r.Avcs(ctx, enricherClient, &enricherapi.AvcRequest{
+		Profile: enricher.DriftProfileKey(profile.GetName()),
+	})
+	if isNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("retrieve avcs: %w", err)
+	}
+
+	denials := sets.New[string]()
+
+	for _, avc := range response.GetAvc() {
+		contextParts := strings.Split(avc.GetTcontext(), ":")
+		if len(contextParts) < seContextRequiredParts {
+			r.log.Info("Skipping AVC with invalid target context", "tcontext", avc.GetTcontext())
+
+			continue
+		}
+
+		label := selinuxprofileapi.LabelKey(contextParts[2])
+		if string(label) == profile.GetPolicyUsage() {
+			label = selinuxprofileapi.AllowSelf
+		}
+
+		class := selinuxprofileapi.ObjectClassKey(avc.GetTclass())
+		if sets.New(profile.Spec.Allow[label][class]...).Has(avc.GetPerm()) {
+			continue
+		}
+
+		denials.Insert(fmt.Sprintf("%s %s %s", label, class, avc.GetPerm()))
+	}
+
+	return sets.List(denials), nil
+}
+
+// apparmorDenials returns the observed file system paths which are not
+// covered by the profile.
+func (r *DriftDetector) apparmorDenials(
+	ctx context.Context,
+	enricherClient enricherapi.EnricherClient,
+	profile *apparmorprofileapi.AppArmorProfile,
+) ([]string, error) {
+	response, err := r.ApparmorPaths(ctx, enricherClient, &enricherapi.ApparmorPathsRequest{
+		Profile: enricher.DriftProfileKey(profile.GetName()),
+	})
+	if isNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("retrieve apparmor paths: %w", err)
+	}
+
+	patterns := []string{}
+	if executable := profile.Spec.Abstract.Executable; executable != nil {
+		patterns = append(patterns, executable.AllowedExecutables...)
+		patterns = append(patterns, executable.AllowedLibraries...)
+
+		for i := range executable.Transitions {
+			patterns = append(patterns, executable.Transitions[i].Path)
+		}
+	}
+
+	if filesystem := profile.Spec.Abstract.Filesystem; filesystem != nil {
+		patterns = append(patterns, filesystem.ReadOnlyPaths...)
+		patterns = append(patterns, filesystem.WriteOnlyPaths...)
+		patterns = append(patterns, filesystem.ReadWritePaths...)
+
+		for i := range filesystem.Rules {
+			if !filesystem.Rules[i].Deny {
+				patterns = append(patterns, filesystem.Rules[i].Path)
+			}
+		}
+	}
+
+	denials := []string{}
+
+	for _, path := range response.GetPaths() {
+		allowed := false
+
+		for _, pattern := range patterns {
+			if apparmorGlobMatch(pattern, path) {
+				allowed = true
+
+				break
+			}
+		}
+
+		if !allowed {
+			denials = append(denials, path)
+		}
+	}
+
+	return denials, nil
+}
+
+// apparmorGlobMatch returns true if the path matches the AppArmor glob
+// pattern, where "**" matches any characters and "*" any characters except
+// "/".
+func apparmorGlobMatch(pattern, path string) bool {
+	var expr strings.Builder
+
+	expr.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		}
+	}
+
+	expr.WriteString("$")
+
+	matched, err := regexp.MatchString(expr.String(), path)
+
+	return err == nil && matched
+}
+
+// updateDrift adds the newly observed denials to the profile status, records
+// an event and updates the metrics.
+func (r *DriftDetector) updateDrift(
+	ctx context.Context,
+	profile profilebasev1.StatusBaseUser,
+	kind string,
+	denials []string,
+) error {
+	if len(denials) == 0 {
+		return nil
+	}
+
+	status := profile.GetStatusBase()
+
+	drift := status.Drift.DeepCopy()
+	if drift == nil || drift.ObservedGeneration != profile.GetGeneration() {
+		// The profile changed, which means that previous denials may be
+		// allowed now.
+		drift = &profilebasev1.ProfileDrift{ObservedGeneration: profile.GetGeneration()}
+	}
+
+	existing := sets.New(drift.Denials...)
+	newDenials := sets.New(denials...).Difference(existing)
+
+	if newDenials.Len() == 0 {
+		return nil
+	}
+
+	drift.Denials = sets.List(existing.Union(newDenials))
+	drift.Nodes = sets.List(sets.New(drift.Nodes...).Insert(r.nodeName))
+	drift.LastObservedTime = metav1.Now()
+	status.Drift = drift
+
+	if err := r.UpdateStatus(ctx, r.client, profile); err != nil {
+		return fmt.Errorf("update profile status: %w", err)
+	}
+
+	r.log.Info(
+		"Detected profile drift",
+		"kind", kind, "profile", profile.GetName(), "denials", sets.List(newDenials),
+	)
+	r.record.Eventf(
+		profile, corev1.EventTypeWarning, reasonProfileDrift,
+		"Observed %d operations on node %s which are not allowed by the profile: %s",
+		newDenials.Len(), r.nodeName, strings.Join(sets.List(newDenials), ", "),
+	)
+
+	if r.metrics != nil {
+		r.metrics.AddProfileDrift(r.nodeName, kind, profile.GetName(), newDenials.Len())
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return err != nil && grpcstatus.Code(err) == grpccodes.NotFound
+}
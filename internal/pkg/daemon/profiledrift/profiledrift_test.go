@@ -0,0 +1,378 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profiledrift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebasev1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profiledrift/profiledriftfakes"
+)
+
+const (
+	testNode    = "test-node"
+	testProfile = "test-profile"
+)
+
+var errTest = errors.New("test")
+
+func installedSeccompProfile(t *testing.T) []byte {
+	t.Helper()
+
+	content, err := json.Marshal(&seccompprofileapi.SeccompProfileSpec{
+		DefaultAction: seccompprofileapi.ActErrno,
+		Syscalls: []seccompprofileapi.Syscall{
+			{Action: seccompprofileapi.ActAllow, Names: []string{"read", "write"}},
+			{Action: seccompprofileapi.ActErrno, Names: []string{"mount"}},
+		},
+	})
+	require.NoError(t, err)
+
+	return content
+}
+
+func listProfiles(profiles ...client.Object) func(context.Context, client.Client, client.ObjectList) error {
+	return func(_ context.Context, _ client.Client, list client.ObjectList) error {
+		for _, profile := range profiles {
+			switch l := list.(type) {
+			case *seccompprofileapi.SeccompProfileList:
+				if p, ok := profile.(*seccompprofileapi.SeccompProfile); ok {
+					l.Items = append(l.Items, *p)
+				}
+			case *selinuxprofileapi.SelinuxProfileList:
+				if p, ok := profile.(*selinuxprofileapi.SelinuxProfile); ok {
+					l.Items = append(l.Items, *p)
+				}
+			case *apparmorprofileapi.AppArmorProfileList:
+				if p, ok := profile.(*apparmorprofileapi.AppArmorProfile); ok {
+					l.Items = append(l.Items, *p)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	objectMeta := metav1.ObjectMeta{Name: testProfile, Generation: 2}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*profiledriftfakes.FakeImpl)
+		assert  func(*profiledriftfakes.FakeImpl, *record.FakeRecorder, error)
+	}{
+		{
+			name: "seccomp drift",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{ObjectMeta: objectMeta}))
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{
+					Syscalls: []string{"read", "mount", "chmod"},
+				}, nil)
+				mock.ReadFileReturns(installedSeccompProfile(t), nil)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, recorder *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.UpdateStatusCallCount())
+
+				_, _, obj := mock.UpdateStatusArgsForCall(0)
+				profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+				require.True(t, ok)
+				require.Equal(t, []string{"chmod", "mount"}, profile.Status.Drift.Denials)
+				require.Equal(t, []string{testNode}, profile.Status.Drift.Nodes)
+				require.EqualValues(t, 2, profile.Status.Drift.ObservedGeneration)
+
+				require.Contains(t, <-recorder.Events, "ProfileDrift Observed 2 operations on node test-node")
+			},
+		},
+		{
+			name: "seccomp drift merged with existing denials",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: objectMeta,
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1.StatusBase{Drift: &profilebasev1.ProfileDrift{
+							Denials:            []string{"chmod"},
+							Nodes:              []string{"other-node"},
+							ObservedGeneration: 2,
+						}},
+					},
+				}))
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{
+					Syscalls: []string{"mount", "chmod"},
+				}, nil)
+				mock.ReadFileReturns(installedSeccompProfile(t), nil)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, recorder *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.UpdateStatusCallCount())
+
+				_, _, obj := mock.UpdateStatusArgsForCall(0)
+				profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+				require.True(t, ok)
+				require.Equal(t, []string{"chmod", "mount"}, profile.Status.Drift.Denials)
+				require.Equal(t, []string{"other-node", testNode}, profile.Status.Drift.Nodes)
+
+				require.Contains(t, <-recorder.Events, "Observed 1 operations on node test-node")
+			},
+		},
+		{
+			name: "seccomp drift of previous generation gets discarded",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: objectMeta,
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1.StatusBase{Drift: &profilebasev1.ProfileDrift{
+							Denials:            []string{"write"},
+							ObservedGeneration: 1,
+						}},
+					},
+				}))
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{
+					Syscalls: []string{"write", "mount"},
+				}, nil)
+				mock.ReadFileReturns(installedSeccompProfile(t), nil)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.UpdateStatusCallCount())
+
+				_, _, obj := mock.UpdateStatusArgsForCall(0)
+				profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+				require.True(t, ok)
+				require.Equal(t, []string{"mount"}, profile.Status.Drift.Denials)
+				require.EqualValues(t, 2, profile.Status.Drift.ObservedGeneration)
+			},
+		},
+		{
+			name: "no new seccomp denials",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{ObjectMeta: objectMeta}))
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{
+					Syscalls: []string{"read", "write"},
+				}, nil)
+				mock.ReadFileReturns(installedSeccompProfile(t), nil)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Zero(t, mock.UpdateStatusCallCount())
+			},
+		},
+		{
+			name: "no seccomp syscalls observed",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{ObjectMeta: objectMeta}))
+				mock.SyscallsReturns(nil, status.Error(codes.NotFound, "not found"))
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Zero(t, mock.ReadFileCallCount())
+				require.Zero(t, mock.UpdateStatusCallCount())
+			},
+		},
+		{
+			name: "seccomp profile not installed on node",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{ObjectMeta: objectMeta}))
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{Syscalls: []string{"mount"}}, nil)
+				mock.ReadFileReturns(nil, os.ErrNotExist)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Zero(t, mock.UpdateStatusCallCount())
+			},
+		},
+		{
+			name: "selinux drift",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&selinuxprofileapi.SelinuxProfile{
+					ObjectMeta: objectMeta,
+					Spec: selinuxprofileapi.SelinuxProfileSpec{
+						Allow: selinuxprofileapi.Allow{
+							"var_log_t":                 {"file": {"read"}},
+							selinuxprofileapi.AllowSelf: {"process": {"signal"}},
+						},
+					},
+				}))
+				mock.AvcsReturns(&enricherapi.AvcResponse{Avc: []*enricherapi.AvcResponse_SelinuxAvc{
+					{Perm: "read", Tclass: "file", Tcontext: "system_u:object_r:var_log_t:s0"},
+					{Perm: "write", Tclass: "file", Tcontext: "system_u:object_r:var_log_t:s0"},
+					{Perm: "signal", Tclass: "process", Tcontext: "system_u:system_r:test-profile.process:s0"},
+					{Perm: "getattr", Tclass: "dir", Tcontext: "invalid"},
+				}}, nil)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.UpdateStatusCallCount())
+
+				_, _, obj := mock.UpdateStatusArgsForCall(0)
+				profile, ok := obj.(*selinuxprofileapi.SelinuxProfile)
+				require.True(t, ok)
+				require.Equal(t, []string{"var_log_t file write"}, profile.Status.Drift.Denials)
+			},
+		},
+		{
+			name: "apparmor drift",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&apparmorprofileapi.AppArmorProfile{
+					ObjectMeta: objectMeta,
+					Spec: apparmorprofileapi.AppArmorProfileSpec{
+						Abstract: apparmorprofileapi.AppArmorAbstract{
+							Filesystem: &apparmorprofileapi.AppArmorFsRules{
+								ReadOnlyPaths: []string{"/etc/nginx/**", "/tmp/*.log"},
+							},
+						},
+					},
+				}))
+				mock.ApparmorPathsReturns(&enricherapi.ApparmorPathsResponse{Paths: []string{
+					"/etc/nginx/conf.d/default.conf", "/etc/shadow", "/tmp/access.log", "/tmp/logs/error.log",
+				}}, nil)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.UpdateStatusCallCount())
+
+				_, _, obj := mock.UpdateStatusArgsForCall(0)
+				profile, ok := obj.(*apparmorprofileapi.AppArmorProfile)
+				require.True(t, ok)
+				require.Equal(t, []string{"/etc/shadow", "/tmp/logs/error.log"}, profile.Status.Drift.Denials)
+			},
+		},
+		{
+			name: "failure on enricher error",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{ObjectMeta: objectMeta}))
+				mock.SyscallsReturns(nil, errTest)
+			},
+			assert: func(mock *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.UpdateStatusCallCount())
+			},
+		},
+		{
+			name: "failure on list error",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesReturns(errTest)
+			},
+			assert: func(_ *profiledriftfakes.FakeImpl, _ *record.FakeRecorder, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on status update",
+			prepare: func(mock *profiledriftfakes.FakeImpl) {
+				mock.ListProfilesCalls(listProfiles(&seccompprofileapi.SeccompProfile{ObjectMeta: objectMeta}))
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{Syscalls: []string{"mount"}}, nil)
+				mock.ReadFileReturns(installedSeccompProfile(t), nil)
+				mock.UpdateStatusReturns(errTest)
+			},
+			assert: func(_ *profiledriftfakes.FakeImpl, recorder *record.FakeRecorder, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Empty(t, recorder.Events)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, err := grpc.NewClient("unix:///invalid", grpc.WithTransportCredentials(insecure.NewCredentials()))
+			require.NoError(t, err)
+
+			mock := &profiledriftfakes.FakeImpl{}
+			mock.DialEnricherReturns(conn, nil)
+			prepare(mock)
+
+			recorder := record.NewFakeRecorder(10)
+			sut := &DriftDetector{
+				impl:     mock,
+				log:      logr.Discard(),
+				record:   recorder,
+				metrics:  metrics.New(),
+				nodeName: testNode,
+				kinds:    sets.New(kindSeccomp, kindSelinux, kindAppArmor),
+			}
+
+			err = sut.detect(context.Background())
+			assert(mock, recorder, err)
+		})
+	}
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(s))
+
+	mock := &profiledriftfakes.FakeImpl{}
+	mock.ManagerGetSchemeReturns(s)
+
+	sut, ok := NewController().(*DriftDetector)
+	require.True(t, ok)
+
+	sut.impl = mock
+
+	require.NoError(t, sut.Setup(context.Background(), nil, nil))
+	require.Equal(t, []string{kindSeccomp}, sets.List(sut.kinds))
+	require.Equal(t, 1, mock.ManagerAddCallCount())
+}
+
+func TestApparmorGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern, path string
+		matches       bool
+	}{
+		{"/etc/shadow", "/etc/shadow", true},
+		{"/etc/shadow", "/etc/passwd", false},
+		{"/etc/*", "/etc/passwd", true},
+		{"/etc/*", "/etc/nginx/nginx.conf", false},
+		{"/etc/**", "/etc/nginx/nginx.conf", true},
+		{"/var/log/*.log", "/var/log/nginx.log", true},
+		{"/var/log/*.log", "/var/log/nginx.txt", false},
+		{"/usr/lib/lib+.so", "/usr/lib/lib+.so", true},
+	} {
+		require.Equal(t, tc.matches, apparmorGlobMatch(tc.pattern, tc.path), tc.pattern+" "+tc.path)
+	}
+}
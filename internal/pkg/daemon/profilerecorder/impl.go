@@ -81,11 +81,17 @@ type impl interface {
 	) error
 	DialEnricher() (*grpc.ClientConn, error)
 	GetRecording(context.Context, client.Client, client.ObjectKey) (*profilerecordingapi.ProfileRecording, error)
+	UpdateRecordingStatus(context.Context, client.Client, *profilerecordingapi.ProfileRecording) error
 	ApparmorForProfile(
 		context.Context,
 		bpfrecorderapi.BpfRecorderClient,
 		*bpfrecorderapi.ProfileRequest,
 	) (*bpfrecorderapi.ApparmorResponse, error)
+	SelinuxForProfile(
+		context.Context,
+		bpfrecorderapi.BpfRecorderClient,
+		*bpfrecorderapi.ProfileRequest,
+	) (*bpfrecorderapi.SelinuxResponse, error)
 }
 
 func (*defaultImpl) NewClient(mgr ctrl.Manager) (client.Client, error) {
@@ -178,6 +184,14 @@ func (*defaultImpl) ApparmorForProfile(
 	return c.ApparmorForProfile(ctx, req)
 }
 
+func (*defaultImpl) SelinuxForProfile(
+	ctx context.Context,
+	c bpfrecorderapi.BpfRecorderClient,
+	req *bpfrecorderapi.ProfileRequest,
+) (*bpfrecorderapi.SelinuxResponse, error) {
+	return c.SelinuxForProfile(ctx, req)
+}
+
 func (*defaultImpl) CreateOrUpdate(
 	ctx context.Context,
 	c client.Client,
@@ -234,3 +248,11 @@ func (*defaultImpl) GetRecording(
 
 	return &recording, err
 }
+
+func (*defaultImpl) UpdateRecordingStatus(
+	ctx context.Context,
+	cli client.Client,
+	recording *profilerecordingapi.ProfileRecording,
+) error {
+	return cli.Status().Update(ctx, recording)
+}
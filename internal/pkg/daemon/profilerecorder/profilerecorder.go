@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"slices"
@@ -32,10 +33,14 @@ import (
 	"github.com/go-logr/logr"
 	grpccodes "google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -68,12 +73,17 @@ const (
 	// default reconcile timeout.
 	reconcileTimeout = 1 * time.Minute
 
+	// boundsCheckInterval is the interval for checking whether the bounds
+	// of a recording got reached.
+	boundsCheckInterval = 10 * time.Second
+
 	errInvalidAnnotation = "invalid Annotation"
 
 	reasonProfileRecording      string = "ProfileRecording"
 	reasonProfileCreated        string = "ProfileCreated"
 	reasonProfileCreationFailed string = "CannotCreateProfile"
 	reasonAnnotationParsing     string = "AnnotationParsing"
+	reasonRecordingCompleted    string = "ProfileRecordingCompleted"
 
 	seContextRequiredParts = 3
 )
@@ -107,6 +117,55 @@ type podToWatch struct {
 	baseName types.NamespacedName
 	recorder profilerecordingapi.ProfileRecorder
 	profiles []profileToCollect
+	bounds   *recordingBounds
+	workload *recordedWorkload
+	// images maps the container names to their images.
+	images map[string]string
+	// execPolicy controls how exec sessions and ephemeral containers get
+	// recorded by the log enricher.
+	execPolicy profilerecordingapi.ExecPolicy
+}
+
+// setProfileMetadata sets the annotations of a profile recorded for the
+// container.
+func (p *podToWatch) setProfileMetadata(profile metav1.Object, cntName string) {
+	if image, ok := p.images[cntName]; ok {
+		profile.SetAnnotations(map[string]string{
+			profilerecordingapi.ProfileContainerImagesAnnotation: image,
+		})
+	}
+}
+
+// recordingBounds stop the recording of a pod before it gets deleted.
+type recordingBounds struct {
+	recording     types.NamespacedName
+	duration      *metav1.Duration
+	stopAfterIdle *metav1.Duration
+	untilPodReady *metav1.Duration
+
+	// recordedEvents is the amount of recorded syscalls or AVCs at
+	// lastActivity, which is used to detect idle recordings.
+	recordedEvents int
+	lastActivity   time.Time
+}
+
+// recordedWorkload is the top-level controller of a recorded pod.
+type recordedWorkload struct {
+	owner metav1.OwnerReference
+
+	// nameProfiles adds the workload name to the names of the recorded
+	// profiles.
+	nameProfiles bool
+}
+
+// profileName returns the part of the profile names which refers to the
+// workload, or an empty string if the profiles are not named after it.
+func (w *recordedWorkload) profileName() string {
+	if w == nil || !w.nameProfiles {
+		return ""
+	}
+
+	return w.owner.Name
 }
 
 // Name returns the name of the controller.
@@ -121,6 +180,7 @@ func (r *RecorderReconciler) SchemeBuilder() *scheme.Builder {
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 
 // Setup is the initialization of the controller.
 func (r *RecorderReconciler) Setup(
@@ -200,7 +260,8 @@ func (r *RecorderReconciler) isPodWithTraceAnnotation(obj runtime.Object) bool {
 		if strings.HasPrefix(key, config.SelinuxProfileRecordLogsAnnotationKey) ||
 			strings.HasPrefix(key, config.SeccompProfileRecordLogsAnnotationKey) ||
 			strings.HasPrefix(key, config.SeccompProfileRecordBpfAnnotationKey) ||
-			strings.HasPrefix(key, config.ApparmorProfileRecordBpfAnnotationKey) {
+			strings.HasPrefix(key, config.ApparmorProfileRecordBpfAnnotationKey) ||
+			strings.HasPrefix(key, config.SelinuxProfileRecordBpfAnnotationKey) {
 			return true
 		}
 	}
@@ -211,6 +272,8 @@ func (r *RecorderReconciler) isPodWithTraceAnnotation(obj runtime.Object) bool {
 // Reconcile reconciles a pod event for profile recording.
 //
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
 func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := r.log.WithValues("pod", req.Name, "namespace", req.Namespace)
 
@@ -299,11 +362,51 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 			baseName.Name = pod.GenerateName
 		}
 
-		r.podsToWatch.Store(
-			req.String(),
-			podToWatch{baseName, recorder, profiles},
-		)
+		recording, err := r.profilesRecording(ctx, req.Namespace, profiles)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("get recording: %w", err)
+		}
+
+		bounds := newRecordingBounds(recording)
+		watchedPod := podToWatch{
+			baseName: baseName,
+			recorder: recorder,
+			profiles: profiles,
+			bounds:   bounds,
+			workload: r.recordedWorkload(ctx, pod, recording),
+			images:   containerImages(pod),
+			// Exec sessions and ephemeral containers were always included
+			// before, so stick with that without a recording
+			execPolicy: profilerecordingapi.ExecPolicyInclude,
+		}
+		if recording != nil && recording.Spec.ExecPolicy != "" {
+			watchedPod.execPolicy = recording.Spec.ExecPolicy
+		}
+
+		r.podsToWatch.Store(req.String(), watchedPod)
 		r.record.Event(pod, util.EventTypeNormal, reasonProfileRecording, "Recording profiles")
+
+		startTime := metav1.Now()
+		r.updateContainerStatus(ctx, req.NamespacedName, &watchedPod,
+			func(status *profilerecordingapi.RecordedContainer, _ *recordedContainer) {
+				*status = profilerecordingapi.RecordedContainer{
+					PodName:       status.PodName,
+					ContainerName: status.ContainerName,
+					NodeName:      pod.Spec.NodeName,
+					Recorder:      recorder,
+					Phase:         profilerecordingapi.RecordedContainerPhaseRecording,
+					StartTime:     &startTime,
+				}
+			},
+		)
+
+		if bounds != nil {
+			return reconcile.Result{RequeueAfter: boundsCheckInterval}, nil
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodRunning {
+		return r.checkBounds(ctx, pod, req.NamespacedName)
 	}
 
 	if pod.Status.Phase == corev1.PodSucceeded {
@@ -320,6 +423,464 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 	return reconcile.Result{}, nil
 }
 
+// profilesRecording returns the recording which produces the profiles, or
+// nil if the recording cannot be resolved.
+func (r *RecorderReconciler) profilesRecording(
+	ctx context.Context, namespace string, profiles []profileToCollect,
+) (*profilerecordingapi.ProfileRecording, error) {
+	parsedAnnotation, err := parseProfileAnnotation(profiles[0].name)
+	if err != nil {
+		// Invalid names are reported when collecting the profiles
+		r.log.Info("Unable to resolve recording", "error", err.Error())
+
+		return nil, nil //nolint:nilnil // an unknown recording has no settings
+	}
+
+	recording := &profilerecordingapi.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{Name: parsedAnnotation.profileName, Namespace: namespace},
+	}
+
+	if err := r.ClientGet(ctx, r.client, client.ObjectKeyFromObject(recording), recording); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil //nolint:nilnil // the recording got removed in the meantime
+		}
+
+		return nil, fmt.Errorf("get recording: %w", err)
+	}
+
+	return recording, nil
+}
+
+// newRecordingBounds returns the bounds of the recording, or nil if the
+// recording is not bounded.
+func newRecordingBounds(recording *profilerecordingapi.ProfileRecording) *recordingBounds {
+	if recording == nil || !recording.Spec.IsBounded() {
+		return nil
+	}
+
+	return &recordingBounds{
+		recording:     client.ObjectKeyFromObject(recording),
+		duration:      recording.Spec.Duration,
+		stopAfterIdle: recording.Spec.StopAfterIdle,
+		untilPodReady: recording.Spec.UntilPodReady,
+		lastActivity:  time.Now(),
+	}
+}
+
+// containerImages returns the images of the containers of the pod by their
+// name.
+func containerImages(pod *corev1.Pod) map[string]string {
+	images := map[string]string{}
+
+	for i := range pod.Spec.InitContainers {
+		images[pod.Spec.InitContainers[i].Name] = pod.Spec.InitContainers[i].Image
+	}
+
+	for i := range pod.Spec.Containers {
+		images[pod.Spec.Containers[i].Name] = pod.Spec.Containers[i].Image
+	}
+
+	return images
+}
+
+// recordedWorkload returns the top-level controller of the pod, or nil if
+// the pod has no controller.
+func (r *RecorderReconciler) recordedWorkload(
+	ctx context.Context, pod *corev1.Pod, recording *profilerecordingapi.ProfileRecording,
+) *recordedWorkload {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return nil
+	}
+
+	workload := &recordedWorkload{owner: *r.topLevelController(ctx, pod.Namespace, owner)}
+	if recording != nil {
+		workload.nameProfiles = recording.Spec.ProfileNaming == profilerecordingapi.ProfileNamingWorkload
+	}
+
+	return workload
+}
+
+// topLevelController follows the controllers of ReplicaSets and Jobs up to
+// their Deployment or CronJob. The owner is used as is if its controller
+// cannot be retrieved.
+func (r *RecorderReconciler) topLevelController(
+	ctx context.Context, namespace string, owner *metav1.OwnerReference,
+) *metav1.OwnerReference {
+	for {
+		var obj client.Object
+
+		switch gvk := schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind); gvk {
+		case appsv1.SchemeGroupVersion.WithKind("ReplicaSet"):
+			obj = &appsv1.ReplicaSet{}
+		case batchv1.SchemeGroupVersion.WithKind("Job"):
+			obj = &batchv1.Job{}
+		default:
+			return owner
+		}
+
+		key := types.NamespacedName{Name: owner.Name, Namespace: namespace}
+		if err := r.ClientGet(ctx, r.client, key, obj); err != nil {
+			r.log.Info("Unable to resolve controller of workload",
+				"kind", owner.Kind, "name", owner.Name, "error", err.Error())
+
+			return owner
+		}
+
+		parent := metav1.GetControllerOf(obj)
+		if parent == nil {
+			return owner
+		}
+
+		owner = parent
+	}
+}
+
+// checkBounds collects the profiles of a running pod once a bound of its
+// recording got reached.
+func (r *RecorderReconciler) checkBounds(
+	ctx context.Context, pod *corev1.Pod, podName types.NamespacedName,
+) (reconcile.Result, error) {
+	value, ok := r.podsToWatch.Load(podName.String())
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return reconcile.Result{}, errors.New("type assert pod to watch")
+	}
+
+	if podToWatch.bounds == nil {
+		return reconcile.Result{}, nil
+	}
+
+	reason, err := r.reachedBound(ctx, pod, &podToWatch)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("check recording bounds: %w", err)
+	}
+
+	if reason == "" {
+		return reconcile.Result{RequeueAfter: boundsCheckInterval}, nil
+	}
+
+	r.log.Info("Recording bound reached, collecting profiles", "pod", podName, "reason", reason)
+
+	if err := r.collectProfile(ctx, podName); err != nil {
+		if errors.Is(err, errNameNotValid) {
+			r.log.Error(err, "cannot collect profile")
+			// not reconcilable, no need to requeue
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("collect profile for bounded recording: %w", err)
+	}
+
+	message := "Collected the recorded profiles of pod " + podName.String()
+	r.record.Event(pod, util.EventTypeNormal, reasonRecordingCompleted, message)
+
+	if err := r.setRecordingCompleted(ctx, podToWatch.bounds.recording, reason, message); err != nil {
+		return reconcile.Result{}, fmt.Errorf("set recording completed: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// reachedBound returns the reason of the first reached bound, or an empty
+// string if the recording should continue.
+func (r *RecorderReconciler) reachedBound(
+	ctx context.Context, pod *corev1.Pod, podToWatch *podToWatch,
+) (string, error) {
+	bounds := podToWatch.bounds
+	now := time.Now()
+
+	if bounds.duration != nil && pod.Status.StartTime != nil &&
+		now.Sub(pod.Status.StartTime.Time) >= bounds.duration.Duration {
+		return profilerecordingapi.ProfileRecordingReasonDurationReached, nil
+	}
+
+	if bounds.untilPodReady != nil {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue &&
+				now.Sub(cond.LastTransitionTime.Time) >= bounds.untilPodReady.Duration {
+				return profilerecordingapi.ProfileRecordingReasonPodReady, nil
+			}
+		}
+	}
+
+	if bounds.stopAfterIdle != nil && podToWatch.recorder == profilerecordingapi.ProfileRecorderLogs {
+		recordedEvents, err := r.recordedLogEvents(ctx, podToWatch.profiles)
+		if err != nil {
+			return "", fmt.Errorf("get recorded events: %w", err)
+		}
+
+		if recordedEvents != bounds.recordedEvents {
+			bounds.recordedEvents = recordedEvents
+			bounds.lastActivity = now
+		} else if now.Sub(bounds.lastActivity) >= bounds.stopAfterIdle.Duration {
+			return profilerecordingapi.ProfileRecordingReasonIdle, nil
+		}
+	}
+
+	return "", nil
+}
+
+// recordedLogEvents returns the amount of syscalls and AVCs recorded by the
+// log enricher for the profiles without resetting them.
+func (r *RecorderReconciler) recordedLogEvents(
+	ctx context.Context, profiles []profileToCollect,
+) (int, error) {
+	conn, err := r.DialEnricher()
+	if err != nil {
+		return 0, fmt.Errorf("connecting to local GRPC server: %w", err)
+	}
+	defer conn.Close()
+
+	enricherClient := enricherapi.NewEnricherClient(conn)
+	recordedEvents := 0
+
+	for _, prf := range profiles {
+		switch prf.kind {
+		case profilerecordingapi.ProfileRecordingKindSeccompProfile:
+			response, err := r.Syscalls(ctx, enricherClient, &enricherapi.SyscallsRequest{Profile: prf.name})
+			if err != nil && !isNotFound(err, enricher.ErrorNoSyscalls) {
+				return 0, fmt.Errorf("retrieve syscalls for profile %s: %w", prf.name, err)
+			}
+
+			recordedEvents += len(response.GetSyscalls())
+		case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
+			response, err := r.Avcs(ctx, enricherClient, &enricherapi.AvcRequest{Profile: prf.name})
+			if err != nil && !isNotFound(err, enricher.ErrorNoAvcs) {
+				return 0, fmt.Errorf("retrieve avcs for profile %s: %w", prf.name, err)
+			}
+
+			recordedEvents += len(response.GetAvc())
+		case profilerecordingapi.ProfileRecordingKindAppArmorProfile:
+			// Not supported by the log enricher
+		}
+	}
+
+	return recordedEvents, nil
+}
+
+func isNotFound(err error, message string) bool {
+	return grpcstatus.Convert(err).Code() == grpccodes.NotFound &&
+		grpcstatus.Convert(err).Message() == message
+}
+
+// setRecordingCompleted marks the recording as completed in its status.
+func (r *RecorderReconciler) setRecordingCompleted(
+	ctx context.Context, recordingName types.NamespacedName, reason, message string,
+) error {
+	return r.updateRecordingStatus(ctx, recordingName, func(recording *profilerecordingapi.ProfileRecording) {
+		meta.SetStatusCondition(&recording.Status.Conditions, metav1.Condition{
+			Type:               profilerecordingapi.ProfileRecordingConditionCompleted,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: recording.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+	})
+}
+
+// updateRecordingStatus applies the update to the status of the recording.
+func (r *RecorderReconciler) updateRecordingStatus(
+	ctx context.Context,
+	recordingName types.NamespacedName,
+	update func(*profilerecordingapi.ProfileRecording),
+) error {
+	return util.Retry(func() error {
+		recording := &profilerecordingapi.ProfileRecording{}
+		if err := r.ClientGet(ctx, r.client, recordingName, recording); err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+
+			return fmt.Errorf("get recording: %w", err)
+		}
+
+		update(recording)
+
+		return r.UpdateRecordingStatus(ctx, r.client, recording)
+	}, kerrors.IsConflict)
+}
+
+// recordedContainer is a container recorded into a profile.
+type recordedContainer struct {
+	recording types.NamespacedName
+	name      string
+	kind      profilerecordingapi.ProfileRecordingKind
+	profile   types.NamespacedName
+}
+
+// recordedContainers returns the containers recorded for the pod.
+func recordedContainers(podName types.NamespacedName, podToWatch *podToWatch) []recordedContainer {
+	replicaSuffix := podReplicaSuffix(podName, podToWatch.baseName)
+	containers := make([]recordedContainer, 0, len(podToWatch.profiles))
+
+	for _, prf := range podToWatch.profiles {
+		parsedAnnotation, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			// Invalid names are reported when collecting the profiles
+			continue
+		}
+
+		containers = append(containers, recordedContainer{
+			recording: types.NamespacedName{Name: parsedAnnotation.profileName, Namespace: podName.Namespace},
+			name:      parsedAnnotation.cntName,
+			kind:      prf.kind,
+			profile: createProfileName(
+				parsedAnnotation.cntName, replicaSuffix, podName.Namespace, parsedAnnotation.profileName,
+				podToWatch.workload.profileName(),
+			),
+		})
+	}
+
+	return containers
+}
+
+// updateContainerStatus applies the update to the status entries of all
+// recorded containers of the pod. Failures are only logged, because the
+// status is informational.
+func (r *RecorderReconciler) updateContainerStatus(
+	ctx context.Context,
+	podName types.NamespacedName,
+	podToWatch *podToWatch,
+	update func(*profilerecordingapi.RecordedContainer, *recordedContainer),
+) {
+	containersByRecording := map[types.NamespacedName][]recordedContainer{}
+	for _, container := range recordedContainers(podName, podToWatch) {
+		containersByRecording[container.recording] = append(containersByRecording[container.recording], container)
+	}
+
+	for recordingName, containers := range containersByRecording {
+		if err := r.updateRecordingStatus(ctx, recordingName, func(recording *profilerecordingapi.ProfileRecording) {
+			for i := range containers {
+				status := profilerecordingapi.RecordedContainer{
+					PodName:       podName.Name,
+					ContainerName: containers[i].name,
+				}
+				if existing := recording.Status.FindContainer(podName.Name, containers[i].name); existing != nil {
+					status = *existing
+				}
+
+				update(&status, &containers[i])
+				recording.Status.SetContainer(status)
+			}
+
+			recording.Status.UpdateContainerConditions(recording.Generation)
+		}); err != nil {
+			r.log.Error(err, "Cannot update recording status", "recording", recordingName)
+		}
+	}
+}
+
+// setContainersCollected marks the recorded containers of the pod as
+// collected and references their profiles.
+func (r *RecorderReconciler) setContainersCollected(
+	ctx context.Context, podName types.NamespacedName, podToWatch *podToWatch,
+) {
+	type collectedProfile struct {
+		found  bool
+		events int32
+	}
+
+	profiles := map[types.NamespacedName]collectedProfile{}
+
+	for _, container := range recordedContainers(podName, podToWatch) {
+		found, events, err := r.collectedProfileEvents(ctx, container.kind, container.profile)
+		if err != nil {
+			r.log.Error(err, "Cannot get collected profile", "profile", container.profile)
+
+			continue
+		}
+
+		profiles[container.profile] = collectedProfile{found, events}
+	}
+
+	r.updateContainerStatus(ctx, podName, podToWatch,
+		func(status *profilerecordingapi.RecordedContainer, container *recordedContainer) {
+			status.Phase = profilerecordingapi.RecordedContainerPhaseCollected
+			status.Message = ""
+
+			profile, ok := profiles[container.profile]
+			if !ok {
+				return
+			}
+
+			if !profile.found {
+				status.Message = "No events recorded"
+
+				return
+			}
+
+			status.RecordedEvents = profile.events
+			status.Profile = &profilerecordingapi.RecordedProfileReference{
+				Kind: container.kind,
+				Name: container.profile.Name,
+			}
+		},
+	)
+}
+
+// collectedProfileEvents returns whether the collected profile exists and
+// how many syscalls, SELinux permissions or AppArmor paths it contains.
+func (r *RecorderReconciler) collectedProfileEvents(
+	ctx context.Context, kind profilerecordingapi.ProfileRecordingKind, profileName types.NamespacedName,
+) (found bool, events int32, err error) {
+	var profile client.Object
+
+	switch kind {
+	case profilerecordingapi.ProfileRecordingKindSeccompProfile:
+		profile = &seccompprofileapi.SeccompProfile{}
+	case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
+		profile = &selinuxprofileapi.SelinuxProfile{}
+	case profilerecordingapi.ProfileRecordingKindAppArmorProfile:
+		profile = &apparmorprofileapi.AppArmorProfile{}
+	default:
+		return false, 0, fmt.Errorf("unrecognized kind %s", kind)
+	}
+
+	if err := r.ClientGet(ctx, r.client, profileName, profile); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, 0, nil
+		}
+
+		return false, 0, fmt.Errorf("get profile: %w", err)
+	}
+
+	return true, profileEvents(profile), nil
+}
+
+// profileEvents returns the number of syscalls, SELinux permissions or
+// AppArmor paths of the profile.
+func profileEvents(profile client.Object) int32 {
+	events := 0
+
+	switch p := profile.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		for _, syscall := range p.Spec.Syscalls {
+			events += len(syscall.Names)
+		}
+	case *selinuxprofileapi.SelinuxProfile:
+		for _, classes := range p.Spec.Allow {
+			for _, permissions := range classes {
+				events += len(permissions)
+			}
+		}
+	case *apparmorprofileapi.AppArmorProfile:
+		if fs := p.Spec.Abstract.Filesystem; fs != nil {
+			events += len(fs.ReadOnlyPaths) + len(fs.WriteOnlyPaths) + len(fs.ReadWritePaths) + len(fs.Rules)
+		}
+
+		if executable := p.Spec.Abstract.Executable; executable != nil {
+			events += len(executable.AllowedExecutables) + len(executable.AllowedLibraries)
+		}
+	}
+
+	return int32(min(events, math.MaxInt32)) //nolint:gosec // bounded by the min above
+}
+
 func (r *RecorderReconciler) getBpfRecorderClient(
 	ctx context.Context,
 ) (bpfrecorderapi.BpfRecorderClient, error) {
@@ -394,38 +955,66 @@ func (r *RecorderReconciler) collectProfile(
 		return errors.New("type assert pod to watch")
 	}
 
-	replicaSuffix := ""
-	if podToWatch.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, podToWatch.baseName.Name) {
-		// this is a replica, we need to strip the suffix from the pod name
-		replicaSuffix = strings.TrimPrefix(podName.Name, podToWatch.baseName.Name)
+	r.updateContainerStatus(ctx, podName, &podToWatch,
+		func(status *profilerecordingapi.RecordedContainer, _ *recordedContainer) {
+			status.Phase = profilerecordingapi.RecordedContainerPhaseCollecting
+			status.Message = ""
+		},
+	)
+
+	if err := r.collectPodProfiles(ctx, podName, &podToWatch); err != nil {
+		r.updateContainerStatus(ctx, podName, &podToWatch,
+			func(status *profilerecordingapi.RecordedContainer, _ *recordedContainer) {
+				status.Phase = profilerecordingapi.RecordedContainerPhaseFailed
+				status.Message = err.Error()
+			},
+		)
+
+		return err
 	}
 
+	r.setContainersCollected(ctx, podName, &podToWatch)
+	r.podsToWatch.Delete(n)
+
+	return nil
+}
+
+func (r *RecorderReconciler) collectPodProfiles(
+	ctx context.Context, podName types.NamespacedName, podToWatch *podToWatch,
+) error {
+	replicaSuffix := podReplicaSuffix(podName, podToWatch.baseName)
+
 	if podToWatch.recorder == profilerecordingapi.ProfileRecorderLogs {
-		if err := r.collectLogProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
-		); err != nil {
+		if err := r.collectLogProfiles(ctx, replicaSuffix, podName, podToWatch); err != nil {
 			return fmt.Errorf("collect log profile: %w", err)
 		}
 	}
 
 	if podToWatch.recorder == profilerecordingapi.ProfileRecorderBpf {
-		if err := r.collectBpfProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
-		); err != nil {
+		if err := r.collectBpfProfiles(ctx, replicaSuffix, podName, podToWatch); err != nil {
 			return fmt.Errorf("collect bpf profile: %w", err)
 		}
 	}
 
-	r.podsToWatch.Delete(n)
-
 	return nil
 }
 
+// podReplicaSuffix returns the suffix of pods managed by a replicated
+// controller, or an empty string for other pods.
+func podReplicaSuffix(podName, baseName types.NamespacedName) string {
+	if baseName.Name != podName.Name && strings.HasPrefix(podName.Name, baseName.Name) {
+		// this is a replica, we need to strip the suffix from the pod name
+		return strings.TrimPrefix(podName.Name, baseName.Name)
+	}
+
+	return ""
+}
+
 func (r *RecorderReconciler) collectLogProfiles(
 	ctx context.Context,
 	replicaSuffix string,
 	podName types.NamespacedName,
-	profiles []profileToCollect,
+	podToWatch *podToWatch,
 ) error {
 	r.log.Info("Checking if enricher is enabled")
 
@@ -452,31 +1041,145 @@ func (r *RecorderReconciler) collectLogProfiles(
 
 	enricherClient := enricherapi.NewEnricherClient(conn)
 
-	for _, prf := range profiles {
+	for _, prf := range podToWatch.profiles {
 		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
 		if err != nil {
 			return fmt.Errorf("parse profile raw annotation: %w", err)
 		}
 
-		profileNamespacedName := createProfileName(
-			parsedProfileAnnotation.cntName, replicaSuffix,
-			podName.Namespace, parsedProfileAnnotation.profileName)
+		for _, logPrf := range podToWatch.logProfiles(parsedProfileAnnotation, prf.name) {
+			profileNamespacedName := createProfileName(
+				logPrf.annotation.cntName, replicaSuffix,
+				podName.Namespace, logPrf.annotation.profileName, podToWatch.workload.profileName())
+
+			r.log.Info("Collecting profile", "name", profileNamespacedName, "kind", prf.kind)
+
+			switch prf.kind {
+			case profilerecordingapi.ProfileRecordingKindSeccompProfile:
+				err = r.collectLogSeccompProfile(ctx, enricherClient, &logPrf, profileNamespacedName, podToWatch)
+			case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
+				err = r.collectLogSelinuxProfile(ctx, enricherClient, &logPrf, profileNamespacedName, podToWatch)
+			case profilerecordingapi.ProfileRecordingKindAppArmorProfile:
+				err = errors.New("log recorder doesn't support apparmor profile recording")
+			default:
+				err = fmt.Errorf("unrecognized kind %s", prf.kind)
+			}
 
-		r.log.Info("Collecting profile", "name", profileNamespacedName, "kind", prf.kind)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
-		switch prf.kind {
-		case profilerecordingapi.ProfileRecordingKindSeccompProfile:
-			err = r.collectLogSeccompProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
-		case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
-			err = r.collectLogSelinuxProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
-		case profilerecordingapi.ProfileRecordingKindAppArmorProfile:
-			err = errors.New("log recorder doesn't support apparmor profile recording")
-		default:
-			err = fmt.Errorf("unrecognized kind %s", prf.kind)
+	return nil
+}
+
+// logProfile is a profile to collect from the log enricher.
+type logProfile struct {
+	annotation *parsedAnnotation
+	// collect are the enricher keys recorded into the profile.
+	collect []string
+	// discard are the enricher keys reset together with the profile
+	// without being recorded.
+	discard []string
+}
+
+// ids returns all enricher keys of the profile.
+func (l *logProfile) ids() []string {
+	return slices.Concat(l.collect, l.discard)
+}
+
+// logProfiles returns the profiles to collect for the recorded profile ID
+// according to the exec policy. Exec sessions and ephemeral containers are
+// recorded by the log enricher under the debug key of the profile ID.
+func (p *podToWatch) logProfiles(annotation *parsedAnnotation, profileID string) []logProfile {
+	debugID := enricher.DebugProfileKey(profileID)
+
+	switch p.execPolicy {
+	case profilerecordingapi.ExecPolicyExclude:
+		return []logProfile{{annotation: annotation, collect: []string{profileID}, discard: []string{debugID}}}
+	case profilerecordingapi.ExecPolicySeparate:
+		// The container name of the debug profile has no image, which
+		// keeps it from getting bound after the recording
+		debugAnnotation := *annotation
+		debugAnnotation.cntName += profilerecordingapi.DebugProfileSuffix
+
+		return []logProfile{
+			{annotation: annotation, collect: []string{profileID}},
+			{annotation: &debugAnnotation, collect: []string{debugID}},
+		}
+	case profilerecordingapi.ExecPolicyInclude:
+	}
+
+	return []logProfile{{annotation: annotation, collect: []string{profileID, debugID}}}
+}
+
+// recordedSyscalls returns the union of the syscalls recorded for the
+// enricher keys and the architecture they got recorded for.
+func (r *RecorderReconciler) recordedSyscalls(
+	ctx context.Context, enricherClient enricherapi.EnricherClient, profileIDs []string,
+) (goArch string, syscalls []string, err error) {
+	recorded := sets.New[string]()
+
+	for _, profileID := range profileIDs {
+		response, err := r.Syscalls(ctx, enricherClient, &enricherapi.SyscallsRequest{Profile: profileID})
+		if isNotFound(err, enricher.ErrorNoSyscalls) {
+			continue
+		}
+
+		if err != nil {
+			return "", nil, fmt.Errorf("retrieve syscalls for profile %s: %w", profileID, err)
+		}
+
+		goArch = response.GetGoArch()
+		recorded.Insert(response.GetSyscalls()...)
+	}
+
+	return goArch, sets.List(recorded), nil
+}
+
+// resetSyscalls removes the syscalls recorded for the enricher keys.
+func (r *RecorderReconciler) resetSyscalls(
+	ctx context.Context, enricherClient enricherapi.EnricherClient, profileIDs []string,
+) error {
+	for _, profileID := range profileIDs {
+		if err := r.ResetSyscalls(ctx, enricherClient, &enricherapi.SyscallsRequest{Profile: profileID}); err != nil {
+			return fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
+		}
+	}
+
+	return nil
+}
+
+// recordedAvcs returns the AVCs recorded for the enricher keys.
+func (r *RecorderReconciler) recordedAvcs(
+	ctx context.Context, enricherClient enricherapi.EnricherClient, profileIDs []string,
+) (*enricherapi.AvcResponse, error) {
+	recorded := &enricherapi.AvcResponse{}
+
+	for _, profileID := range profileIDs {
+		response, err := r.Avcs(ctx, enricherClient, &enricherapi.AvcRequest{Profile: profileID})
+		if isNotFound(err, enricher.ErrorNoAvcs) {
+			continue
 		}
 
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("retrieve avcs for profile %s: %w", profileID, err)
+		}
+
+		recorded.Avc = append(recorded.Avc, response.GetAvc()...)
+	}
+
+	return recorded, nil
+}
+
+// resetAvcs removes the AVCs recorded for the enricher keys.
+func (r *RecorderReconciler) resetAvcs(
+	ctx context.Context, enricherClient enricherapi.EnricherClient, profileIDs []string,
+) error {
+	for _, profileID := range profileIDs {
+		if err := r.ResetAvcs(ctx, enricherClient, &enricherapi.AvcRequest{Profile: profileID}); err != nil {
+			return fmt.Errorf("reset avcs for profile %s: %w", profileID, err)
 		}
 	}
 
@@ -486,16 +1189,19 @@ func (r *RecorderReconciler) collectLogProfiles(
 func (r *RecorderReconciler) collectLogSeccompProfile(
 	ctx context.Context,
 	enricherClient enricherapi.EnricherClient,
-	parsedProfileName *parsedAnnotation,
+	logProfile *logProfile,
 	profileNamespacedName types.NamespacedName,
-	profileID string,
+	podToWatch *podToWatch,
 ) error {
+	parsedProfileName := logProfile.annotation
+
 	labels, err := profileLabels(
 		ctx,
 		r,
 		parsedProfileName.profileName,
 		parsedProfileName.cntName,
-		profileNamespacedName.Namespace)
+		profileNamespacedName.Namespace,
+		podToWatch.workload)
 	if err != nil {
 		return fmt.Errorf("creating profile labels: %w", err)
 	}
@@ -509,25 +1215,22 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	}
 
 	// Retrieve the syscalls for the recording
-	request := &enricherapi.SyscallsRequest{Profile: profileID}
-
-	response, err := r.Syscalls(ctx, enricherClient, request)
+	goArch, syscalls, err := r.recordedSyscalls(ctx, enricherClient, logProfile.collect)
 	if err != nil {
-		if grpcstatus.Convert(err).Code() == grpccodes.NotFound &&
-			grpcstatus.Convert(err).Message() == enricher.ErrorNoSyscalls {
-			if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
-				return fmt.Errorf("reset syscalls for profile %s: %w", profileNamespacedName, err)
-			}
-
-			r.log.Info("No syscalls found, resetting profile", "profileID", profileID)
+		return err
+	}
 
-			return nil
+	if len(syscalls) == 0 {
+		if err := r.resetSyscalls(ctx, enricherClient, logProfile.ids()); err != nil {
+			return fmt.Errorf("reset syscalls for profile %s: %w", profileNamespacedName, err)
 		}
 
-		return fmt.Errorf("retrieve syscalls for profile %s: %w", profileID, err)
+		r.log.Info("No syscalls found, resetting profile", "profileIDs", logProfile.collect)
+
+		return nil
 	}
 
-	arch, err := r.goArchToSeccompArch(response.GetGoArch())
+	arch, err := r.goArchToSeccompArch(goArch)
 	if err != nil {
 		return fmt.Errorf("get seccomp arch: %w", err)
 	}
@@ -537,7 +1240,7 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		Architectures: []seccompprofileapi.Arch{arch},
 		Syscalls: []seccompprofileapi.Syscall{{
 			Action: seccompprofileapi.ActAllow,
-			Names:  response.GetSyscalls(),
+			Names:  syscalls,
 		}},
 	}
 
@@ -550,6 +1253,8 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		Spec: *profileSpec,
 	}
 
+	podToWatch.setProfileMetadata(profile, parsedProfileName.cntName)
+
 	if err := r.setDisabled(ctx, r.client,
 		parsedProfileName.profileName, profileNamespacedName.Namespace,
 		&profileSpec.SpecBase); err != nil {
@@ -577,8 +1282,8 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
 
 	// Reset the syscalls for further recordings
-	if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
-		return fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
+	if err := r.resetSyscalls(ctx, enricherClient, logProfile.ids()); err != nil {
+		return err
 	}
 
 	return nil
@@ -587,16 +1292,19 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 func (r *RecorderReconciler) collectLogSelinuxProfile(
 	ctx context.Context,
 	enricherClient enricherapi.EnricherClient,
-	parsedProfileName *parsedAnnotation,
+	logProfile *logProfile,
 	profileNamespacedName types.NamespacedName,
-	profileID string,
+	podToWatch *podToWatch,
 ) error {
+	parsedProfileName := logProfile.annotation
+
 	labels, err := profileLabels(
 		ctx,
 		r,
 		parsedProfileName.profileName,
 		parsedProfileName.cntName,
-		profileNamespacedName.Namespace)
+		profileNamespacedName.Namespace,
+		podToWatch.workload)
 	if err != nil {
 		return fmt.Errorf("creating profile labels: %w", err)
 	}
@@ -610,22 +1318,19 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	}
 
 	// Retrieve the AVCs for the recording
-	request := &enricherapi.AvcRequest{Profile: profileID}
-
-	response, err := r.Avcs(ctx, enricherClient, request)
+	response, err := r.recordedAvcs(ctx, enricherClient, logProfile.collect)
 	if err != nil {
-		if grpcstatus.Convert(err).Code() == grpccodes.NotFound &&
-			grpcstatus.Convert(err).Message() == enricher.ErrorNoAvcs {
-			if err := r.ResetAvcs(ctx, enricherClient, request); err != nil {
-				return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
-			}
-
-			r.log.Info("No AVCs found, resetting profile", "profileID", profileID)
+		return err
+	}
 
-			return nil
+	if len(response.GetAvc()) == 0 {
+		if err := r.resetAvcs(ctx, enricherClient, logProfile.ids()); err != nil {
+			return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
 		}
 
-		return fmt.Errorf("retrieve avcs for profile %s: %w", profileID, err)
+		r.log.Info("No AVCs found, resetting profile", "profileIDs", logProfile.collect)
+
+		return nil
 	}
 
 	selinuxProfileSpec := selinuxprofileapi.SelinuxProfileSpec{
@@ -646,6 +1351,8 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 		Spec: selinuxProfileSpec,
 	}
 
+	podToWatch.setProfileMetadata(profile, parsedProfileName.cntName)
+
 	selinuxProfileSpec.Allow, err = r.formatSelinuxProfile(profile, response)
 	if err != nil {
 		r.log.Error(err, "Cannot format selinuxprofile")
@@ -683,7 +1390,7 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "selinuxprofile profile created")
 
 	// Reset the selinuxprofile for further recordings
-	if err := r.ResetAvcs(ctx, enricherClient, request); err != nil {
+	if err := r.resetAvcs(ctx, enricherClient, logProfile.ids()); err != nil {
 		return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
 	}
 
@@ -712,14 +1419,14 @@ func (r *RecorderReconciler) collectBpfProfiles(
 	ctx context.Context,
 	replicaSuffix string,
 	podName types.NamespacedName,
-	profiles []profileToCollect,
+	podToWatch *podToWatch,
 ) error {
 	recorderClient, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
 		return fmt.Errorf("get bpf recorder client: %w", err)
 	}
 
-	for _, profileToCollect := range profiles {
+	for _, profileToCollect := range podToWatch.profiles {
 		ptc := profileToCollect
 
 		parsedProfileName, err := parseProfileAnnotation(profileToCollect.name)
@@ -729,14 +1436,15 @@ func (r *RecorderReconciler) collectBpfProfiles(
 
 		profileNamespacedName := createProfileName(
 			parsedProfileName.cntName, replicaSuffix,
-			podName.Namespace, parsedProfileName.profileName)
+			podName.Namespace, parsedProfileName.profileName, podToWatch.workload.profileName())
 
 		labels, err := profileLabels(
 			ctx,
 			r,
 			parsedProfileName.profileName,
 			parsedProfileName.cntName,
-			profileNamespacedName.Namespace)
+			profileNamespacedName.Namespace,
+			podToWatch.workload)
 		if err != nil {
 			return fmt.Errorf("creating profile labels: %w", err)
 		}
@@ -753,7 +1461,9 @@ func (r *RecorderReconciler) collectBpfProfiles(
 
 		switch profileToCollect.kind {
 		case profilerecordingapi.ProfileRecordingKindSeccompProfile:
-			seccompProfile, err := r.collectSeccompBpfProfile(ctx, recorderClient, &ptc, profileNamespacedName, labels)
+			seccompProfile, err := r.collectSeccompBpfProfile(
+				ctx, recorderClient, &ptc, parsedProfileName.profileName, profileNamespacedName, labels,
+			)
 			if err != nil {
 				// skip empty profiles
 				if errors.Is(err, errRecordedProfileNotFound) {
@@ -763,6 +1473,8 @@ func (r *RecorderReconciler) collectBpfProfiles(
 				return fmt.Errorf("collecting seccomp profile %s: %w", profileToCollect.name, err)
 			}
 
+			podToWatch.setProfileMetadata(seccompProfile, parsedProfileName.cntName)
+
 			err = r.updateOrCreateSeccompResource(
 				ctx, parsedProfileName.profileName, profileNamespacedName.Namespace, seccompProfile)
 			if err != nil {
@@ -779,15 +1491,31 @@ func (r *RecorderReconciler) collectBpfProfiles(
 				return fmt.Errorf("collecting apparmor profile %s: %w", profileToCollect.name, err)
 			}
 
+			podToWatch.setProfileMetadata(apparmorProfile, parsedProfileName.cntName)
+
 			err = r.updateOrCreateApparmorResource(
 				ctx, parsedProfileName.profileName, profileNamespacedName.Namespace, apparmorProfile)
 			if err != nil {
 				return fmt.Errorf("creating/updating apparmor profile %s: %w", profileToCollect.name, err)
 			}
 		case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
-			r.log.Info("Profile kind not supported by BPF recoder", "name", profileToCollect.name, "kind", profileToCollect.kind)
+			selinuxProfile, err := r.collectSelinuxBpfProfile(ctx, recorderClient, &ptc, profileNamespacedName, labels)
+			if err != nil {
+				// skip empty profiles
+				if errors.Is(err, errRecordedProfileNotFound) {
+					continue
+				}
 
-			continue
+				return fmt.Errorf("collecting selinux profile %s: %w", profileToCollect.name, err)
+			}
+
+			podToWatch.setProfileMetadata(selinuxProfile, parsedProfileName.cntName)
+
+			err = r.updateOrCreateSelinuxResource(
+				ctx, parsedProfileName.profileName, profileNamespacedName.Namespace, selinuxProfile)
+			if err != nil {
+				return fmt.Errorf("creating/updating selinux profile %s: %w", profileToCollect.name, err)
+			}
 		}
 	}
 
@@ -804,6 +1532,7 @@ func (r *RecorderReconciler) collectSeccompBpfProfile(
 	ctx context.Context,
 	recorderClient bpfrecorderapi.BpfRecorderClient,
 	profileToCollect *profileToCollect,
+	profileRecordingName string,
 	profileNamespacedName types.NamespacedName,
 	profileLabels map[string]string,
 ) (*seccompprofileapi.SeccompProfile, error) {
@@ -837,6 +1566,20 @@ func (r *RecorderReconciler) collectSeccompBpfProfile(
 		}},
 	}
 
+	if len(response.GetSyscallArgs()) > 0 {
+		recording, err := r.GetRecording(ctx, r.client, types.NamespacedName{
+			Name:      profileRecordingName,
+			Namespace: profileNamespacedName.Namespace,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get recording: %w", err)
+		}
+
+		profileSpec.Syscalls = syscallsWithArgs(
+			response.GetSyscalls(), response.GetSyscallArgs(), recording.Spec.RecordSyscallArgs,
+		)
+	}
+
 	profile := &seccompprofileapi.SeccompProfile{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      profileNamespacedName.Name,
@@ -849,6 +1592,73 @@ func (r *RecorderReconciler) collectSeccompBpfProfile(
 	return profile, nil
 }
 
+// syscallsWithArgs restricts the selected syscalls to their recorded
+// argument combinations. All other syscalls are allowed by name only.
+func syscallsWithArgs(
+	names []string,
+	recordedArgs []*bpfrecorderapi.SyscallArgs,
+	selected []string,
+) []seccompprofileapi.Syscall {
+	argRules := []seccompprofileapi.Syscall{}
+	restricted := sets.New[string]()
+
+	for _, syscallArgs := range recordedArgs {
+		name := syscallArgs.GetName()
+		if !slices.Contains(selected, name) || !slices.Contains(names, name) {
+			continue
+		}
+
+		rules, ok := syscallArgRules(name, syscallArgs.GetCombinations())
+		if !ok {
+			// Fall back to allowing the syscall by name only
+			continue
+		}
+
+		argRules = append(argRules, rules...)
+		restricted.Insert(name)
+	}
+
+	allowed := slices.DeleteFunc(slices.Clone(names), restricted.Has)
+	if len(allowed) == 0 {
+		return argRules
+	}
+
+	return append([]seccompprofileapi.Syscall{{
+		Action: seccompprofileapi.ActAllow,
+		Names:  allowed,
+	}}, argRules...)
+}
+
+func syscallArgRules(
+	name string, combinations []*bpfrecorderapi.SyscallArgs_Combination,
+) (rules []seccompprofileapi.Syscall, ok bool) {
+	for _, combination := range combinations {
+		args := []seccompprofileapi.Arg{}
+
+		for _, arg := range combination.GetArgs() {
+			// The profile API does not support values above MaxInt64
+			if arg.GetValue() > math.MaxInt64 || arg.GetValueTwo() > math.MaxInt64 {
+				return nil, false
+			}
+
+			args = append(args, seccompprofileapi.Arg{
+				Index:    ptr.To(int32(arg.GetIndex())),
+				Value:    int64(arg.GetValue()),
+				ValueTwo: int64(arg.GetValueTwo()),
+				Op:       seccompprofileapi.Operator(arg.GetOp()),
+			})
+		}
+
+		rules = append(rules, seccompprofileapi.Syscall{
+			Action: seccompprofileapi.ActAllow,
+			Names:  []string{name},
+			Args:   args,
+		})
+	}
+
+	return rules, len(rules) > 0
+}
+
 //nolint:dupl // This requires a specific profile type which prevents the reducton of duplicated code
 func (r *RecorderReconciler) updateOrCreateSeccompResource(
 	ctx context.Context,
@@ -982,7 +1792,8 @@ func (r *RecorderReconciler) generateAppArmorProfileAbstract(
 		abstract.Filesystem = &files
 	}
 
-	if response.GetSocket().GetUseRaw() || response.GetSocket().GetUseTcp() || response.GetSocket().GetUseUdp() {
+	if response.GetSocket().GetUseRaw() || response.GetSocket().GetUseTcp() || response.GetSocket().GetUseUdp() ||
+		len(response.GetSocket().GetRules()) != 0 {
 		net := apparmorprofileapi.AppArmorNetworkRules{}
 		proto := apparmorprofileapi.AppArmorAllowedProtocols{}
 
@@ -1000,6 +1811,13 @@ func (r *RecorderReconciler) generateAppArmorProfileAbstract(
 			net.Protocols = &proto
 		}
 
+		for _, rule := range response.GetSocket().GetRules() {
+			net.Rules = append(net.Rules, apparmorprofileapi.AppArmorNetworkRule{
+				Family: rule.GetFamily(),
+				Type:   rule.GetType(),
+			})
+		}
+
 		abstract.Network = &net
 	}
 
@@ -1053,6 +1871,99 @@ func (r *RecorderReconciler) updateOrCreateApparmorResource(
 	return nil
 }
 
+func (r *RecorderReconciler) collectSelinuxBpfProfile(
+	ctx context.Context,
+	recorderClient bpfrecorderapi.BpfRecorderClient,
+	profileToCollect *profileToCollect,
+	profileNamespacedName types.NamespacedName,
+	profileLabels map[string]string,
+) (*selinuxprofileapi.SelinuxProfile, error) {
+	response, err := r.SelinuxForProfile(
+		ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profileToCollect.name},
+	)
+	if err != nil {
+		// Recording was not found for this profile, this might be an init container
+		// which is not longer active. Let's skip here and keep processing the
+		// next profile.
+		if grpcstatus.Convert(err).Message() == bpfrecorder.ErrNotFound.Error() {
+			r.log.Error(err, "Recorded profile not found", "name", profileToCollect.name)
+
+			return nil, errRecordedProfileNotFound
+		}
+
+		return nil, fmt.Errorf("getting selinux AVCs for profile: %w", err)
+	}
+
+	profile := &selinuxprofileapi.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      profileNamespacedName.Name,
+			Namespace: profileNamespacedName.Namespace,
+			Labels:    profileLabels,
+		},
+		Spec: selinuxprofileapi.SelinuxProfileSpec{
+			Inherit: []selinuxprofileapi.PolicyRef{
+				{
+					Kind: selinuxprofileapi.SystemPolicyKind,
+					Name: "container",
+				},
+			},
+		},
+	}
+
+	seBuilder := newSeProfileBuilder(profile.GetPolicyUsage(), r.log)
+	if err := seBuilder.AddBpfAvcList(response.GetAvc()); err != nil {
+		return nil, fmt.Errorf("consuming AVCs: %w", err)
+	}
+
+	profile.Spec.Allow, err = seBuilder.Format()
+	if err != nil {
+		return nil, fmt.Errorf("building policy: %w", err)
+	}
+
+	return profile, nil
+}
+
+//nolint:dupl // This requires a specific profile type which prevents the reducton of duplicated code
+func (r *RecorderReconciler) updateOrCreateSelinuxResource(
+	ctx context.Context,
+	profileRecordingName string,
+	profileNamespace string,
+	profile *selinuxprofileapi.SelinuxProfile,
+) error {
+	if err := r.setDisabled(ctx, r.client,
+		profileRecordingName, profileNamespace,
+		&profile.Spec.SpecBase); err != nil {
+		r.log.Error(err, "Cannot set the disable flag on profile",
+			"name", profileRecordingName,
+			"namespace", profileNamespace,
+		)
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+
+		return fmt.Errorf("disabling profile after recording: %w", err)
+	}
+
+	profileSpec := profile.Spec
+
+	res, err := r.CreateOrUpdate(ctx, r.client, profile,
+		func() error {
+			profile.Spec = profileSpec
+
+			return nil
+		},
+	)
+	if err != nil {
+		r.log.Error(err, "Cannot create profile resource")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+
+		return fmt.Errorf("creating profile resource: %w", err)
+	}
+
+	r.log.Info("Created/updated profile", "action", res, "name", profileNamespace)
+	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "selinuxprofile profile created")
+
+	return nil
+}
+
 type parsedAnnotation struct {
 	profileName string
 	cntName     string
@@ -1077,8 +1988,14 @@ func parseProfileAnnotation(annotation string) (*parsedAnnotation, error) {
 	}, nil
 }
 
-func createProfileName(cntName, replicaSuffix, namespace, profileName string) types.NamespacedName {
+func createProfileName(
+	cntName, replicaSuffix, namespace, profileName, workloadName string,
+) types.NamespacedName {
 	name := fmt.Sprintf("%s-%s", profileName, cntName)
+	if workloadName != "" {
+		name = fmt.Sprintf("%s-%s-%s", profileName, workloadName, cntName)
+	}
+
 	if replicaSuffix != "" {
 		name = fmt.Sprintf("%s-%s", name, replicaSuffix)
 	}
@@ -1130,6 +2047,8 @@ func parseBpfAnnotations(annotations map[string]string) (res []profileToCollect,
 			collectProfile.kind = profilerecordingapi.ProfileRecordingKindSeccompProfile
 		} else if strings.HasPrefix(key, config.ApparmorProfileRecordBpfAnnotationKey) {
 			collectProfile.kind = profilerecordingapi.ProfileRecordingKindAppArmorProfile
+		} else if strings.HasPrefix(key, config.SelinuxProfileRecordBpfAnnotationKey) {
+			collectProfile.kind = profilerecordingapi.ProfileRecordingKindSelinuxProfile
 		} else {
 			continue
 		}
@@ -1168,7 +2087,24 @@ func newSeProfileBuilder(usageCtx string, log logr.Logger) *seProfileBuilder {
 	}
 }
 
+// selinuxAvc is an AVC reported by either the log enricher or the bpf
+// recorder.
+type selinuxAvc interface {
+	GetPerm() string
+	GetScontext() string
+	GetTcontext() string
+	GetTclass() string
+}
+
 func (sb *seProfileBuilder) AddAvcList(avcs []*enricherapi.AvcResponse_SelinuxAvc) error {
+	return addAvcList(sb, avcs)
+}
+
+func (sb *seProfileBuilder) AddBpfAvcList(avcs []*bpfrecorderapi.SelinuxResponse_SelinuxAvc) error {
+	return addAvcList(sb, avcs)
+}
+
+func addAvcList[T selinuxAvc](sb *seProfileBuilder, avcs []T) error {
 	for _, avc := range avcs {
 		sb.log.Info("Received an AVC response",
 			"perm", avc.GetPerm(), "tclass",
@@ -1183,7 +2119,7 @@ func (sb *seProfileBuilder) AddAvcList(avcs []*enricherapi.AvcResponse_SelinuxAv
 	return nil
 }
 
-func (sb *seProfileBuilder) addAvc(avc *enricherapi.AvcResponse_SelinuxAvc) error {
+func (sb *seProfileBuilder) addAvc(avc selinuxAvc) error {
 	ctxType, err := ctxt2type(avc.GetTcontext())
 	if err != nil {
 		return fmt.Errorf("converting context to type: %w", err)
@@ -1288,7 +2224,7 @@ func profilePartial(
 	switch recorder.Spec.MergeStrategy {
 	case profilerecordingapi.ProfileMergeNone:
 		profilePartial = false
-	case profilerecordingapi.ProfileMergeContainers:
+	case profilerecordingapi.ProfileMergeContainers, profilerecordingapi.ProfileMergeContinuous:
 		profilePartial = true
 	}
 
@@ -1297,6 +2233,7 @@ func profilePartial(
 
 func profileLabels(
 	ctx context.Context, r *RecorderReconciler, recordingName, cntName, namespace string,
+	workload *recordedWorkload,
 ) (map[string]string, error) {
 	errs := validation.IsDNS1123Label(recordingName)
 	if len(errs) > 0 {
@@ -1309,6 +2246,14 @@ func profileLabels(
 		profilerecordingapi.ProfileToRecordingNamespaceLabel: namespace,
 	}
 
+	if workload != nil {
+		labels[profilerecordingapi.ProfileToWorkloadKindLabel] = workload.owner.Kind
+		// Object names can exceed the maximum length of label values
+		if len(validation.IsValidLabelValue(workload.owner.Name)) == 0 {
+			labels[profilerecordingapi.ProfileToWorkloadNameLabel] = workload.owner.Name
+		}
+	}
+
 	partial, err := profilePartial(ctx, r, recordingName, namespace)
 	if err != nil {
 		return nil, err
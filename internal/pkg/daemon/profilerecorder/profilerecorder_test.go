@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"runtime"
 	"testing"
 	"time"
@@ -27,13 +28,19 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -41,9 +48,12 @@ import (
 	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	recordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
 	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder/profilerecorderfakes"
 )
 
@@ -258,6 +268,102 @@ func TestReconcile(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{ // seccomp BPF success collect with syscall arguments
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableBpfRecorder: ptrTrue()}},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"clone", "mkdir", "prctl", "socket"},
+						GoArch:   runtime.GOARCH,
+						SyscallArgs: []*bpfrecorderapi.SyscallArgs{
+							{
+								Name: "prctl",
+								Combinations: []*bpfrecorderapi.SyscallArgs_Combination{
+									{Args: []*bpfrecorderapi.SyscallArgs_Arg{{Index: 0, Value: 1, Op: "SCMP_CMP_EQ"}}},
+								},
+							},
+							{
+								Name: "socket",
+								Combinations: []*bpfrecorderapi.SyscallArgs_Combination{
+									{Args: []*bpfrecorderapi.SyscallArgs_Arg{
+										{Index: 0, Value: 2, Op: "SCMP_CMP_EQ"},
+										{Index: 1, Value: 0xf, ValueTwo: 1, Op: "SCMP_CMP_MASKED_EQ"},
+									}},
+									{Args: []*bpfrecorderapi.SyscallArgs_Arg{
+										{Index: 0, Value: 10, Op: "SCMP_CMP_EQ"},
+										{Index: 1, Value: 0xf, ValueTwo: 2, Op: "SCMP_CMP_MASKED_EQ"},
+									}},
+								},
+							},
+							{
+								Name: "clone",
+								Combinations: []*bpfrecorderapi.SyscallArgs_Combination{
+									{Args: []*bpfrecorderapi.SyscallArgs_Arg{{Index: 0, Value: math.MaxUint64, Op: "SCMP_CMP_EQ"}}},
+								},
+							},
+						},
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.NoError(t, err)
+
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					require.True(t, ok)
+
+					syscalls := profile.Spec.Syscalls
+					require.Len(t, syscalls, 3)
+					// prctl is not selected and clone exceeds the supported value range
+					assert.Equal(t, []string{"clone", "mkdir", "prctl"}, syscalls[0].Names)
+					assert.Empty(t, syscalls[0].Args)
+					assert.Equal(t, []string{"socket"}, syscalls[1].Names)
+					assert.Equal(t, []seccompprofileapi.Arg{
+						{Index: ptr.To[int32](0), Value: 2, Op: seccompprofileapi.OpEqualTo},
+						{Index: ptr.To[int32](1), Value: 0xf, ValueTwo: 1, Op: seccompprofileapi.OpMaskedEqual},
+					}, syscalls[1].Args)
+					assert.Equal(t, []string{"socket"}, syscalls[2].Names)
+					assert.EqualValues(t, 10, syscalls[2].Args[0].Value)
+
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						RecordSyscallArgs: []string{"clone", "socket"},
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NoError(t, err)
+			},
+		},
 		{ //nolint:dupl // test duplicates are fine
 			// seccomp BPF GoArchToSeccompArch fails
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
@@ -922,6 +1028,133 @@ func TestReconcile(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{ //nolint:dupl // test duplicates are fine
+			// selinux BPF success collect
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_selinux_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSelinuxProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SelinuxProfileRecordBpfAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableBpfRecorder: ptrTrue()}},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, nil)
+				mock.SelinuxForProfileReturns(
+					&bpfrecorderapi.SelinuxResponse{
+						Avc: []*bpfrecorderapi.SelinuxResponse_SelinuxAvc{
+							{
+								Perm:     "read",
+								Scontext: "system_u:system_r:selinuxrecording.process:s0:c4,c0",
+								Tcontext: "system_u:object_r:container_file_t:s0:c4,c0",
+								Tclass:   "file",
+							},
+						},
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.NoError(t, err)
+
+					profile, ok := obj.(*selinuxprofileapi.SelinuxProfile)
+					assert.True(t, ok)
+					assert.Len(t, profile.Spec.Allow, 1)
+
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{ //nolint:dupl // test duplicates are fine
+			// selinux BPF SelinuxForProfile returns not found
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_selinux_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSelinuxProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SelinuxProfileRecordBpfAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableBpfRecorder: ptrTrue()}},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, nil)
+				mock.SelinuxForProfileReturns(nil, bpfrecorder.ErrNotFound)
+				mock.StopBpfRecorderReturns(nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{ //nolint:dupl // test duplicates are fine
+			// selinux BPF SelinuxForProfile fails
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_selinux_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSelinuxProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SelinuxProfileRecordBpfAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableBpfRecorder: ptrTrue()}},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, nil)
+				mock.SelinuxForProfileReturns(nil, errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Error(t, err)
+			},
+		},
 		{ // apparmor BPF DialBpfRecorder fails
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				mock.GetPodReturns(&corev1.Pod{
@@ -1683,6 +1916,713 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileBoundedRecording(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileName := fmt.Sprintf("recording_container_4bbwm_%d", time.Now().Unix())
+	recordingName := types.NamespacedName{Namespace: "namespace", Name: "recording"}
+
+	runningPod := func(startTime time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			Status: corev1.PodStatus{
+				Phase:     corev1.PodRunning,
+				StartTime: &metav1.Time{Time: startTime},
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					config.SeccompProfileRecordLogsAnnotationKey: profileName,
+				},
+			},
+		}
+	}
+
+	watchPod := func(sut *RecorderReconciler, bounds *recordingBounds) {
+		sut.podsToWatch.Store(testRequest.String(), podToWatch{
+			recorder: recordingapi.ProfileRecorderLogs,
+			profiles: []profileToCollect{
+				{
+					kind: recordingapi.ProfileRecordingKindSeccompProfile,
+					name: profileName,
+				},
+			},
+			bounds: bounds,
+		})
+	}
+
+	prepareCollect := func(mock *profilerecorderfakes.FakeImpl) {
+		mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+			Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableLogEnricher: ptrTrue()}},
+		}, nil)
+		conn, err := grpc.NewClient("passthrough:///enricher", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		mock.DialEnricherReturns(conn, nil)
+		mock.SyscallsReturns(
+			&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH, Syscalls: []string{"read"}}, nil,
+		)
+		mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+	}
+
+	assertCompleted := func(mock *profilerecorderfakes.FakeImpl, reason string) {
+		require.Positive(t, mock.UpdateRecordingStatusCallCount())
+		_, _, recording := mock.UpdateRecordingStatusArgsForCall(mock.UpdateRecordingStatusCallCount() - 1)
+		cond := meta.FindStatusCondition(recording.Status.Conditions, recordingapi.ProfileRecordingConditionCompleted)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, reason, cond.Reason)
+	}
+
+	for _, tc := range []struct {
+		prepare func(*RecorderReconciler, *profilerecorderfakes.FakeImpl)
+		assert  func(*RecorderReconciler, *profilerecorderfakes.FakeImpl, reconcile.Result, error)
+	}{
+		{ // success pending bounded recording
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.ClientGetCalls(func(
+					_ context.Context, _ client.Client, key client.ObjectKey, obj client.Object,
+				) error {
+					assert.Equal(t, recordingName, key)
+					recording, ok := obj.(*recordingapi.ProfileRecording)
+					require.True(t, ok)
+					recording.Spec.Duration = &metav1.Duration{Duration: time.Minute}
+
+					return nil
+				})
+			},
+			assert: func(sut *RecorderReconciler, _ *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, boundsCheckInterval, res.RequeueAfter)
+
+				v, ok := sut.podsToWatch.Load(testRequest.String())
+				require.True(t, ok)
+				pod, ok := v.(podToWatch)
+				require.True(t, ok)
+				require.NotNil(t, pod.bounds)
+				assert.Equal(t, recordingName, pod.bounds.recording)
+				assert.Equal(t, time.Minute, pod.bounds.duration.Duration)
+			},
+		},
+		{ // success pending unbounded recording
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, _ *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Zero(t, res.RequeueAfter)
+
+				v, ok := sut.podsToWatch.Load(testRequest.String())
+				require.True(t, ok)
+				pod, ok := v.(podToWatch)
+				require.True(t, ok)
+				assert.Nil(t, pod.bounds)
+			},
+		},
+		{ // failure pending ClientGet fails
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.ClientGetReturns(errTest)
+			},
+			assert: func(_ *RecorderReconciler, _ *profilerecorderfakes.FakeImpl, _ reconcile.Result, err error) {
+				require.Error(t, err)
+			},
+		},
+		{ // success running duration not reached
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording: recordingName,
+					duration:  &metav1.Duration{Duration: time.Hour},
+				})
+				mock.GetPodReturns(runningPod(time.Now()), nil)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, boundsCheckInterval, res.RequeueAfter)
+				assert.Zero(t, mock.UpdateRecordingStatusCallCount())
+			},
+		},
+		{ // success running duration reached
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording: recordingName,
+					duration:  &metav1.Duration{Duration: time.Minute},
+				})
+				mock.GetPodReturns(runningPod(time.Now().Add(-2*time.Minute)), nil)
+				prepareCollect(mock)
+			},
+			assert: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Zero(t, res.RequeueAfter)
+				assert.Equal(t, 1, mock.CreateOrUpdateCallCount())
+				assertCompleted(mock, recordingapi.ProfileRecordingReasonDurationReached)
+
+				_, ok := sut.podsToWatch.Load(testRequest.String())
+				assert.False(t, ok)
+			},
+		},
+		{ // success running pod ready
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording:     recordingName,
+					untilPodReady: &metav1.Duration{Duration: time.Minute},
+				})
+				pod := runningPod(time.Now())
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+				}}
+				mock.GetPodReturns(pod, nil)
+				prepareCollect(mock)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, _ reconcile.Result, err error) {
+				require.NoError(t, err)
+				assertCompleted(mock, recordingapi.ProfileRecordingReasonPodReady)
+			},
+		},
+		{ // success running still active
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording:     recordingName,
+					stopAfterIdle: &metav1.Duration{Duration: time.Minute},
+					lastActivity:  time.Now().Add(-2 * time.Minute),
+				})
+				mock.GetPodReturns(runningPod(time.Now()), nil)
+				prepareCollect(mock)
+			},
+			assert: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, boundsCheckInterval, res.RequeueAfter)
+				assert.Zero(t, mock.UpdateRecordingStatusCallCount())
+
+				v, ok := sut.podsToWatch.Load(testRequest.String())
+				require.True(t, ok)
+				pod, ok := v.(podToWatch)
+				require.True(t, ok)
+				assert.Equal(t, 1, pod.bounds.recordedEvents)
+			},
+		},
+		{ // success running idle
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording:      recordingName,
+					stopAfterIdle:  &metav1.Duration{Duration: time.Minute},
+					recordedEvents: 1,
+					lastActivity:   time.Now().Add(-2 * time.Minute),
+				})
+				mock.GetPodReturns(runningPod(time.Now()), nil)
+				prepareCollect(mock)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, _ reconcile.Result, err error) {
+				require.NoError(t, err)
+				assertCompleted(mock, recordingapi.ProfileRecordingReasonIdle)
+			},
+		},
+		{ // failure running Syscalls fails
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording:     recordingName,
+					stopAfterIdle: &metav1.Duration{Duration: time.Minute},
+					lastActivity:  time.Now(),
+				})
+				mock.GetPodReturns(runningPod(time.Now()), nil)
+				prepareCollect(mock)
+				mock.SyscallsReturns(nil, errTest)
+			},
+			assert: func(_ *RecorderReconciler, _ *profilerecorderfakes.FakeImpl, _ reconcile.Result, err error) {
+				require.Error(t, err)
+			},
+		},
+		{ // failure running UpdateRecordingStatus fails
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				watchPod(sut, &recordingBounds{
+					recording: recordingName,
+					duration:  &metav1.Duration{Duration: time.Minute},
+				})
+				mock.GetPodReturns(runningPod(time.Now().Add(-2*time.Minute)), nil)
+				prepareCollect(mock)
+				mock.UpdateRecordingStatusReturns(errTest)
+			},
+			assert: func(_ *RecorderReconciler, _ *profilerecorderfakes.FakeImpl, _ reconcile.Result, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		mock := &profilerecorderfakes.FakeImpl{}
+		sut := &RecorderReconciler{
+			impl:   mock,
+			log:    logr.Discard(),
+			record: record.NewFakeRecorder(10),
+		}
+		tc.prepare(sut, mock)
+
+		res, err := sut.Reconcile(t.Context(), testRequest)
+		tc.assert(sut, mock, res, err)
+	}
+}
+
+func TestReconcileRecordingStatus(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileName := fmt.Sprintf("recording_container_4bbwm_%d", time.Now().Unix())
+
+	for _, tc := range []struct {
+		prepareCollect func(*profilerecorderfakes.FakeImpl)
+		wantErr        bool
+		assert         func(*recordingapi.ProfileRecording)
+	}{
+		{ // success collected
+			prepareCollect: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.SyscallsReturns(
+					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH, Syscalls: []string{"read", "write"}}, nil,
+				)
+			},
+			assert: func(recording *recordingapi.ProfileRecording) {
+				require.Len(t, recording.Status.Containers, 1)
+				container := recording.Status.Containers[0]
+				assert.Equal(t, recordingapi.RecordedContainerPhaseCollected, container.Phase)
+				assert.Equal(t, int32(2), container.RecordedEvents)
+				assert.Equal(t, &recordingapi.RecordedProfileReference{
+					Kind: recordingapi.ProfileRecordingKindSeccompProfile,
+					Name: "recording-container",
+				}, container.Profile)
+
+				conditions := recording.Status.Conditions
+				assert.True(t, meta.IsStatusConditionFalse(conditions, recordingapi.ProfileRecordingConditionRecording))
+				assert.True(t, meta.IsStatusConditionFalse(conditions, recordingapi.ProfileRecordingConditionCollecting))
+				assert.True(t, meta.IsStatusConditionFalse(conditions, recordingapi.ProfileRecordingConditionFailed))
+			},
+		},
+		{ // failure collecting
+			prepareCollect: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.SyscallsReturns(nil, errTest)
+			},
+			wantErr: true,
+			assert: func(recording *recordingapi.ProfileRecording) {
+				require.Len(t, recording.Status.Containers, 1)
+				container := recording.Status.Containers[0]
+				assert.Equal(t, recordingapi.RecordedContainerPhaseFailed, container.Phase)
+				assert.Contains(t, container.Message, errTest.Error())
+				assert.Nil(t, container.Profile)
+				assert.True(t, meta.IsStatusConditionTrue(
+					recording.Status.Conditions, recordingapi.ProfileRecordingConditionFailed,
+				))
+			},
+		},
+	} {
+		mock := &profilerecorderfakes.FakeImpl{}
+		sut := &RecorderReconciler{
+			impl:   mock,
+			log:    logr.Discard(),
+			record: record.NewFakeRecorder(10),
+		}
+
+		recording := &recordingapi.ProfileRecording{}
+		mock.ClientGetCalls(func(_ context.Context, _ client.Client, _ client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *recordingapi.ProfileRecording:
+				recording.DeepCopyInto(o)
+			case *seccompprofileapi.SeccompProfile:
+				o.Spec.Syscalls = []seccompprofileapi.Syscall{{Names: []string{"read", "write"}}}
+			}
+
+			return nil
+		})
+		mock.UpdateRecordingStatusCalls(func(
+			_ context.Context, _ client.Client, updated *recordingapi.ProfileRecording,
+		) error {
+			updated.DeepCopyInto(recording)
+
+			return nil
+		})
+
+		// Start recording
+		mock.GetPodReturns(&corev1.Pod{
+			Spec:   corev1.PodSpec{NodeName: "node"},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					config.SeccompProfileRecordLogsAnnotationKey: profileName,
+				},
+			},
+		}, nil)
+
+		_, err := sut.Reconcile(t.Context(), testRequest)
+		require.NoError(t, err)
+
+		require.Len(t, recording.Status.Containers, 1)
+		container := recording.Status.Containers[0]
+		assert.Equal(t, "name", container.PodName)
+		assert.Equal(t, "container", container.ContainerName)
+		assert.Equal(t, "node", container.NodeName)
+		assert.Equal(t, recordingapi.ProfileRecorderLogs, container.Recorder)
+		assert.Equal(t, recordingapi.RecordedContainerPhaseRecording, container.Phase)
+		assert.NotNil(t, container.StartTime)
+		assert.True(t, meta.IsStatusConditionTrue(
+			recording.Status.Conditions, recordingapi.ProfileRecordingConditionRecording,
+		))
+
+		// Collect after the pod got removed
+		mock.GetPodReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, ""))
+		mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+			Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableLogEnricher: ptrTrue()}},
+		}, nil)
+		mock.DialEnricherReturns(nil, nil)
+		mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+		tc.prepareCollect(mock)
+
+		_, err = sut.Reconcile(t.Context(), testRequest)
+		if tc.wantErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+
+		tc.assert(recording)
+	}
+}
+
+func TestRecordedWorkload(t *testing.T) {
+	t.Parallel()
+
+	controllerRef := func(apiVersion, kind, name string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID(name),
+			Controller: ptr.To(true),
+		}}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		owners    []metav1.OwnerReference
+		recording *recordingapi.ProfileRecording
+		prepare   func(*profilerecorderfakes.FakeImpl)
+		want      *recordedWorkload
+	}{
+		{
+			name: "no controller",
+			want: nil,
+		},
+		{
+			name:   "deployment",
+			owners: controllerRef("apps/v1", "ReplicaSet", "web-5d8f"),
+			recording: &recordingapi.ProfileRecording{Spec: recordingapi.ProfileRecordingSpec{
+				ProfileNaming: recordingapi.ProfileNamingWorkload,
+			}},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetCalls(func(_ context.Context, _ client.Client, _ client.ObjectKey, obj client.Object) error {
+					obj.SetOwnerReferences(controllerRef("apps/v1", "Deployment", "web"))
+
+					return nil
+				})
+			},
+			want: &recordedWorkload{
+				owner:        controllerRef("apps/v1", "Deployment", "web")[0],
+				nameProfiles: true,
+			},
+		},
+		{
+			name:   "cronjob",
+			owners: controllerRef("batch/v1", "Job", "backup-2901"),
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetCalls(func(_ context.Context, _ client.Client, _ client.ObjectKey, obj client.Object) error {
+					if _, ok := obj.(*batchv1.Job); ok {
+						obj.SetOwnerReferences(controllerRef("batch/v1", "CronJob", "backup"))
+					}
+
+					return nil
+				})
+			},
+			want: &recordedWorkload{owner: controllerRef("batch/v1", "CronJob", "backup")[0]},
+		},
+		{
+			name:   "statefulset",
+			owners: controllerRef("apps/v1", "StatefulSet", "db"),
+			want:   &recordedWorkload{owner: controllerRef("apps/v1", "StatefulSet", "db")[0]},
+		},
+		{
+			name:   "replicaset without controller",
+			owners: controllerRef("apps/v1", "ReplicaSet", "web-5d8f"),
+			want:   &recordedWorkload{owner: controllerRef("apps/v1", "ReplicaSet", "web-5d8f")[0]},
+		},
+		{
+			name:   "replicaset not retrievable",
+			owners: controllerRef("apps/v1", "ReplicaSet", "web-5d8f"),
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetReturns(errTest)
+			},
+			want: &recordedWorkload{owner: controllerRef("apps/v1", "ReplicaSet", "web-5d8f")[0]},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			sut := &RecorderReconciler{impl: mock, log: logr.Discard()}
+
+			if tc.prepare != nil {
+				tc.prepare(mock)
+			}
+
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod",
+				Namespace:       "namespace",
+				OwnerReferences: tc.owners,
+			}}
+
+			assert.Equal(t, tc.want, sut.recordedWorkload(t.Context(), pod, tc.recording))
+		})
+	}
+}
+
+func TestReconcileRecordedWorkload(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileName := fmt.Sprintf("recording_container_4bbwm_%d", time.Now().Unix())
+	deployment := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "web",
+		UID:        "uid",
+		Controller: ptr.To(true),
+	}
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	sut := &RecorderReconciler{
+		impl:   mock,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+
+	mock.ClientGetCalls(func(_ context.Context, _ client.Client, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *recordingapi.ProfileRecording:
+			o.Spec.ProfileNaming = recordingapi.ProfileNamingWorkload
+		case *appsv1.ReplicaSet:
+			o.OwnerReferences = []metav1.OwnerReference{deployment}
+		}
+
+		return nil
+	})
+
+	// Start recording
+	mock.GetPodReturns(&corev1.Pod{
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordLogsAnnotationKey: profileName,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "web-5d8f",
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "container", Image: "nginx:1"}},
+		},
+	}, nil)
+
+	_, err := sut.Reconcile(t.Context(), testRequest)
+	require.NoError(t, err)
+
+	// Collect after the pod got removed
+	mock.GetPodReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, ""))
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableLogEnricher: ptrTrue()}},
+	}, nil)
+	mock.DialEnricherReturns(nil, nil)
+	mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+	mock.SyscallsReturns(&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH, Syscalls: []string{"read"}}, nil)
+
+	_, err = sut.Reconcile(t.Context(), testRequest)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mock.CreateOrUpdateCallCount())
+	_, _, obj, _ := mock.CreateOrUpdateArgsForCall(0)
+	assert.Equal(t, "recording-web-container", obj.GetName())
+	assert.Equal(t, "Deployment", obj.GetLabels()[recordingapi.ProfileToWorkloadKindLabel])
+	assert.Equal(t, "web", obj.GetLabels()[recordingapi.ProfileToWorkloadNameLabel])
+	// Cluster scoped profiles cannot be owned by the namespaced workload
+	assert.Empty(t, obj.GetOwnerReferences())
+	assert.Equal(t, "nginx:1", obj.GetAnnotations()[recordingapi.ProfileContainerImagesAnnotation])
+}
+
+func TestLogProfiles(t *testing.T) {
+	t.Parallel()
+
+	const profileID = "recording_container_4bbwm_1"
+
+	debugID := enricher.DebugProfileKey(profileID)
+	annotation := &parsedAnnotation{profileName: "recording", cntName: "container"}
+
+	for _, tc := range []struct {
+		policy       recordingapi.ExecPolicy
+		wantCntNames []string
+		wantCollect  [][]string
+		wantDiscard  [][]string
+	}{
+		{
+			policy:       recordingapi.ExecPolicyInclude,
+			wantCntNames: []string{"container"},
+			wantCollect:  [][]string{{profileID, debugID}},
+			wantDiscard:  [][]string{nil},
+		},
+		{
+			policy:       recordingapi.ExecPolicyExclude,
+			wantCntNames: []string{"container"},
+			wantCollect:  [][]string{{profileID}},
+			wantDiscard:  [][]string{{debugID}},
+		},
+		{
+			policy:       recordingapi.ExecPolicySeparate,
+			wantCntNames: []string{"container", "container-debug"},
+			wantCollect:  [][]string{{profileID}, {debugID}},
+			wantDiscard:  [][]string{nil, nil},
+		},
+	} {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			t.Parallel()
+
+			watched := &podToWatch{execPolicy: tc.policy}
+			profiles := watched.logProfiles(annotation, profileID)
+			require.Len(t, profiles, len(tc.wantCntNames))
+
+			for i := range profiles {
+				assert.Equal(t, "recording", profiles[i].annotation.profileName)
+				assert.Equal(t, tc.wantCntNames[i], profiles[i].annotation.cntName)
+				assert.Equal(t, tc.wantCollect[i], profiles[i].collect)
+				assert.Equal(t, tc.wantDiscard[i], profiles[i].discard)
+			}
+		})
+	}
+
+	// The annotation of the recorded container stays untouched
+	assert.Equal(t, "container", annotation.cntName)
+}
+
+func TestReconcileSeparateExecSessions(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileID := fmt.Sprintf("recording_container_4bbwm_%d", time.Now().Unix())
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	sut := &RecorderReconciler{
+		impl:   mock,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+
+	mock.ClientGetCalls(func(_ context.Context, _ client.Client, _ client.ObjectKey, obj client.Object) error {
+		if o, ok := obj.(*recordingapi.ProfileRecording); ok {
+			o.Spec.ExecPolicy = recordingapi.ExecPolicySeparate
+		}
+
+		return nil
+	})
+
+	// Start recording
+	mock.GetPodReturns(&corev1.Pod{
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordLogsAnnotationKey: profileID,
+			},
+		},
+	}, nil)
+
+	_, err := sut.Reconcile(t.Context(), testRequest)
+	require.NoError(t, err)
+
+	// Collect after the pod got removed
+	mock.GetPodReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, ""))
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{Enricher: spodapi.SPODEnricherConfig{EnableLogEnricher: ptrTrue()}},
+	}, nil)
+	mock.DialEnricherReturns(nil, nil)
+	mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+	mock.SyscallsCalls(func(
+		_ context.Context, _ enricherapi.EnricherClient, req *enricherapi.SyscallsRequest,
+	) (*enricherapi.SyscallsResponse, error) {
+		syscalls := []string{"read"}
+		if req.GetProfile() == enricher.DebugProfileKey(profileID) {
+			syscalls = []string{"execve"}
+		}
+
+		return &enricherapi.SyscallsResponse{GoArch: runtime.GOARCH, Syscalls: syscalls}, nil
+	})
+
+	_, err = sut.Reconcile(t.Context(), testRequest)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, mock.CreateOrUpdateCallCount())
+
+	for i, want := range []struct {
+		name     string
+		syscalls []string
+	}{
+		{name: "recording-container", syscalls: []string{"read"}},
+		{name: "recording-container-debug", syscalls: []string{"execve"}},
+	} {
+		_, _, obj, _ := mock.CreateOrUpdateArgsForCall(i)
+		profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+		require.True(t, ok)
+		assert.Equal(t, want.name, profile.GetName())
+		assert.Equal(t, want.syscalls, profile.Spec.Syscalls[0].Names)
+	}
+
+	// Both enricher keys got reset
+	require.Equal(t, 2, mock.ResetSyscallsCallCount())
+}
+
 func TestIsPodOnLocalNode(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +256,21 @@ type FakeImpl struct {
 	resetSyscallsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SelinuxForProfileStub        func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.ProfileRequest) (*api_bpfrecorder.SelinuxResponse, error)
+	selinuxForProfileMutex       sync.RWMutex
+	selinuxForProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.ProfileRequest
+	}
+	selinuxForProfileReturns struct {
+		result1 *api_bpfrecorder.SelinuxResponse
+		result2 error
+	}
+	selinuxForProfileReturnsOnCall map[int]struct {
+		result1 *api_bpfrecorder.SelinuxResponse
+		result2 error
+	}
 	StartBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient) error
 	startBpfRecorderMutex       sync.RWMutex
 	startBpfRecorderArgsForCall []struct {
@@ -310,6 +325,19 @@ type FakeImpl struct {
 		result1 *api_bpfrecorder.SyscallsResponse
 		result2 error
 	}
+	UpdateRecordingStatusStub        func(context.Context, client.Client, *v1a.ProfileRecording) error
+	updateRecordingStatusMutex       sync.RWMutex
+	updateRecordingStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1a.ProfileRecording
+	}
+	updateRecordingStatusReturns struct {
+		result1 error
+	}
+	updateRecordingStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1328,6 +1356,72 @@ func (fake *FakeImpl) ResetSyscallsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) SelinuxForProfile(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient, arg3 *api_bpfrecorder.ProfileRequest) (*api_bpfrecorder.SelinuxResponse, error) {
+	fake.selinuxForProfileMutex.Lock()
+	ret, specificReturn := fake.selinuxForProfileReturnsOnCall[len(fake.selinuxForProfileArgsForCall)]
+	fake.selinuxForProfileArgsForCall = append(fake.selinuxForProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.ProfileRequest
+	}{arg1, arg2, arg3})
+	stub := fake.SelinuxForProfileStub
+	fakeReturns := fake.selinuxForProfileReturns
+	fake.recordInvocation("SelinuxForProfile", []interface{}{arg1, arg2, arg3})
+	fake.selinuxForProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) SelinuxForProfileCallCount() int {
+	fake.selinuxForProfileMutex.RLock()
+	defer fake.selinuxForProfileMutex.RUnlock()
+	return len(fake.selinuxForProfileArgsForCall)
+}
+
+func (fake *FakeImpl) SelinuxForProfileCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.ProfileRequest) (*api_bpfrecorder.SelinuxResponse, error)) {
+	fake.selinuxForProfileMutex.Lock()
+	defer fake.selinuxForProfileMutex.Unlock()
+	fake.SelinuxForProfileStub = stub
+}
+
+func (fake *FakeImpl) SelinuxForProfileArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.ProfileRequest) {
+	fake.selinuxForProfileMutex.RLock()
+	defer fake.selinuxForProfileMutex.RUnlock()
+	argsForCall := fake.selinuxForProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) SelinuxForProfileReturns(result1 *api_bpfrecorder.SelinuxResponse, result2 error) {
+	fake.selinuxForProfileMutex.Lock()
+	defer fake.selinuxForProfileMutex.Unlock()
+	fake.SelinuxForProfileStub = nil
+	fake.selinuxForProfileReturns = struct {
+		result1 *api_bpfrecorder.SelinuxResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SelinuxForProfileReturnsOnCall(i int, result1 *api_bpfrecorder.SelinuxResponse, result2 error) {
+	fake.selinuxForProfileMutex.Lock()
+	defer fake.selinuxForProfileMutex.Unlock()
+	fake.SelinuxForProfileStub = nil
+	if fake.selinuxForProfileReturnsOnCall == nil {
+		fake.selinuxForProfileReturnsOnCall = make(map[int]struct {
+			result1 *api_bpfrecorder.SelinuxResponse
+			result2 error
+		})
+	}
+	fake.selinuxForProfileReturnsOnCall[i] = struct {
+		result1 *api_bpfrecorder.SelinuxResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) StartBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient) error {
 	fake.startBpfRecorderMutex.Lock()
 	ret, specificReturn := fake.startBpfRecorderReturnsOnCall[len(fake.startBpfRecorderArgsForCall)]
@@ -1584,9 +1678,74 @@ func (fake *FakeImpl) SyscallsForProfileReturnsOnCall(i int, result1 *api_bpfrec
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) UpdateRecordingStatus(arg1 context.Context, arg2 client.Client, arg3 *v1a.ProfileRecording) error {
+	fake.updateRecordingStatusMutex.Lock()
+	ret, specificReturn := fake.updateRecordingStatusReturnsOnCall[len(fake.updateRecordingStatusArgsForCall)]
+	fake.updateRecordingStatusArgsForCall = append(fake.updateRecordingStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1a.ProfileRecording
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRecordingStatusStub
+	fakeReturns := fake.updateRecordingStatusReturns
+	fake.recordInvocation("UpdateRecordingStatus", []interface{}{arg1, arg2, arg3})
+	fake.updateRecordingStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusCallCount() int {
+	fake.updateRecordingStatusMutex.RLock()
+	defer fake.updateRecordingStatusMutex.RUnlock()
+	return len(fake.updateRecordingStatusArgsForCall)
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusCalls(stub func(context.Context, client.Client, *v1a.ProfileRecording) error) {
+	fake.updateRecordingStatusMutex.Lock()
+	defer fake.updateRecordingStatusMutex.Unlock()
+	fake.UpdateRecordingStatusStub = stub
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusArgsForCall(i int) (context.Context, client.Client, *v1a.ProfileRecording) {
+	fake.updateRecordingStatusMutex.RLock()
+	defer fake.updateRecordingStatusMutex.RUnlock()
+	argsForCall := fake.updateRecordingStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusReturns(result1 error) {
+	fake.updateRecordingStatusMutex.Lock()
+	defer fake.updateRecordingStatusMutex.Unlock()
+	fake.UpdateRecordingStatusStub = nil
+	fake.updateRecordingStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusReturnsOnCall(i int, result1 error) {
+	fake.updateRecordingStatusMutex.Lock()
+	defer fake.updateRecordingStatusMutex.Unlock()
+	fake.UpdateRecordingStatusStub = nil
+	if fake.updateRecordingStatusReturnsOnCall == nil {
+		fake.updateRecordingStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRecordingStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.updateRecordingStatusMutex.RLock()
+	defer fake.updateRecordingStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	Getenv(string) string
+	InClusterConfig() (*rest.Config, error)
+	NewClient(*rest.Config) (client.Client, error)
+	GetProfile(context.Context, client.Client, string) (*seccompprofileapi.SeccompProfile, error)
+	Dial() (*grpc.ClientConn, error)
+	Close(*grpc.ClientConn) error
+	SeccompNotifyInc(apimetrics.MetricsClient) (apimetrics.Metrics_SeccompNotifyIncClient, error)
+	SendMetric(apimetrics.Metrics_SeccompNotifyIncClient, *apimetrics.SeccompNotifyRequest) error
+	Stat(string) (os.FileInfo, error)
+	RemoveAll(string) error
+	Listen(string, string) (net.Listener, error)
+}
+
+func (d *defaultImpl) Getenv(key string) string {
+	return os.Getenv(key)
+}
+
+func (d *defaultImpl) InClusterConfig() (*rest.Config, error) {
+	return rest.InClusterConfig()
+}
+
+func (d *defaultImpl) NewClient(c *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := seccompprofileapi.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add seccomp profile API to scheme: %w", err)
+	}
+
+	return client.New(c, client.Options{Scheme: scheme})
+}
+
+func (d *defaultImpl) GetProfile(
+	ctx context.Context, c client.Client, name string,
+) (*seccompprofileapi.SeccompProfile, error) {
+	profile := &seccompprofileapi.SeccompProfile{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (d *defaultImpl) Dial() (*grpc.ClientConn, error) {
+	return metrics.Dial()
+}
+
+func (d *defaultImpl) Close(conn *grpc.ClientConn) error {
+	return conn.Close()
+}
+
+func (d *defaultImpl) SeccompNotifyInc(
+	client apimetrics.MetricsClient,
+) (apimetrics.Metrics_SeccompNotifyIncClient, error) {
+	return client.SeccompNotifyInc(context.Background())
+}
+
+func (d *defaultImpl) SendMetric(
+	client apimetrics.Metrics_SeccompNotifyIncClient,
+	in *apimetrics.SeccompNotifyRequest,
+) error {
+	return client.Send(in)
+}
+
+func (d *defaultImpl) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (d *defaultImpl) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+func (d *defaultImpl) Listen(network, address string) (net.Listener, error) {
+	return net.Listen(network, address)
+}
@@ -0,0 +1,257 @@
+//go:build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	seccomp "github.com/seccomp/libseccomp-golang"
+	"golang.org/x/sys/unix"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+)
+
+const (
+	// stateMsgSize is the maximum size of the container process state sent
+	// by the container runtime.
+	stateMsgSize = 1024 * 1024
+
+	// stateMaxFds is the maximum number of file descriptors accepted from
+	// the container runtime.
+	stateMaxFds = 8
+)
+
+// NotifyHandler returns the response to a seccomp user notification.
+type NotifyHandler func(fd seccomp.ScmpFd, req *seccomp.ScmpNotifReq) *seccomp.ScmpNotifResp
+
+// ReceiveContainerProcessState reads the container process state sent by the
+// container runtime and returns it together with the seccomp notify fd.
+func ReceiveContainerProcessState(conn *net.UnixConn) (*specs.ContainerProcessState, int, error) {
+	buf := make([]byte, stateMsgSize)
+	oob := make([]byte, unix.CmsgSpace(stateMaxFds*4))
+
+	n, oobn, _, _, err := conn.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return nil, -1, fmt.Errorf("read message: %w", err)
+	}
+
+	msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, -1, fmt.Errorf("parse control message: %w", err)
+	}
+
+	fds := []int{}
+
+	for i := range msgs {
+		rights, err := unix.ParseUnixRights(&msgs[i])
+		if err != nil {
+			continue
+		}
+
+		fds = append(fds, rights...)
+	}
+
+	state := &specs.ContainerProcessState{}
+	if err := json.Unmarshal(buf[:n], state); err != nil {
+		closeFds(fds)
+
+		return nil, -1, fmt.Errorf("unmarshal container process state: %w", err)
+	}
+
+	notifyFd, err := seccompNotifyFd(state, fds)
+	if err != nil {
+		closeFds(fds)
+
+		return nil, -1, err
+	}
+
+	// Only the seccomp notify fd is of interest.
+	closeFds(slices.DeleteFunc(fds, func(fd int) bool { return fd == notifyFd }))
+
+	return state, notifyFd, nil
+}
+
+// ServeNotifications responds to every seccomp user notification by using the
+// handler, until all processes of the filter exited.
+func ServeNotifications(notifyFd int, handle NotifyHandler) error {
+	fd := seccomp.ScmpFd(notifyFd)
+	pollFds := []unix.PollFd{{Fd: int32(notifyFd), Events: unix.POLLIN}}
+
+	for {
+		if _, err := unix.Poll(pollFds, -1); err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+
+			return fmt.Errorf("poll notify fd: %w", err)
+		}
+
+		if pollFds[0].Revents&unix.POLLHUP != 0 {
+			return nil
+		}
+
+		req, err := seccomp.NotifReceive(fd)
+		if errors.Is(err, unix.ENOENT) {
+			// The process got interrupted before we received the notification.
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("receive notification: %w", err)
+		}
+
+		if err := seccomp.NotifRespond(fd, handle(fd, req)); err != nil && !errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("respond to notification: %w", err)
+		}
+	}
+}
+
+// seccompNotifyFd returns the seccomp notify fd out of the received fds.
+func seccompNotifyFd(state *specs.ContainerProcessState, fds []int) (int, error) {
+	if len(state.Fds) != len(fds) {
+		return -1, fmt.Errorf(
+			"received %d file descriptors but %d are named", len(fds), len(state.Fds),
+		)
+	}
+
+	idx := slices.Index(state.Fds, specs.SeccompFdName)
+	if idx < 0 {
+		return -1, errors.New("no seccomp notify file descriptor received")
+	}
+
+	return fds[idx], nil
+}
+
+func closeFds(fds []int) {
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
+}
+
+func (s *SeccompAgent) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		s.logger.Info("Ignoring non unix seccomp agent connection")
+
+		return
+	}
+
+	state, notifyFd, err := ReceiveContainerProcessState(unixConn)
+	if err != nil {
+		s.logger.Error(err, "unable to receive container process state")
+
+		return
+	}
+	defer unix.Close(notifyFd)
+
+	containerID := state.State.ID
+	profile := state.Metadata
+	s.logger.Info("Serving seccomp notifications",
+		"containerID", containerID, "pid", state.Pid, "profile", profile)
+
+	policy := s.policy(profile)
+
+	if err := ServeNotifications(notifyFd, func(
+		fd seccomp.ScmpFd, req *seccomp.ScmpNotifReq,
+	) *seccomp.ScmpNotifResp {
+		return s.respond(fd, req, profile, policy)
+	}); err != nil {
+		s.logger.Error(err, "unable to serve seccomp notifications", "containerID", containerID)
+
+		return
+	}
+
+	s.logger.Info("Seccomp notifications done", "containerID", containerID)
+}
+
+func (s *SeccompAgent) respond(
+	fd seccomp.ScmpFd,
+	req *seccomp.ScmpNotifReq,
+	profile string,
+	policy *seccompprofileapi.NotifyPolicy,
+) *seccomp.ScmpNotifResp {
+	name, err := req.Data.Syscall.GetNameByArch(req.Data.Arch)
+	if err != nil {
+		// Unknown syscalls do not match any rule
+		s.logger.Info("No syscall name found for ID", "syscallID", req.Data.Syscall, "err", err.Error())
+	}
+
+	d := evaluatePolicy(policy, name, req.Data.Args, func(addr uint64) (string, error) {
+		return readProcessString(fd, req, addr)
+	})
+	s.report(profile, name, d)
+
+	resp := &seccomp.ScmpNotifResp{ID: req.ID}
+	if d.action == seccompprofileapi.NotifyActionDeny {
+		resp.Error = d.errnoRet
+	} else {
+		resp.Flags = seccomp.NotifRespFlagContinue
+	}
+
+	return resp
+}
+
+// readProcessString reads a NUL terminated string from the memory of the
+// process which triggered the notification.
+func readProcessString(fd seccomp.ScmpFd, req *seccomp.ScmpNotifReq, addr uint64) (string, error) {
+	mem, err := os.Open("/proc/" + strconv.FormatUint(uint64(req.Pid), 10) + "/mem")
+	if err != nil {
+		return "", fmt.Errorf("open process memory: %w", err)
+	}
+	defer mem.Close()
+
+	// The PID may have been reused by another process before opening its
+	// memory, which is not the case if the notification is still valid.
+	if err := seccomp.NotifIDValid(fd, req.ID); err != nil {
+		return "", fmt.Errorf("validate notification: %w", err)
+	}
+
+	pageSize := uint64(os.Getpagesize())
+	res := []byte{}
+	buf := make([]byte, pageSize)
+
+	for len(res) < unix.PathMax {
+		// Do not read across pages, the next one may not be mapped.
+		size := pageSize - addr%pageSize
+
+		n, err := mem.ReadAt(buf[:size], int64(addr)) //nolint:gosec // user space addresses fit
+		if idx := bytes.IndexByte(buf[:n], 0); idx >= 0 {
+			return string(append(res, buf[:idx]...)), nil
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("read process memory: %w", err)
+		}
+
+		res = append(res, buf[:n]...)
+		addr += uint64(n)
+	}
+
+	return "", errors.New("string exceeds maximum length")
+}
@@ -0,0 +1,135 @@
+//go:build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestReceiveContainerProcessState(t *testing.T) {
+	t.Parallel()
+
+	pair, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM, 0)
+	require.NoError(t, err)
+
+	sender := os.NewFile(uintptr(pair[0]), "sender")
+	defer sender.Close()
+
+	receiverFile := os.NewFile(uintptr(pair[1]), "receiver")
+	defer receiverFile.Close()
+
+	conn, err := net.FileConn(receiverFile)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	unixConn, ok := conn.(*net.UnixConn)
+	require.True(t, ok)
+
+	pidFd, seccompFd, err := os.Pipe()
+	require.NoError(t, err)
+
+	defer pidFd.Close()
+	defer seccompFd.Close()
+
+	state, err := json.Marshal(&specs.ContainerProcessState{
+		Fds:   []string{"pidFd", specs.SeccompFdName},
+		Pid:   1234,
+		State: specs.State{ID: "container-id"},
+	})
+	require.NoError(t, err)
+
+	rights := unix.UnixRights(int(pidFd.Fd()), int(seccompFd.Fd()))
+	require.NoError(t, unix.Sendmsg(int(sender.Fd()), state, rights, nil, 0))
+
+	res, notifyFd, err := ReceiveContainerProcessState(unixConn)
+	require.NoError(t, err)
+
+	defer unix.Close(notifyFd)
+
+	require.Equal(t, "container-id", res.State.ID)
+	require.Equal(t, 1234, res.Pid)
+
+	// The received fd refers to the write end of the pipe.
+	_, err = unix.Write(notifyFd, []byte("x"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 1)
+	_, err = pidFd.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "x", string(buf))
+}
+
+func TestSeccompNotifyFd(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		names     []string
+		fds       []int
+		wantFd    int
+		shouldErr bool
+	}{
+		{
+			name:   "only seccomp fd",
+			names:  []string{specs.SeccompFdName},
+			fds:    []int{10},
+			wantFd: 10,
+		},
+		{
+			name:   "multiple fds",
+			names:  []string{"pidFd", specs.SeccompFdName},
+			fds:    []int{10, 11},
+			wantFd: 11,
+		},
+		{
+			name:      "no seccomp fd",
+			names:     []string{"pidFd"},
+			fds:       []int{10},
+			shouldErr: true,
+		},
+		{
+			name:      "fd count mismatch",
+			names:     []string{"pidFd", specs.SeccompFdName},
+			fds:       []int{10},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fd, err := seccompNotifyFd(&specs.ContainerProcessState{Fds: tc.names}, tc.fds)
+			if tc.shouldErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantFd, fd)
+		})
+	}
+}
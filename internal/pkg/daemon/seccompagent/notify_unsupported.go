@@ -0,0 +1,32 @@
+//go:build !linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"errors"
+	"net"
+)
+
+var errUnsupportedPlatform = errors.New("unsupported platform")
+
+func (s *SeccompAgent) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	s.logger.Error(errUnsupportedPlatform, "unable to serve seccomp notifications")
+}
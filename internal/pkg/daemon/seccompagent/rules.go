@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"path"
+	"slices"
+	"syscall"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+)
+
+// defaultErrnoRet is the errno returned by denied syscalls if the policy does
+// not specify one.
+const defaultErrnoRet = int32(syscall.EPERM)
+
+// decision is the outcome of evaluating a notified syscall.
+type decision struct {
+	action   seccompprofileapi.NotifyAction
+	errnoRet int32
+}
+
+// stringReader reads a NUL terminated string at the provided address of the
+// calling process.
+type stringReader func(addr uint64) (string, error)
+
+// evaluatePolicy decides about a notified syscall. The first matching rule of
+// the policy wins, while a missing policy denies every syscall.
+//
+// Syscalls are never continued if the matching rule read a string argument
+// from the memory of the calling process: the kernel reads the argument again
+// when continuing the syscall, and another thread of the process may have
+// changed it in the meantime. Those syscalls get denied instead of being
+// allowed. Such rules are rejected on admission, this is a last line of
+// defense.
+func evaluatePolicy(
+	policy *seccompprofileapi.NotifyPolicy,
+	name string,
+	args []uint64,
+	readString stringReader,
+) decision {
+	if policy == nil {
+		return decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: defaultErrnoRet}
+	}
+
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if !slices.Contains(rule.Names, name) {
+			continue
+		}
+
+		readMemory := false
+		if !argsMatch(rule.Args, args, func(addr uint64) (string, error) {
+			readMemory = true
+
+			return readString(addr)
+		}) {
+			continue
+		}
+
+		errnoRet := rule.ErrnoRet
+		if errnoRet == 0 {
+			errnoRet = defaultErrnoRet
+		}
+
+		action := rule.Action
+		if readMemory {
+			action = seccompprofileapi.NotifyActionDeny
+		}
+
+		return decision{action: action, errnoRet: errnoRet}
+	}
+
+	action := policy.DefaultAction
+	if action == "" {
+		action = seccompprofileapi.NotifyActionDeny
+	}
+
+	return decision{action: action, errnoRet: defaultErrnoRet}
+}
+
+func argsMatch(
+	notifyArgs []seccompprofileapi.NotifyArg, args []uint64, readString stringReader,
+) bool {
+	for i := range notifyArgs {
+		if !argMatches(&notifyArgs[i], args, readString) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func argMatches(
+	notifyArg *seccompprofileapi.NotifyArg, args []uint64, readString stringReader,
+) bool {
+	if notifyArg.Index < 0 || int(notifyArg.Index) >= len(args) {
+		return false
+	}
+
+	arg := args[notifyArg.Index]
+
+	if len(notifyArg.Values) > 0 && !slices.Contains(notifyArg.Values, int64(arg)) {
+		return false
+	}
+
+	if len(notifyArg.Patterns) == 0 {
+		return true
+	}
+
+	value, err := readString(arg)
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(notifyArg.Patterns, func(pattern string) bool {
+		matched, err := path.Match(pattern, value)
+
+		return err == nil && matched
+	})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+)
+
+func TestEvaluatePolicy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		sourceAddr = 0x1000
+		targetAddr = 0x2000
+		badAddr    = 0x3000
+	)
+
+	memory := map[uint64]string{
+		sourceAddr: "/dev/sdb1",
+		targetAddr: "/mnt/data",
+	}
+
+	readString := func(addr uint64) (string, error) {
+		s, ok := memory[addr]
+		if !ok {
+			return "", errTest
+		}
+
+		return s, nil
+	}
+
+	mountPolicy := &seccompprofileapi.NotifyPolicy{
+		DefaultAction: seccompprofileapi.NotifyActionDeny,
+		Rules: []seccompprofileapi.NotifyRule{
+			{
+				Names:  []string{"mount"},
+				Action: seccompprofileapi.NotifyActionAllow,
+				Args: []seccompprofileapi.NotifyArg{
+					{Index: 0, Patterns: []string{"/dev/sd*"}},
+					{Index: 1, Patterns: []string{"/mnt/*"}},
+				},
+			},
+			{
+				Names:    []string{"mount"},
+				Action:   seccompprofileapi.NotifyActionDeny,
+				ErrnoRet: int32(syscall.EACCES),
+			},
+			{
+				Names:  []string{"umount2"},
+				Action: seccompprofileapi.NotifyActionLog,
+				Args: []seccompprofileapi.NotifyArg{
+					{Index: 1, Values: []int64{0, 2}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		policy   *seccompprofileapi.NotifyPolicy
+		syscall  string
+		args     []uint64
+		expected decision
+	}{
+		{
+			name:    "no policy",
+			syscall: "mount",
+			args:    []uint64{sourceAddr, targetAddr},
+			expected: decision{
+				action:   seccompprofileapi.NotifyActionDeny,
+				errnoRet: int32(syscall.EPERM),
+			},
+		},
+		{
+			name:     "matching patterns are never allowed",
+			policy:   mountPolicy,
+			syscall:  "mount",
+			args:     []uint64{sourceAddr, targetAddr},
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name: "matching deny patterns",
+			policy: &seccompprofileapi.NotifyPolicy{
+				DefaultAction: seccompprofileapi.NotifyActionLog,
+				Rules: []seccompprofileapi.NotifyRule{{
+					Names:  []string{"mount"},
+					Action: seccompprofileapi.NotifyActionDeny,
+					Args: []seccompprofileapi.NotifyArg{
+						{Index: 0, Patterns: []string{"/dev/sdb*"}},
+					},
+				}},
+			},
+			syscall:  "mount",
+			args:     []uint64{sourceAddr, targetAddr},
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name: "mismatching patterns use the default action",
+			policy: &seccompprofileapi.NotifyPolicy{
+				DefaultAction: seccompprofileapi.NotifyActionLog,
+				Rules: []seccompprofileapi.NotifyRule{{
+					Names:  []string{"mount"},
+					Action: seccompprofileapi.NotifyActionDeny,
+					Args: []seccompprofileapi.NotifyArg{
+						{Index: 0, Patterns: []string{"/dev/sda*"}},
+					},
+				}},
+			},
+			syscall:  "mount",
+			args:     []uint64{sourceAddr, targetAddr},
+			expected: decision{action: seccompprofileapi.NotifyActionLog, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name:     "pattern mismatch falls through to the next rule",
+			policy:   mountPolicy,
+			syscall:  "mount",
+			args:     []uint64{targetAddr, targetAddr},
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EACCES)},
+		},
+		{
+			name:     "unreadable string does not match",
+			policy:   mountPolicy,
+			syscall:  "mount",
+			args:     []uint64{badAddr, targetAddr},
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EACCES)},
+		},
+		{
+			name:     "matching value",
+			policy:   mountPolicy,
+			syscall:  "umount2",
+			args:     []uint64{targetAddr, 2},
+			expected: decision{action: seccompprofileapi.NotifyActionLog, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name:     "value mismatch uses the default action",
+			policy:   mountPolicy,
+			syscall:  "umount2",
+			args:     []uint64{targetAddr, 1},
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name:     "argument index out of range",
+			policy:   mountPolicy,
+			syscall:  "umount2",
+			args:     []uint64{targetAddr},
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name:     "unknown syscall with empty default action",
+			policy:   &seccompprofileapi.NotifyPolicy{},
+			syscall:  "",
+			expected: decision{action: seccompprofileapi.NotifyActionDeny, errnoRet: int32(syscall.EPERM)},
+		},
+		{
+			name: "allow by default",
+			policy: &seccompprofileapi.NotifyPolicy{
+				DefaultAction: seccompprofileapi.NotifyActionAllow,
+			},
+			syscall:  "mount",
+			expected: decision{action: seccompprofileapi.NotifyActionAllow, errnoRet: int32(syscall.EPERM)},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, evaluatePolicy(tc.policy, tc.syscall, tc.args, readString))
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// metricsQueueSize is the amount of decisions which can wait for being sent
+// to the metrics server before further ones get dropped.
+const metricsQueueSize = 1024
+
+// SeccompAgent is the main structure of this package.
+type SeccompAgent struct {
+	impl
+	logger        logr.Logger
+	client        client.Client
+	nodeName      string
+	metricsClient apimetrics.Metrics_SeccompNotifyIncClient
+	metrics       chan *apimetrics.SeccompNotifyRequest
+}
+
+// New returns a new SeccompAgent instance.
+func New(logger logr.Logger) *SeccompAgent {
+	return &SeccompAgent{
+		impl:    &defaultImpl{},
+		logger:  logger,
+		metrics: make(chan *apimetrics.SeccompNotifyRequest, metricsQueueSize),
+	}
+}
+
+// Run the seccomp agent to decide about the syscalls which are notified by the
+// container runtime for profiles using the SCMP_ACT_NOTIFY action.
+func (s *SeccompAgent) Run() error {
+	s.nodeName = s.Getenv(config.NodeNameEnvKey)
+	if s.nodeName == "" {
+		err := fmt.Errorf("%s environment variable not set", config.NodeNameEnvKey)
+		s.logger.Error(err, "unable to run seccomp agent")
+
+		return err
+	}
+
+	s.logger.Info("Starting seccomp agent on node: " + s.nodeName)
+
+	clusterConfig, err := s.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("get in-cluster config: %w", err)
+	}
+
+	s.client, err = s.NewClient(clusterConfig)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	s.logger.Info("Connecting to local GRPC server")
+
+	var conn *grpc.ClientConn
+
+	if err := util.Retry(func() (err error) {
+		conn, err = s.Dial()
+		if err != nil {
+			return fmt.Errorf("connecting to local GRPC server: %w", err)
+		}
+
+		s.metricsClient, err = s.SeccompNotifyInc(apimetrics.NewMetricsClient(conn))
+		if err != nil {
+			s.Close(conn)
+
+			return fmt.Errorf("create metrics seccomp notify client: %w", err)
+		}
+
+		return nil
+	}, func(error) bool { return true }); err != nil {
+		return fmt.Errorf("connect to local GRPC server: %w", err)
+	}
+
+	defer s.Close(conn)
+
+	go s.sendMetrics()
+
+	if _, err := s.Stat(config.SeccompAgentSocket); err == nil {
+		if err := s.RemoveAll(config.SeccompAgentSocket); err != nil {
+			return fmt.Errorf("remove seccomp agent socket file: %w", err)
+		}
+	}
+
+	listener, err := s.Listen("unix", config.SeccompAgentSocket)
+	if err != nil {
+		return fmt.Errorf("create seccomp agent listener: %w", err)
+	}
+
+	s.logger.Info("Listening for seccomp notifications", "socket", config.SeccompAgentSocket)
+
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+
+		if err != nil {
+			s.logger.Error(err, "unable to accept seccomp agent connection")
+
+			continue
+		}
+
+		go s.handleConn(conn)
+	}
+}
+
+// policy returns the notify policy of the profile passed as listener
+// metadata. Without a policy, every notified syscall gets denied.
+func (s *SeccompAgent) policy(profileName string) *seccompprofileapi.NotifyPolicy {
+	if profileName == "" {
+		s.logger.Info("No profile provided in the listener metadata, denying all notified syscalls")
+
+		return nil
+	}
+
+	profile, err := s.GetProfile(context.Background(), s.client, profileName)
+	if err != nil {
+		s.logger.Error(err, "unable to get seccomp profile, denying all notified syscalls",
+			"profile", profileName)
+
+		return nil
+	}
+
+	if profile.Spec.Notify == nil {
+		s.logger.Info("Seccomp profile has no notify rules, denying all notified syscalls",
+			"profile", profileName)
+	}
+
+	return profile.Spec.Notify
+}
+
+// report logs the decision about a notified syscall and queues the metrics
+// update, which does not block the notified process.
+func (s *SeccompAgent) report(profile, syscall string, d decision) {
+	logger := s.logger
+	if d.action == seccompprofileapi.NotifyActionAllow {
+		logger = logger.V(config.VerboseLevel)
+	}
+
+	logger.Info("Seccomp notify decision",
+		"profile", profile, "syscall", syscall, "action", d.action)
+
+	select {
+	case s.metrics <- &apimetrics.SeccompNotifyRequest{
+		Node:    s.nodeName,
+		Profile: profile,
+		Syscall: syscall,
+		Action:  string(d.action),
+	}:
+	default:
+		s.logger.Info("Metrics queue is full, dropping seccomp notify decision",
+			"profile", profile, "syscall", syscall)
+	}
+}
+
+// sendMetrics sends the queued metrics updates until the queue gets closed.
+func (s *SeccompAgent) sendMetrics() {
+	for req := range s.metrics {
+		if err := s.SendMetric(s.metricsClient, req); err != nil {
+			s.logger.Error(err, "unable to update metrics")
+		}
+	}
+}
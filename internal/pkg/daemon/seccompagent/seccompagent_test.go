@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompagent
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompagent/seccompagentfakes"
+)
+
+const (
+	node    = "test-node"
+	profile = "test-profile"
+)
+
+var errTest = errors.New("test")
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		prepare   func(*testing.T, *seccompagentfakes.FakeImpl)
+		shouldErr bool
+	}{
+		{
+			name: "success",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns(node)
+				mock.StatReturns(nil, os.ErrNotExist)
+
+				listener, err := net.Listen("unix", filepath.Join(t.TempDir(), "agent.sock"))
+				require.NoError(t, err)
+				require.NoError(t, listener.Close())
+				mock.ListenReturns(listener, nil)
+			},
+		},
+		{
+			name: "failure on Getenv",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns("")
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure on InClusterConfig",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns(node)
+				mock.InClusterConfigReturns(nil, errTest)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure on NewClient",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns(node)
+				mock.NewClientReturns(nil, errTest)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure on Dial",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns(node)
+				mock.DialReturns(nil, errTest)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure on RemoveAll",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns(node)
+				mock.RemoveAllReturns(errTest)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure on Listen",
+			prepare: func(t *testing.T, mock *seccompagentfakes.FakeImpl) {
+				t.Helper()
+				mock.GetenvReturns(node)
+				mock.StatReturns(nil, os.ErrNotExist)
+				mock.ListenReturns(nil, errTest)
+			},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &seccompagentfakes.FakeImpl{}
+			tc.prepare(t, mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+
+			err := sut.Run()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := &seccompprofileapi.NotifyPolicy{
+		DefaultAction: seccompprofileapi.NotifyActionLog,
+	}
+
+	for _, tc := range []struct {
+		name        string
+		profileName string
+		prepare     func(*seccompagentfakes.FakeImpl)
+		expected    *seccompprofileapi.NotifyPolicy
+	}{
+		{
+			name:        "profile with notify rules",
+			profileName: profile,
+			prepare: func(mock *seccompagentfakes.FakeImpl) {
+				mock.GetProfileReturns(&seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{Notify: policy},
+				}, nil)
+			},
+			expected: policy,
+		},
+		{
+			name:        "profile without notify rules",
+			profileName: profile,
+			prepare: func(mock *seccompagentfakes.FakeImpl) {
+				mock.GetProfileReturns(&seccompprofileapi.SeccompProfile{}, nil)
+			},
+		},
+		{
+			name:        "profile not found",
+			profileName: profile,
+			prepare: func(mock *seccompagentfakes.FakeImpl) {
+				mock.GetProfileReturns(nil, errTest)
+			},
+		},
+		{
+			name:    "no listener metadata",
+			prepare: func(*seccompagentfakes.FakeImpl) {},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &seccompagentfakes.FakeImpl{}
+			tc.prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+
+			require.Equal(t, tc.expected, sut.policy(tc.profileName))
+
+			if tc.profileName != "" {
+				_, _, name := mock.GetProfileArgsForCall(0)
+				require.Equal(t, tc.profileName, name)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	mock := &seccompagentfakes.FakeImpl{}
+	mock.SendMetricReturns(errTest)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+	sut.nodeName = node
+
+	sut.report(profile, "mount", decision{action: seccompprofileapi.NotifyActionDeny})
+	require.Equal(t, 0, mock.SendMetricCallCount())
+
+	close(sut.metrics)
+	sut.sendMetrics()
+
+	require.Equal(t, 1, mock.SendMetricCallCount())
+
+	_, req := mock.SendMetricArgsForCall(0)
+	require.Equal(t, node, req.GetNode())
+	require.Equal(t, profile, req.GetProfile())
+	require.Equal(t, "mount", req.GetSyscall())
+	require.Equal(t, "Deny", req.GetAction())
+}
+
+func TestReportQueueFull(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.metrics = make(chan *apimetrics.SeccompNotifyRequest, 1)
+
+	sut.report(profile, "mount", decision{action: seccompprofileapi.NotifyActionDeny})
+	sut.report(profile, "umount2", decision{action: seccompprofileapi.NotifyActionDeny})
+
+	require.Len(t, sut.metrics, 1)
+	require.Equal(t, "mount", (<-sut.metrics).GetSyscall())
+}
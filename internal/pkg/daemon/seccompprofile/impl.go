@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	"github.com/opencontainers/go-digest"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -38,9 +39,10 @@ type defaultImpl struct{}
 //counterfeiter:generate . impl
 type impl interface {
 	Pull(context.Context, logr.Logger, string, string, string, *v1.Platform,
-		*artifact.PullSignatureOptions) (*artifact.PullResult, error)
+		*artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)
 	PullResultType(*artifact.PullResult) artifact.PullResultType
 	PullResultSeccompProfile(*artifact.PullResult) *seccompprofileapi.SeccompProfile
+	PullResultDigest(*artifact.PullResult) digest.Digest
 	ClientGetProfile(
 		context.Context, client.Client, client.ObjectKey, ...client.GetOption,
 	) (*seccompprofileapi.SeccompProfile, error)
@@ -55,8 +57,9 @@ func (*defaultImpl) Pull(
 	from, username, password string,
 	platform *v1.Platform,
 	signOpts *artifact.PullSignatureOptions,
+	regOpts *artifact.RegistryOptions,
 ) (*artifact.PullResult, error) {
-	return artifact.New(l).Pull(ctx, from, username, password, platform, signOpts)
+	return artifact.New(l).Pull(ctx, from, username, password, platform, signOpts, regOpts)
 }
 
 func (*defaultImpl) PullResultType(res *artifact.PullResult) artifact.PullResultType {
@@ -67,6 +70,10 @@ func (*defaultImpl) PullResultSeccompProfile(res *artifact.PullResult) *seccompp
 	return res.SeccompProfile()
 }
 
+func (*defaultImpl) PullResultDigest(res *artifact.PullResult) digest.Digest {
+	return res.Digest()
+}
+
 func (*defaultImpl) ClientGetProfile(
 	ctx context.Context, c client.Client, key client.ObjectKey, opts ...client.GetOption,
 ) (*seccompprofileapi.SeccompProfile, error) {
@@ -27,11 +27,11 @@ import (
 	"path"
 	"runtime"
 	"slices"
-	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
+	"github.com/opencontainers/go-digest"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"go.podman.io/common/pkg/seccomp"
 	"k8s.io/apimachinery/pkg/types"
@@ -69,6 +69,8 @@ const (
 	errForbiddenProfile    = "seccomp profile not allowed"
 	errForbiddenAction     = "seccomp action not allowed"
 
+	errBaseProfileDigestRequired = "base profile has to be referenced by digest"
+
 	filePermissionMode os.FileMode = 0o644
 
 	// MkdirAll won't create a directory if it does not have the execute bit.
@@ -86,10 +88,30 @@ const (
 
 	defaultCacheTimeout time.Duration = 24 * time.Hour
 	maxCacheItems       uint64        = 1000
-
-	allowedAllRegexp string = ".*"
 )
 
+// baseProfileKind stores the digests of remote base profiles in the
+// SeccompProfile status.
+var baseProfileKind = &common.BaseProfileKind[*seccompprofileapi.SeccompProfile]{
+	Annotation: seccompprofileapi.ResolveBaseProfilesAnnotation,
+	Status: func(sp *seccompprofileapi.SeccompProfile) ([]common.ResolvedBaseProfile, string) {
+		resolved := make([]common.ResolvedBaseProfile, 0, len(sp.Status.ResolvedBaseProfiles))
+		for _, r := range sp.Status.ResolvedBaseProfiles {
+			resolved = append(resolved, common.ResolvedBaseProfile(r))
+		}
+
+		return resolved, sp.Status.ObservedResolveBaseProfiles
+	},
+	SetStatus: func(sp *seccompprofileapi.SeccompProfile, resolved []common.ResolvedBaseProfile, observed string) {
+		sp.Status.ResolvedBaseProfiles = nil
+		for _, r := range resolved {
+			sp.Status.ResolvedBaseProfiles = append(sp.Status.ResolvedBaseProfiles, seccompprofileapi.ResolvedBaseProfile(r))
+		}
+
+		sp.Status.ObservedResolveBaseProfiles = observed
+	},
+}
+
 // NewController returns a new empty controller instance.
 func NewController() controller.Controller {
 	return &Reconciler{
@@ -107,6 +129,7 @@ type saver func(string, []byte) (bool, error)
 type Reconciler struct {
 	impl
 	client       client.Client
+	reader       client.Reader
 	log          logr.Logger
 	record       record.EventRecorder
 	save         saver
@@ -175,6 +198,7 @@ func (r *Reconciler) Setup(
 	met *metrics.Metrics,
 ) error {
 	r.client = mgr.GetClient()
+	r.reader = mgr.GetAPIReader()
 	r.log = ctrl.Log.WithName(r.Name())
 	r.record = mgr.GetEventRecorderFor("profile") //nolint:staticcheck,nolintlint // TODO: migrate to GetEventRecorder
 	r.save = saveProfileOnDisk
@@ -279,6 +303,7 @@ func (r *Reconciler) checkSeccomp() error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;get;patch;update
+// +kubebuilder:rbac:groups=core,namespace="security-profiles-operator",resources=configmaps;secrets,verbs=get
 
 // OpenShift ... This is ignored in other distros
 //nolint:lll // required for kubebuilder
@@ -316,7 +341,9 @@ func (r *Reconciler) mergeBaseProfile(
 	ctx context.Context, sp *seccompprofileapi.SeccompProfile, l logr.Logger,
 ) (*seccompprofileapi.SeccompProfile, error) {
 	// Recursively resolve the syscalls
-	finalSyscalls, err := r.resolveSyscallsForProfile(ctx, sp, sp.Spec.Syscalls, l, 0)
+	digests := baseProfileKind.NewDigests(sp)
+
+	finalSyscalls, err := r.resolveSyscallsForProfile(ctx, sp, sp.Spec.Syscalls, digests, l, 0)
 	if err != nil {
 		return nil, fmt.Errorf("resolve syscalls: %w", err)
 	}
@@ -344,19 +371,25 @@ func (r *Reconciler) mergeBaseProfile(
 		}
 	}
 
+	if err := baseProfileKind.UpdateStatus(ctx, r.client, r.record, sp, digests, l); err != nil {
+		return nil, err
+	}
+
 	sp.Spec.Syscalls = finalSyscalls
 
 	return sp, nil
 }
 
-// resolveSyscallsForProfile recursively resolves the syscalls for base
-// profiles up to a depth level of 15 is also caches the results when pulling
-// from OCI artifacts.
-func (r *Reconciler) resolveSyscallsForProfile(
-	ctx context.Context,
+// BaseProfileGetter retrieves the base profile referenced by the provided
+// seccomp profile.
+type BaseProfileGetter func(*seccompprofileapi.SeccompProfile) (*seccompprofileapi.SeccompProfile, error)
+
+// ResolveSyscalls recursively resolves the syscalls for base profiles up to a
+// depth level of 15, by using getBaseProfile for retrieving them.
+func ResolveSyscalls(
 	sp *seccompprofileapi.SeccompProfile,
 	inputSyscalls []seccompprofileapi.Syscall,
-	l logr.Logger,
+	getBaseProfile BaseProfileGetter,
 	level uint8,
 ) ([]seccompprofileapi.Syscall, error) {
 	const maxLevel = 15
@@ -367,76 +400,68 @@ func (r *Reconciler) resolveSyscallsForProfile(
 		)
 	}
 
-	baseProfileName := sp.Spec.BaseProfileName
-	if baseProfileName == "" {
+	if sp.Spec.BaseProfileName == "" {
 		// No base profile at all
 		return inputSyscalls, nil
 	}
 
-	l.Info("Resolving syscalls for profile", "recursion", level)
-
-	var baseProfile *seccompprofileapi.SeccompProfile
-
-	if after, ok := strings.CutPrefix(baseProfileName, config.OCIProfilePrefix); ok {
-		// Pull remote base profile from an OCI artifact registry
-		from := after
-
-		item := r.baseProfiles.Get(from)
-		if item != nil {
-			l.Info("Using cached base profile", "baseProfile", from)
+	baseProfile, err := getBaseProfile(sp)
+	if err != nil {
+		return nil, err
+	}
 
-			baseProfile = item.Value()
-		} else {
-			spod, err := r.GetSPOD(ctx, r.client)
-			if err != nil {
-				return nil, fmt.Errorf("retrieving the SPOD configuration: %w", err)
-			}
+	newSyscalls, err := util.UnionSyscalls(baseProfile.Spec.Syscalls, inputSyscalls)
+	if err != nil {
+		return nil, fmt.Errorf("union syscalls: %w", err)
+	}
 
-			if spod.Spec.Security.AllowedIdentityRegexp == "" {
-				spod.Spec.Security.AllowedIdentityRegexp = allowedAllRegexp
-			}
+	return ResolveSyscalls(baseProfile, newSyscalls, getBaseProfile, level+1)
+}
 
-			if spod.Spec.Security.AllowedOidcIssuerRegexp == "" {
-				spod.Spec.Security.AllowedOidcIssuerRegexp = allowedAllRegexp
-			}
+// resolveSyscallsForProfile recursively resolves the syscalls for base
+// profiles up to a depth level of 15 is also caches the results when pulling
+// from OCI artifacts. The digests of remote base profiles are tracked in
+// digests.
+func (r *Reconciler) resolveSyscallsForProfile(
+	ctx context.Context,
+	sp *seccompprofileapi.SeccompProfile,
+	inputSyscalls []seccompprofileapi.Syscall,
+	digests *common.BaseProfileDigests,
+	l logr.Logger,
+	level uint8,
+) ([]seccompprofileapi.Syscall, error) {
+	return ResolveSyscalls(
+		sp,
+		inputSyscalls,
+		func(sp *seccompprofileapi.SeccompProfile) (*seccompprofileapi.SeccompProfile, error) {
+			return r.getBaseProfile(ctx, sp, digests, l)
+		},
+		level,
+	)
+}
 
-			signOpts := &artifact.PullSignatureOptions{
-				DisableSignatureVerification: ptr.Deref(spod.Spec.Security.DisableOCIArtifactSignatureVerification, false),
-				AllowedIdentityRegexp:        spod.Spec.Security.AllowedIdentityRegexp,
-				AllowedOidcIssuerRegexp:      spod.Spec.Security.AllowedOidcIssuerRegexp,
-			}
-			l.Info(
-				"Pulling base profile: "+from,
-				"disableOCIArtifactSignatureVerification", signOpts.DisableSignatureVerification,
-				"allowedIdentityRegexp", signOpts.AllowedIdentityRegexp,
-				"allowedOidcIssuerRegexp", signOpts.AllowedOidcIssuerRegexp,
-			)
-
-			res, err := r.Pull(ctx, l, from, "", "", &v1.Platform{
-				Architecture: runtime.GOARCH,
-				OS:           runtime.GOOS,
-			}, signOpts)
-			if err != nil {
-				l.Error(err, "cannot pull base profile "+baseProfileName)
-				r.IncSeccompProfileError(r.metrics, reasonCannotPullProfile)
-				r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonCannotPullProfile, err.Error())
-
-				return nil, fmt.Errorf("retrieve base profile %s from OCI registry: %w", from, err)
-			}
+// getBaseProfile retrieves the base profile of sp either from the cluster or
+// from an OCI artifact registry.
+func (r *Reconciler) getBaseProfile(
+	ctx context.Context,
+	sp *seccompprofileapi.SeccompProfile,
+	digests *common.BaseProfileDigests,
+	l logr.Logger,
+) (*seccompprofileapi.SeccompProfile, error) {
+	baseProfileName := sp.Spec.BaseProfileName
 
-			resType := r.PullResultType(res)
-			if resType != artifact.PullResultTypeSeccompProfile {
-				return nil, fmt.Errorf("pull result type %s is not a seccomp profile", resType)
-			}
+	l.Info("Resolving base profile", "baseProfile", baseProfileName)
 
-			baseProfile = r.PullResultSeccompProfile(res)
-			r.baseProfiles.Set(from, baseProfile, ttlcache.DefaultTTL)
+	var baseProfile *seccompprofileapi.SeccompProfile
 
-			l.Info(
-				"Set remote base seccomp profile",
-				"baseProfile", baseProfile.Name,
-			)
+	if from, ok := artifact.OCIReference(baseProfileName); ok {
+		// Pull remote base profile from an OCI artifact registry or layout
+		profile, err := r.getRemoteBaseProfile(ctx, sp, from, digests, l)
+		if err != nil {
+			return nil, err
 		}
+
+		baseProfile = profile
 	} else {
 		// Local base profile
 		profile, err := r.ClientGetProfile(
@@ -459,12 +484,100 @@ func (r *Reconciler) resolveSyscallsForProfile(
 		)
 	}
 
-	newSyscalls, err := util.UnionSyscalls(baseProfile.Spec.Syscalls, inputSyscalls)
+	return baseProfile, nil
+}
+
+// getRemoteBaseProfile retrieves the base profile from an OCI artifact
+// registry or layout. References pinned to a digest are served from the cache
+// if possible, while all others are pulled to resolve their digest.
+func (r *Reconciler) getRemoteBaseProfile(
+	ctx context.Context,
+	sp *seccompprofileapi.SeccompProfile,
+	from string,
+	digests *common.BaseProfileDigests,
+	l logr.Logger,
+) (*seccompprofileapi.SeccompProfile, error) {
+	spod, err := r.GetSPOD(ctx, r.client)
 	if err != nil {
-		return nil, fmt.Errorf("union syscalls: %w", err)
+		return nil, fmt.Errorf("retrieving the SPOD configuration: %w", err)
 	}
 
-	return r.resolveSyscallsForProfile(ctx, baseProfile, newSyscalls, l, level+1)
+	if ptr.Deref(spod.Spec.Security.RequireBaseProfileDigest, false) && !artifact.IsDigestReference(from) {
+		err := fmt.Errorf("%s: %s", errBaseProfileDigestRequired, from)
+		l.Error(err, "base profile not allowed")
+		r.IncSeccompProfileError(r.metrics, reasonProfileNotAllowed)
+		r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonProfileNotAllowed, err.Error())
+
+		return nil, err
+	}
+
+	pullFrom := from
+
+	if pinned, ok := digests.Pinned(from); ok {
+		pullFrom, err = artifact.DigestReference(from, digest.Digest(pinned))
+		if err != nil {
+			return nil, fmt.Errorf("pin base profile %s to digest: %w", from, err)
+		}
+
+		if item := r.baseProfiles.Get(pullFrom); item != nil {
+			l.Info("Using cached base profile", "baseProfile", pullFrom)
+			digests.Add(from, pinned)
+
+			return item.Value(), nil
+		}
+	}
+
+	signOpts, regOpts, err := common.PullOptions(ctx, r.reader, spod, from)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Info(
+		"Pulling base profile: "+pullFrom,
+		"disableOCIArtifactSignatureVerification", signOpts.DisableSignatureVerification,
+		"allowedIdentityRegexp", signOpts.AllowedIdentityRegexp,
+		"allowedOidcIssuerRegexp", signOpts.AllowedOidcIssuerRegexp,
+		"publicKeyVerification", len(signOpts.PublicKeys) > 0,
+		"ignoreTransparencyLog", signOpts.IgnoreTlog,
+		"plainHTTP", regOpts.PlainHTTP,
+		"insecureSkipTLSVerify", regOpts.InsecureSkipTLSVerify,
+	)
+
+	res, err := r.Pull(ctx, l, pullFrom, "", "", &v1.Platform{
+		Architecture: runtime.GOARCH,
+		OS:           runtime.GOOS,
+	}, signOpts, regOpts)
+	if err != nil {
+		l.Error(err, "cannot pull base profile "+pullFrom)
+		r.IncSeccompProfileError(r.metrics, reasonCannotPullProfile)
+		r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonCannotPullProfile, err.Error())
+
+		return nil, fmt.Errorf("retrieve base profile %s from OCI registry: %w", pullFrom, err)
+	}
+
+	resType := r.PullResultType(res)
+	if resType != artifact.PullResultTypeSeccompProfile {
+		return nil, fmt.Errorf("pull result type %s is not a seccomp profile", resType)
+	}
+
+	baseProfile := r.PullResultSeccompProfile(res)
+	resDigest := r.PullResultDigest(res)
+
+	cacheKey, err := artifact.DigestReference(from, resDigest)
+	if err != nil {
+		return nil, fmt.Errorf("pin base profile %s to digest: %w", from, err)
+	}
+
+	r.baseProfiles.Set(cacheKey, baseProfile, ttlcache.DefaultTTL)
+	digests.Add(from, resDigest.String())
+
+	l.Info(
+		"Set remote base seccomp profile",
+		"baseProfile", baseProfile.Name,
+		"digest", resDigest,
+	)
+
+	return baseProfile, nil
 }
 
 func (r *Reconciler) reconcileSeccompProfile(
@@ -506,7 +619,9 @@ func (r *Reconciler) reconcileSeccompProfile(
 
 	l.Info("Got profile content")
 
-	profileContent, err := json.Marshal(outputProfile.Spec)
+	runtimeSpec := runtimeProfileSpec(outputProfile)
+
+	profileContent, err := json.Marshal(runtimeSpec)
 	if err != nil {
 		l.Error(err, "cannot validate profile "+profileName)
 		r.metrics.IncSeccompProfileError(reasonInvalidSeccompProfile)
@@ -515,6 +630,15 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{}, fmt.Errorf("cannot validate profile: %w", err)
 	}
 
+	auditProfileContent, err := json.Marshal(auditProfileSpec(runtimeSpec))
+	if err != nil {
+		l.Error(err, "cannot validate audit profile "+profileName)
+		r.metrics.IncSeccompProfileError(reasonInvalidSeccompProfile)
+		r.record.Event(sp, util.EventTypeWarning, reasonInvalidSeccompProfile, err.Error())
+
+		return reconcile.Result{}, fmt.Errorf("cannot validate audit profile: %w", err)
+	}
+
 	profilePath := sp.GetProfilePath()
 
 	// The object is not being deleted
@@ -544,7 +668,17 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{}, fmt.Errorf("cannot save profile into disk: %w", err)
 	}
 
-	if updated {
+	// The audit variant is used by profile bindings in Audit mode
+	auditUpdated, err := r.save(sp.GetAuditProfilePath(), auditProfileContent)
+	if err != nil {
+		l.Error(err, "cannot save audit profile into disk")
+		r.metrics.IncSeccompProfileError(reasonCannotSaveProfile)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotSaveProfile, err.Error())
+
+		return reconcile.Result{}, fmt.Errorf("cannot save audit profile into disk: %w", err)
+	}
+
+	if updated || auditUpdated {
 		evstr := "Successfully saved profile to disk on " + os.Getenv(config.NodeNameEnvKey)
 		l.Info(evstr)
 		r.metrics.IncSeccompProfileUpdate()
@@ -605,6 +739,10 @@ func (r *Reconciler) reconcileDeletion(
 func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error {
 	profilePath := sp.GetProfilePath()
 
+	if err := os.Remove(sp.GetAuditProfilePath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing audit profile from host: %w", err)
+	}
+
 	err := os.Remove(profilePath)
 	if os.IsNotExist(err) {
 		return nil
@@ -626,13 +764,73 @@ func (r *Reconciler) validateProfile(ctx context.Context, profile *seccompprofil
 		return fmt.Errorf("retrieving the SPOD configuration: %w", err)
 	}
 
-	if len(spod.Spec.Security.AllowedSyscalls) > 0 {
-		return allowProfile(profile, spod.Spec.Security.AllowedSyscalls, spod.Spec.Security.AllowedSeccompActions)
+	return ValidateProfile(profile, &spod.Spec.Security)
+}
+
+// ValidateProfile validates the resolved profile against the security
+// configuration of the SPOD.
+func ValidateProfile(profile *seccompprofileapi.SeccompProfile, security *spodapi.SPODSecurityConfig) error {
+	// The profile may have been created while the validating webhook was
+	// not available.
+	if err := profile.ValidateNotify(); err != nil {
+		return fmt.Errorf("validating notify rules: %w", err)
+	}
+
+	if len(security.AllowedSyscalls) > 0 {
+		return allowProfile(profile, security.AllowedSyscalls, security.AllowedSeccompActions)
 	}
 
 	return nil
 }
 
+// auditProfileSpec derives the non-enforcing variant of the profile spec, which
+// logs every syscall the original profile would have blocked.
+func auditProfileSpec(spec *seccompprofileapi.SeccompProfileSpec) *seccompprofileapi.SeccompProfileSpec {
+	audit := spec.DeepCopy()
+	audit.DefaultAction = auditAction(audit.DefaultAction)
+	audit.ListenerPath = ""
+	audit.ListenerMetadata = ""
+
+	for i := range audit.Syscalls {
+		if action := auditAction(audit.Syscalls[i].Action); action != audit.Syscalls[i].Action {
+			audit.Syscalls[i].Action = action
+			audit.Syscalls[i].ErrnoRet = 0
+		}
+	}
+
+	return audit
+}
+
+// runtimeProfileSpec returns the profile spec passed to the container runtime.
+// The notify rules are only used by the seccomp agent, which gets contacted
+// by default if a profile specifies them.
+func runtimeProfileSpec(sp *seccompprofileapi.SeccompProfile) *seccompprofileapi.SeccompProfileSpec {
+	spec := sp.Spec.DeepCopy()
+	if spec.Notify == nil {
+		return spec
+	}
+
+	if spec.ListenerPath == "" {
+		spec.ListenerPath = config.SeccompAgentSocket
+	}
+
+	if spec.ListenerMetadata == "" {
+		spec.ListenerMetadata = sp.Name
+	}
+
+	spec.Notify = nil
+
+	return spec
+}
+
+func auditAction(action seccompprofileapi.Action) seccompprofileapi.Action {
+	if action == seccompprofileapi.ActAllow || action == seccompprofileapi.ActLog {
+		return action
+	}
+
+	return seccompprofileapi.ActLog
+}
+
 func saveProfileOnDisk(fileName string, content []byte) (updated bool, err error) {
 	if err := os.MkdirAll(path.Dir(fileName), dirPermissionMode); err != nil {
 		return false, fmt.Errorf("%s: %w", errCreatingOperatorDir, err)
@@ -24,13 +24,16 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/opencontainers/go-digest"
 	"github.com/stretchr/testify/require"
 	"go.podman.io/common/pkg/seccomp"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -270,6 +273,110 @@ func TestGetProfilePath(t *testing.T) {
 	}
 }
 
+func TestAuditProfileSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := &seccompprofileapi.SeccompProfileSpec{
+		DefaultAction:    seccompprofileapi.ActErrno,
+		ListenerPath:     "/var/run/security-profiles-operator/agent.sock",
+		ListenerMetadata: "metadata",
+		Syscalls: []seccompprofileapi.Syscall{
+			{Action: seccompprofileapi.ActAllow, Names: []string{"a"}},
+			{Action: seccompprofileapi.ActLog, Names: []string{"b"}},
+			{Action: seccompprofileapi.ActErrno, Names: []string{"c"}, ErrnoRet: 1},
+			{Action: seccompprofileapi.ActKillProcess, Names: []string{"d"}},
+			{Action: seccompprofileapi.ActNotify, Names: []string{"e"}},
+		},
+	}
+
+	got := auditProfileSpec(spec)
+	require.Equal(t, &seccompprofileapi.SeccompProfileSpec{
+		DefaultAction: seccompprofileapi.ActLog,
+		Syscalls: []seccompprofileapi.Syscall{
+			{Action: seccompprofileapi.ActAllow, Names: []string{"a"}},
+			{Action: seccompprofileapi.ActLog, Names: []string{"b"}},
+			{Action: seccompprofileapi.ActLog, Names: []string{"c"}},
+			{Action: seccompprofileapi.ActLog, Names: []string{"d"}},
+			{Action: seccompprofileapi.ActLog, Names: []string{"e"}},
+		},
+	}, got)
+
+	// The original spec must stay untouched
+	require.Equal(t, seccompprofileapi.ActErrno, spec.DefaultAction)
+	require.EqualValues(t, 1, spec.Syscalls[2].ErrnoRet)
+}
+
+func TestRuntimeProfileSpec(t *testing.T) {
+	t.Parallel()
+
+	notify := &seccompprofileapi.NotifyPolicy{
+		DefaultAction: seccompprofileapi.NotifyActionDeny,
+	}
+
+	for _, tc := range []struct {
+		name string
+		spec seccompprofileapi.SeccompProfileSpec
+		want seccompprofileapi.SeccompProfileSpec
+	}{
+		{
+			name: "without notify rules",
+			spec: seccompprofileapi.SeccompProfileSpec{DefaultAction: seccompprofileapi.ActAllow},
+			want: seccompprofileapi.SeccompProfileSpec{DefaultAction: seccompprofileapi.ActAllow},
+		},
+		{
+			name: "notify rules default the listener",
+			spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: seccompprofileapi.ActAllow,
+				Notify:        notify,
+			},
+			want: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction:    seccompprofileapi.ActAllow,
+				ListenerPath:     config.SeccompAgentSocket,
+				ListenerMetadata: "profile",
+			},
+		},
+		{
+			name: "notify rules keep a custom listener",
+			spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction:    seccompprofileapi.ActAllow,
+				ListenerPath:     "/var/run/security-profiles-operator/custom.sock",
+				ListenerMetadata: "metadata",
+				Notify:           notify,
+			},
+			want: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction:    seccompprofileapi.ActAllow,
+				ListenerPath:     "/var/run/security-profiles-operator/custom.sock",
+				ListenerMetadata: "metadata",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec:       tc.spec,
+			}
+
+			require.Equal(t, &tc.want, runtimeProfileSpec(sp))
+			require.Equal(t, tc.spec.Notify, sp.Spec.Notify)
+		})
+	}
+}
+
+func TestGetAuditProfilePath(t *testing.T) {
+	t.Parallel()
+
+	sp := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "file", Namespace: "ns"},
+		Status: seccompprofileapi.SeccompProfileStatus{
+			LocalhostProfile: "operator/file.json",
+		},
+	}
+	require.Equal(t, path.Join(config.ProfilesRootPath(), "ns", "file_audit.json"), sp.GetAuditProfilePath())
+	require.Equal(t, "operator/file_audit.json", sp.GetAuditLocalhostProfile())
+}
+
 func TestAllowProfile(t *testing.T) {
 	t.Parallel()
 
@@ -591,6 +698,8 @@ func TestAllowedSyscallsChangedPredicate(t *testing.T) {
 
 var errTest = errors.New("test")
 
+const testDigest digest.Digest = "sha256:2d5e1ef4b52b0a5fb1b1e17ae3a5ca83c3b9b8d16c0a6f2b1f2a2c4d3e5f6a7b"
+
 func TestResolveSyscallsForProfile(t *testing.T) {
 	t.Parallel()
 
@@ -725,6 +834,27 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 				require.ErrorIs(t, err, errTest)
 			},
 		},
+		{
+			name: "failure on base profile digest required",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{
+						Security: spodapi.SPODSecurityConfig{
+							RequireBaseProfileDigest: ptr.To(true),
+						},
+					},
+				}, nil)
+
+				return &seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						BaseProfileName: config.OCIProfilePrefix + "test:v1",
+					},
+				}
+			},
+			assert: func(syscalls []seccompprofileapi.Syscall, err error) {
+				require.ErrorContains(t, err, errBaseProfileDigestRequired)
+			},
+		},
 		{
 			name: "failure max recursion",
 			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
@@ -772,6 +902,7 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 
 			mock := &seccompprofilefakes.FakeImpl{}
 			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+			mock.PullResultDigestReturns(testDigest)
 
 			sp := prepare(mock)
 
@@ -781,9 +912,125 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 			sut.impl = mock
 
 			syscalls, err := sut.resolveSyscallsForProfile(
-				t.Context(), sp, sp.Spec.Syscalls, logr.Discard(), 0,
+				t.Context(), sp, sp.Spec.Syscalls, baseProfileKind.NewDigests(sp), logr.Discard(), 0,
 			)
 			assert(syscalls, err)
 		})
 	}
 }
+
+//nolint:paralleltest // subtests modify environment variables and cannot run in parallel
+func TestResolveSyscallsForProfilePullOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		prepare func(*seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile
+	}{
+		{
+			name: "failure on get signature verification keys",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{
+						Security: spodapi.SPODSecurityConfig{
+							SignatureVerificationKeys: &spodapi.SignatureVerificationKeysRef{
+								Kind: spodapi.SignatureVerificationKeysKindSecret,
+								Name: "keys",
+							},
+						},
+					},
+				}, nil)
+
+				return &seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						BaseProfileName: config.OCIProfilePrefix + "test",
+					},
+				}
+			},
+		},
+		{
+			name: "failure on get CA bundle",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{
+						Security: spodapi.SPODSecurityConfig{
+							OCIRegistries: []spodapi.OCIRegistryConfig{{
+								Host:     "registry.local:5000",
+								CABundle: &corev1.ConfigMapKeySelector{Key: "ca.crt"},
+							}},
+						},
+					},
+				}, nil)
+
+				return &seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						BaseProfileName: config.OCIProfilePrefix + "registry.local:5000/test",
+					},
+				}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+
+			mock := &seccompprofilefakes.FakeImpl{}
+			sp := tc.prepare(mock)
+
+			sut, ok := NewController().(*Reconciler)
+			require.True(t, ok)
+
+			sut.impl = mock
+			sut.reader = &util.MockClient{MockGet: util.NewMockGetFn(errTest)}
+
+			_, err := sut.resolveSyscallsForProfile(
+				t.Context(), sp, sp.Spec.Syscalls, baseProfileKind.NewDigests(sp), logr.Discard(), 0,
+			)
+			require.ErrorIs(t, err, errTest)
+			require.Zero(t, mock.PullCallCount())
+		})
+	}
+}
+
+func TestGetRemoteBaseProfilePinned(t *testing.T) {
+	t.Parallel()
+
+	mock := &seccompprofilefakes.FakeImpl{}
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+	mock.PullResultTypeReturns(artifact.PullResultTypeSeccompProfile)
+	mock.PullResultSeccompProfileReturns(&seccompprofileapi.SeccompProfile{})
+	mock.PullResultDigestReturns(testDigest)
+
+	sut, ok := NewController().(*Reconciler)
+	require.True(t, ok)
+
+	sut.impl = mock
+
+	from := config.OCIProfilePrefix + "registry.local/test:v1"
+	sp := &seccompprofileapi.SeccompProfile{
+		Spec: seccompprofileapi.SeccompProfileSpec{BaseProfileName: from},
+		Status: seccompprofileapi.SeccompProfileStatus{
+			ResolvedBaseProfiles: []seccompprofileapi.ResolvedBaseProfile{
+				{Reference: "registry.local/test:v1", Digest: testDigest.String()},
+			},
+		},
+	}
+
+	// The first resolution pulls the pinned digest instead of the tag
+	digests := baseProfileKind.NewDigests(sp)
+	_, err := sut.getBaseProfile(t.Context(), sp, digests, logr.Discard())
+	require.NoError(t, err)
+	require.Equal(t, 1, mock.PullCallCount())
+
+	_, _, pulled, _, _, _, _, _ := mock.PullArgsForCall(0)
+	require.Equal(t, "registry.local/test@"+testDigest.String(), pulled)
+	require.Equal(t, []common.ResolvedBaseProfile{
+		{Reference: "registry.local/test:v1", Digest: testDigest.String()},
+	}, digests.Resolved())
+
+	// The second resolution uses the cache
+	digests = baseProfileKind.NewDigests(sp)
+	_, err = sut.getBaseProfile(t.Context(), sp, digests, logr.Discard())
+	require.NoError(t, err)
+	require.Equal(t, 1, mock.PullCallCount())
+	require.Equal(t, []common.ResolvedBaseProfile{
+		{Reference: "registry.local/test:v1", Digest: testDigest.String()},
+	}, digests.Resolved())
+}
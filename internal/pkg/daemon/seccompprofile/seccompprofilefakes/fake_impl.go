@@ -22,12 +22,13 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
-	v1b "github.com/opencontainers/image-spec/specs-go/v1"
+	digest "github.com/opencontainers/go-digest"
+	v1c "github.com/opencontainers/image-spec/specs-go/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
-	v1a "sigs.k8s.io/security-profiles-operator/api/spod/v1"
+	v1b "sigs.k8s.io/security-profiles-operator/api/spod/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
@@ -49,18 +50,18 @@ type FakeImpl struct {
 		result1 *v1.SeccompProfile
 		result2 error
 	}
-	GetSPODStub        func(context.Context, client.Client) (*v1a.SecurityProfilesOperatorDaemon, error)
+	GetSPODStub        func(context.Context, client.Client) (*v1b.SecurityProfilesOperatorDaemon, error)
 	getSPODMutex       sync.RWMutex
 	getSPODArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
 	}
 	getSPODReturns struct {
-		result1 *v1a.SecurityProfilesOperatorDaemon
+		result1 *v1b.SecurityProfilesOperatorDaemon
 		result2 error
 	}
 	getSPODReturnsOnCall map[int]struct {
-		result1 *v1a.SecurityProfilesOperatorDaemon
+		result1 *v1b.SecurityProfilesOperatorDaemon
 		result2 error
 	}
 	IncSeccompProfileErrorStub        func(*metrics.Metrics, string)
@@ -69,7 +70,7 @@ type FakeImpl struct {
 		arg1 *metrics.Metrics
 		arg2 string
 	}
-	PullStub        func(context.Context, logr.Logger, string, string, string, *v1b.Platform, *artifact.PullSignatureOptions) (*artifact.PullResult, error)
+	PullStub        func(context.Context, logr.Logger, string, string, string, *v1c.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)
 	pullMutex       sync.RWMutex
 	pullArgsForCall []struct {
 		arg1 context.Context
@@ -77,8 +78,9 @@ type FakeImpl struct {
 		arg3 string
 		arg4 string
 		arg5 string
-		arg6 *v1b.Platform
+		arg6 *v1c.Platform
 		arg7 *artifact.PullSignatureOptions
+		arg8 *artifact.RegistryOptions
 	}
 	pullReturns struct {
 		result1 *artifact.PullResult
@@ -88,6 +90,17 @@ type FakeImpl struct {
 		result1 *artifact.PullResult
 		result2 error
 	}
+	PullResultDigestStub        func(*artifact.PullResult) digest.Digest
+	pullResultDigestMutex       sync.RWMutex
+	pullResultDigestArgsForCall []struct {
+		arg1 *artifact.PullResult
+	}
+	pullResultDigestReturns struct {
+		result1 digest.Digest
+	}
+	pullResultDigestReturnsOnCall map[int]struct {
+		result1 digest.Digest
+	}
 	PullResultSeccompProfileStub        func(*artifact.PullResult) *v1.SeccompProfile
 	pullResultSeccompProfileMutex       sync.RWMutex
 	pullResultSeccompProfileArgsForCall []struct {
@@ -190,7 +203,7 @@ func (fake *FakeImpl) ClientGetProfileReturnsOnCall(i int, result1 *v1.SeccompPr
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) GetSPOD(arg1 context.Context, arg2 client.Client) (*v1a.SecurityProfilesOperatorDaemon, error) {
+func (fake *FakeImpl) GetSPOD(arg1 context.Context, arg2 client.Client) (*v1b.SecurityProfilesOperatorDaemon, error) {
 	fake.getSPODMutex.Lock()
 	ret, specificReturn := fake.getSPODReturnsOnCall[len(fake.getSPODArgsForCall)]
 	fake.getSPODArgsForCall = append(fake.getSPODArgsForCall, struct {
@@ -216,7 +229,7 @@ func (fake *FakeImpl) GetSPODCallCount() int {
 	return len(fake.getSPODArgsForCall)
 }
 
-func (fake *FakeImpl) GetSPODCalls(stub func(context.Context, client.Client) (*v1a.SecurityProfilesOperatorDaemon, error)) {
+func (fake *FakeImpl) GetSPODCalls(stub func(context.Context, client.Client) (*v1b.SecurityProfilesOperatorDaemon, error)) {
 	fake.getSPODMutex.Lock()
 	defer fake.getSPODMutex.Unlock()
 	fake.GetSPODStub = stub
@@ -229,28 +242,28 @@ func (fake *FakeImpl) GetSPODArgsForCall(i int) (context.Context, client.Client)
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeImpl) GetSPODReturns(result1 *v1a.SecurityProfilesOperatorDaemon, result2 error) {
+func (fake *FakeImpl) GetSPODReturns(result1 *v1b.SecurityProfilesOperatorDaemon, result2 error) {
 	fake.getSPODMutex.Lock()
 	defer fake.getSPODMutex.Unlock()
 	fake.GetSPODStub = nil
 	fake.getSPODReturns = struct {
-		result1 *v1a.SecurityProfilesOperatorDaemon
+		result1 *v1b.SecurityProfilesOperatorDaemon
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) GetSPODReturnsOnCall(i int, result1 *v1a.SecurityProfilesOperatorDaemon, result2 error) {
+func (fake *FakeImpl) GetSPODReturnsOnCall(i int, result1 *v1b.SecurityProfilesOperatorDaemon, result2 error) {
 	fake.getSPODMutex.Lock()
 	defer fake.getSPODMutex.Unlock()
 	fake.GetSPODStub = nil
 	if fake.getSPODReturnsOnCall == nil {
 		fake.getSPODReturnsOnCall = make(map[int]struct {
-			result1 *v1a.SecurityProfilesOperatorDaemon
+			result1 *v1b.SecurityProfilesOperatorDaemon
 			result2 error
 		})
 	}
 	fake.getSPODReturnsOnCall[i] = struct {
-		result1 *v1a.SecurityProfilesOperatorDaemon
+		result1 *v1b.SecurityProfilesOperatorDaemon
 		result2 error
 	}{result1, result2}
 }
@@ -288,7 +301,7 @@ func (fake *FakeImpl) IncSeccompProfileErrorArgsForCall(i int) (*metrics.Metrics
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeImpl) Pull(arg1 context.Context, arg2 logr.Logger, arg3 string, arg4 string, arg5 string, arg6 *v1b.Platform, arg7 *artifact.PullSignatureOptions) (*artifact.PullResult, error) {
+func (fake *FakeImpl) Pull(arg1 context.Context, arg2 logr.Logger, arg3 string, arg4 string, arg5 string, arg6 *v1c.Platform, arg7 *artifact.PullSignatureOptions, arg8 *artifact.RegistryOptions) (*artifact.PullResult, error) {
 	fake.pullMutex.Lock()
 	ret, specificReturn := fake.pullReturnsOnCall[len(fake.pullArgsForCall)]
 	fake.pullArgsForCall = append(fake.pullArgsForCall, struct {
@@ -297,15 +310,16 @@ func (fake *FakeImpl) Pull(arg1 context.Context, arg2 logr.Logger, arg3 string,
 		arg3 string
 		arg4 string
 		arg5 string
-		arg6 *v1b.Platform
+		arg6 *v1c.Platform
 		arg7 *artifact.PullSignatureOptions
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+		arg8 *artifact.RegistryOptions
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	stub := fake.PullStub
 	fakeReturns := fake.pullReturns
-	fake.recordInvocation("Pull", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("Pull", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.pullMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -319,17 +333,17 @@ func (fake *FakeImpl) PullCallCount() int {
 	return len(fake.pullArgsForCall)
 }
 
-func (fake *FakeImpl) PullCalls(stub func(context.Context, logr.Logger, string, string, string, *v1b.Platform, *artifact.PullSignatureOptions) (*artifact.PullResult, error)) {
+func (fake *FakeImpl) PullCalls(stub func(context.Context, logr.Logger, string, string, string, *v1c.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) (*artifact.PullResult, error)) {
 	fake.pullMutex.Lock()
 	defer fake.pullMutex.Unlock()
 	fake.PullStub = stub
 }
 
-func (fake *FakeImpl) PullArgsForCall(i int) (context.Context, logr.Logger, string, string, string, *v1b.Platform, *artifact.PullSignatureOptions) {
+func (fake *FakeImpl) PullArgsForCall(i int) (context.Context, logr.Logger, string, string, string, *v1c.Platform, *artifact.PullSignatureOptions, *artifact.RegistryOptions) {
 	fake.pullMutex.RLock()
 	defer fake.pullMutex.RUnlock()
 	argsForCall := fake.pullArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
 func (fake *FakeImpl) PullReturns(result1 *artifact.PullResult, result2 error) {
@@ -358,6 +372,67 @@ func (fake *FakeImpl) PullReturnsOnCall(i int, result1 *artifact.PullResult, res
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) PullResultDigest(arg1 *artifact.PullResult) digest.Digest {
+	fake.pullResultDigestMutex.Lock()
+	ret, specificReturn := fake.pullResultDigestReturnsOnCall[len(fake.pullResultDigestArgsForCall)]
+	fake.pullResultDigestArgsForCall = append(fake.pullResultDigestArgsForCall, struct {
+		arg1 *artifact.PullResult
+	}{arg1})
+	stub := fake.PullResultDigestStub
+	fakeReturns := fake.pullResultDigestReturns
+	fake.recordInvocation("PullResultDigest", []interface{}{arg1})
+	fake.pullResultDigestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) PullResultDigestCallCount() int {
+	fake.pullResultDigestMutex.RLock()
+	defer fake.pullResultDigestMutex.RUnlock()
+	return len(fake.pullResultDigestArgsForCall)
+}
+
+func (fake *FakeImpl) PullResultDigestCalls(stub func(*artifact.PullResult) digest.Digest) {
+	fake.pullResultDigestMutex.Lock()
+	defer fake.pullResultDigestMutex.Unlock()
+	fake.PullResultDigestStub = stub
+}
+
+func (fake *FakeImpl) PullResultDigestArgsForCall(i int) *artifact.PullResult {
+	fake.pullResultDigestMutex.RLock()
+	defer fake.pullResultDigestMutex.RUnlock()
+	argsForCall := fake.pullResultDigestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) PullResultDigestReturns(result1 digest.Digest) {
+	fake.pullResultDigestMutex.Lock()
+	defer fake.pullResultDigestMutex.Unlock()
+	fake.PullResultDigestStub = nil
+	fake.pullResultDigestReturns = struct {
+		result1 digest.Digest
+	}{result1}
+}
+
+func (fake *FakeImpl) PullResultDigestReturnsOnCall(i int, result1 digest.Digest) {
+	fake.pullResultDigestMutex.Lock()
+	defer fake.pullResultDigestMutex.Unlock()
+	fake.PullResultDigestStub = nil
+	if fake.pullResultDigestReturnsOnCall == nil {
+		fake.pullResultDigestReturnsOnCall = make(map[int]struct {
+			result1 digest.Digest
+		})
+	}
+	fake.pullResultDigestReturnsOnCall[i] = struct {
+		result1 digest.Digest
+	}{result1}
+}
+
 func (fake *FakeImpl) PullResultSeccompProfile(arg1 *artifact.PullResult) *v1.SeccompProfile {
 	fake.pullResultSeccompProfileMutex.Lock()
 	ret, specificReturn := fake.pullResultSeccompProfileReturnsOnCall[len(fake.pullResultSeccompProfileArgsForCall)]
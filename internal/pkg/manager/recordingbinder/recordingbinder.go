@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingbinder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	secprofnodestatusapi "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	reasonBindingPromoted string = "ProfileBindingPromoted"
+)
+
+var errUnknownProfileKind = errors.New("unknown profile kind")
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &BindingReconciler{}
+}
+
+// A BindingReconciler promotes the profile bindings generated for recorded
+// profiles to enforcing once their profile is installed on all nodes.
+type BindingReconciler struct {
+	client client.Client
+	log    logr.Logger
+	record record.EventRecorder
+}
+
+// Name returns the name of the controller.
+func (r *BindingReconciler) Name() string {
+	return "recording-binder"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *BindingReconciler) SchemeBuilder() *scheme.Builder {
+	return profilebindingapi.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *BindingReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch
+
+// Reconcile promotes a generated profile binding from Audit to Enforce.
+func (r *BindingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("profileBinding", req.Name, "namespace", req.Namespace)
+
+	binding := &profilebindingapi.ProfileBinding{}
+	if err := r.client.Get(ctx, req.NamespacedName, binding); err != nil {
+		return reconcile.Result{}, util.IgnoreNotFound(err)
+	}
+
+	if !isRecordingBinding(binding) ||
+		binding.Spec.EnforcementMode != profilebindingapi.EnforcementModeAudit {
+		return reconcile.Result{}, nil
+	}
+
+	installed, err := r.profileInstalled(ctx, binding)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !installed {
+		logger.V(config.VerboseLevel).Info(
+			"Profile not yet installed on all nodes", "profile", binding.Spec.ProfileRef.Name,
+		)
+
+		return reconcile.Result{}, nil
+	}
+
+	binding.Spec.EnforcementMode = profilebindingapi.EnforcementModeEnforce
+	if err := r.client.Update(ctx, binding); err != nil {
+		return reconcile.Result{}, fmt.Errorf("promote profile binding: %w", err)
+	}
+
+	logger.Info("Promoted profile binding to enforcing", "profile", binding.Spec.ProfileRef.Name)
+	r.record.Event(binding, util.EventTypeNormal, reasonBindingPromoted,
+		fmt.Sprintf("Profile %s is installed on all nodes, enforcing it", binding.Spec.ProfileRef.Name))
+
+	return reconcile.Result{}, nil
+}
+
+// profileInstalled returns true if the profile of the binding is installed
+// on all nodes. The state of the profile is the lowest state of its
+// SecurityProfileNodeStatus objects.
+func (r *BindingReconciler) profileInstalled(
+	ctx context.Context, binding *profilebindingapi.ProfileBinding,
+) (bool, error) {
+	profile, err := newProfile(binding.Spec.ProfileRef.Kind)
+	if err != nil {
+		return false, err
+	}
+
+	key := types.NamespacedName{Name: binding.Spec.ProfileRef.Name, Namespace: binding.Namespace}
+	if err := r.client.Get(ctx, key, profile); err != nil {
+		// The profile gets reconciled once it exists
+		return false, util.IgnoreNotFound(err)
+	}
+
+	return profile.GetStatusBase().Status == secprofnodestatusapi.ProfileStateInstalled, nil
+}
+
+// newProfile returns an empty profile of the kind.
+func newProfile(kind profilebindingapi.ProfileBindingKind) (profilebaseapi.StatusBaseUser, error) {
+	switch kind {
+	case profilebindingapi.ProfileBindingKindSeccompProfile:
+		return &seccompprofileapi.SeccompProfile{}, nil
+	case profilebindingapi.ProfileBindingKindSelinuxProfile:
+		return &selinuxprofileapi.SelinuxProfile{}, nil
+	case profilebindingapi.ProfileBindingKindAppArmorProfile:
+		return &apparmorprofileapi.AppArmorProfile{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownProfileKind, kind)
+	}
+}
+
+// isRecordingBinding returns true if the binding got generated for a
+// recorded profile.
+func isRecordingBinding(obj client.Object) bool {
+	_, ok := obj.GetLabels()[profilerecordingapi.ProfileToRecordingLabel]
+
+	return ok
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingbinder
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	secprofnodestatusapi "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1"
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, profilebindingapi.AddToScheme(s))
+	require.NoError(t, seccompprofileapi.AddToScheme(s))
+
+	return s
+}
+
+func testBinding(name string, labels map[string]string) *profilebindingapi.ProfileBinding {
+	return &profilebindingapi.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "namespace",
+			Labels:    labels,
+		},
+		Spec: profilebindingapi.ProfileBindingSpec{
+			ProfileRef: profilebindingapi.ProfileRef{
+				Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+				Name: "profile",
+			},
+			Image:           "nginx:1",
+			EnforcementMode: profilebindingapi.EnforcementModeAudit,
+		},
+	}
+}
+
+func testProfile(state secprofnodestatusapi.ProfileState) *seccompprofileapi.SeccompProfile {
+	profile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "profile",
+			Namespace: "namespace",
+			Labels:    map[string]string{profilerecordingapi.ProfileToRecordingLabel: "recording"},
+		},
+	}
+	profile.Status.Status = state
+
+	return profile
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	recordingLabels := map[string]string{profilerecordingapi.ProfileToRecordingLabel: "recording"}
+
+	for _, tc := range []struct {
+		name     string
+		binding  *profilebindingapi.ProfileBinding
+		profile  *seccompprofileapi.SeccompProfile
+		wantMode profilebindingapi.EnforcementMode
+	}{
+		{
+			name:     "promote when profile is installed",
+			binding:  testBinding("binding", recordingLabels),
+			profile:  testProfile(secprofnodestatusapi.ProfileStateInstalled),
+			wantMode: profilebindingapi.EnforcementModeEnforce,
+		},
+		{
+			name:     "keep auditing while profile is pending",
+			binding:  testBinding("binding", recordingLabels),
+			profile:  testProfile(secprofnodestatusapi.ProfileStatePending),
+			wantMode: profilebindingapi.EnforcementModeAudit,
+		},
+		{
+			name:     "keep auditing while profile does not exist",
+			binding:  testBinding("binding", recordingLabels),
+			wantMode: profilebindingapi.EnforcementModeAudit,
+		},
+		{
+			name:     "ignore bindings not created for recordings",
+			binding:  testBinding("binding", nil),
+			profile:  testProfile(secprofnodestatusapi.ProfileStateInstalled),
+			wantMode: profilebindingapi.EnforcementModeAudit,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(tc.binding)
+			if tc.profile != nil {
+				builder = builder.WithObjects(tc.profile)
+			}
+
+			cli := builder.Build()
+
+			sut := &BindingReconciler{
+				client: cli,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			_, err := sut.Reconcile(t.Context(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.binding)})
+			require.NoError(t, err)
+
+			binding := &profilebindingapi.ProfileBinding{}
+			require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(tc.binding), binding))
+			require.Equal(t, tc.wantMode, binding.Spec.EnforcementMode)
+		})
+	}
+}
+
+func TestProfileBindings(t *testing.T) {
+	t.Parallel()
+
+	recordingLabels := map[string]string{profilerecordingapi.ProfileToRecordingLabel: "recording"}
+
+	otherProfile := testBinding("other-profile", recordingLabels)
+	otherProfile.Spec.ProfileRef.Name = "other"
+
+	otherKind := testBinding("other-kind", recordingLabels)
+	otherKind.Spec.ProfileRef.Kind = profilebindingapi.ProfileBindingKindSelinuxProfile
+
+	cli := fake.NewClientBuilder().
+		WithScheme(testScheme(t)).
+		WithObjects(
+			testBinding("binding", recordingLabels),
+			testBinding("unlabeled", nil),
+			otherProfile,
+			otherKind,
+		).
+		Build()
+
+	sut := &BindingReconciler{client: cli, log: logr.Discard()}
+
+	requests := sut.profileBindings(profilebindingapi.ProfileBindingKindSeccompProfile)(
+		t.Context(), testProfile(secprofnodestatusapi.ProfileStateInstalled),
+	)
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: client.ObjectKey{Name: "binding", Namespace: "namespace"},
+	}}, requests)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingbinder
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+)
+
+// Setup adds a controller that reconciles the profile bindings of recorded
+// profiles.
+func (r *BindingReconciler) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.client = mgr.GetClient()
+	r.log = ctrl.Log.WithName(r.Name())
+	r.record = mgr.GetEventRecorderFor(r.Name()) //nolint:staticcheck,nolintlint // TODO: migrate to GetEventRecorder
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(r.Name()).
+		For(
+			&profilebindingapi.ProfileBinding{},
+			builder.WithPredicates(recordingPredicate),
+		).
+		Watches(
+			&seccompprofileapi.SeccompProfile{},
+			handler.EnqueueRequestsFromMapFunc(r.profileBindings(profilebindingapi.ProfileBindingKindSeccompProfile)),
+			builder.WithPredicates(recordingPredicate),
+		).
+		Watches(
+			&selinuxprofileapi.SelinuxProfile{},
+			handler.EnqueueRequestsFromMapFunc(r.profileBindings(profilebindingapi.ProfileBindingKindSelinuxProfile)),
+			builder.WithPredicates(recordingPredicate),
+		).
+		Watches(
+			&apparmorprofileapi.AppArmorProfile{},
+			handler.EnqueueRequestsFromMapFunc(r.profileBindings(profilebindingapi.ProfileBindingKindAppArmorProfile)),
+			builder.WithPredicates(recordingPredicate),
+		).
+		Complete(r)
+}
+
+// recordingPredicate filters for the objects of recordings.
+var recordingPredicate = predicate.NewPredicateFuncs(isRecordingBinding)
+
+// profileBindings returns a map function which returns the generated
+// bindings of a recorded profile of the kind.
+func (r *BindingReconciler) profileBindings(kind profilebindingapi.ProfileBindingKind) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		bindings := &profilebindingapi.ProfileBindingList{}
+		if err := r.client.List(ctx, bindings,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingLabels{
+				profilerecordingapi.ProfileToRecordingLabel: obj.GetLabels()[profilerecordingapi.ProfileToRecordingLabel],
+			},
+		); err != nil {
+			r.log.Error(err, "Cannot list profile bindings", "profile", obj.GetName())
+
+			return nil
+		}
+
+		requests := []reconcile.Request{}
+
+		for i := range bindings.Items {
+			ref := bindings.Items[i].Spec.ProfileRef
+			if ref.Kind == kind && ref.Name == obj.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(&bindings.Items[i]),
+				})
+			}
+		}
+
+		return requests
+	}
+}
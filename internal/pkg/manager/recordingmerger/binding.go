@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+)
+
+// bindingHashLength is the length of the image hash in the names of the
+// generated profile bindings.
+const bindingHashLength = 8
+
+// bindingName returns the name of the profile binding of the merged profile
+// for the image.
+func bindingName(profileName, image string) string {
+	hash := sha256.Sum256([]byte(image))
+
+	return fmt.Sprintf("%s-%s", profileName, hex.EncodeToString(hash[:])[:bindingHashLength])
+}
+
+// bindMergedProfile creates a profile binding for every image of the
+// containers which produced the merged profile. New bindings start in the
+// Audit enforcement mode and get promoted by the recording binder once the
+// profile is installed on all nodes.
+func (r *PolicyMergeReconciler) bindMergedProfile(
+	ctx context.Context,
+	profileRecording *profilerecordingapi.ProfileRecording,
+	mergedProfile metav1.Object,
+	profileName string,
+	images []string,
+) error {
+	if len(images) == 0 {
+		r.log.Info("Not binding merged profile without container images", "name", profileName)
+
+		return nil
+	}
+
+	var containerNames []string
+	if cntName := mergedProfile.GetLabels()[profilerecordingapi.ProfileToContainerLabel]; cntName != "" {
+		containerNames = []string{cntName}
+	}
+
+	for _, image := range images {
+		binding := &profilebindingapi.ProfileBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindingName(profileName, image),
+				Namespace: profileRecording.Namespace,
+			},
+		}
+
+		res, err := controllerutil.CreateOrUpdate(ctx, r.client, binding,
+			func() error {
+				if binding.Labels == nil {
+					binding.Labels = map[string]string{}
+				}
+
+				binding.Labels[profilerecordingapi.ProfileToRecordingLabel] = profileRecording.Name
+				binding.Labels[profilerecordingapi.ProfileToRecordingNamespaceLabel] = profileRecording.Namespace
+
+				binding.Spec.ProfileRef = profilebindingapi.ProfileRef{
+					Kind: profilebindingapi.ProfileBindingKind(profileRecording.Spec.Kind),
+					Name: profileName,
+				}
+				binding.Spec.Image = image
+				binding.Spec.ImageMatch = profilebindingapi.ImageMatchExact
+				binding.Spec.ContainerNames = containerNames
+				binding.Spec.PodSelector = profileRecording.Spec.PodSelector.DeepCopy()
+
+				// Keep bindings which got already promoted
+				if binding.Spec.EnforcementMode == "" {
+					binding.Spec.EnforcementMode = profilebindingapi.EnforcementModeAudit
+				}
+
+				return nil
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("create or update profile binding %s: %w", binding.Name, err)
+		}
+
+		r.log.Info("Created/updated profile binding", "action", res, "name", binding.Name)
+	}
+
+	return nil
+}
@@ -18,12 +18,15 @@ package recordingmerger
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
@@ -34,8 +37,12 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
-func mergedObjectMeta(profileName, recordingName, namespace string) *metav1.ObjectMeta {
-	return &metav1.ObjectMeta{
+// mergedObjectMeta returns the metadata of a merged profile. The workload
+// labels are taken over from the merged partial profiles. Owner references
+// are never set, because the profiles are cluster scoped and cannot be owned
+// by the namespaced workloads.
+func mergedObjectMeta(profileName, recordingName, namespace string, merged metav1.Object) *metav1.ObjectMeta {
+	objectMeta := &metav1.ObjectMeta{
 		Name:      profileName,
 		Namespace: namespace,
 		Labels: map[string]string{
@@ -43,15 +50,157 @@ func mergedObjectMeta(profileName, recordingName, namespace string) *metav1.Obje
 			profilerecordingapi.ProfileToRecordingNamespaceLabel: namespace,
 		},
 	}
+
+	for _, label := range workloadLabels {
+		if value, ok := merged.GetLabels()[label]; ok {
+			objectMeta.Labels[label] = value
+		}
+	}
+
+	setContainerImages(objectMeta, containerImages(merged))
+
+	return objectMeta
+}
+
+// containerImages returns the images of the containers which produced the
+// profile.
+func containerImages(prf metav1.Object) []string {
+	value := prf.GetAnnotations()[profilerecordingapi.ProfileContainerImagesAnnotation]
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
+// mergedContainerImages returns the sorted union of the container images of
+// the profiles.
+func mergedContainerImages(profiles []mergeableProfile) []string {
+	images := sets.New[string]()
+	for _, prf := range profiles {
+		images.Insert(containerImages(prf)...)
+	}
+
+	return sets.List(images)
+}
+
+// setContainerImages sets the container images annotation of the profile.
+func setContainerImages(prf metav1.Object, images []string) {
+	if len(images) == 0 {
+		return
+	}
+
+	annotations := prf.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[profilerecordingapi.ProfileContainerImagesAnnotation] = strings.Join(images, ",")
+	prf.SetAnnotations(annotations)
+}
+
+var workloadLabels = []string{
+	profilerecordingapi.ProfileToWorkloadKindLabel,
+	profilerecordingapi.ProfileToWorkloadNameLabel,
+}
+
+// sharedWorkload returns true if all profiles got recorded from the same
+// workload.
+func sharedWorkload(profiles []mergeableProfile) bool {
+	for _, prf := range profiles[1:] {
+		for _, label := range workloadLabels {
+			if prf.GetLabels()[label] != profiles[0].GetLabels()[label] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// removeWorkload removes the workload labels from the profile.
+func removeWorkload(prf metav1.Object) {
+	labels := prf.GetLabels()
+	for _, label := range workloadLabels {
+		delete(labels, label)
+	}
+
+	prf.SetLabels(labels)
+}
+
+// namingWorkload returns the name of the workload which is part of the
+// merged profile name, or an empty string if the recording does not name
+// the profiles after workloads.
+func namingWorkload(recording *profilerecordingapi.ProfileRecording, prf metav1.Object) string {
+	if recording.Spec.ProfileNaming != profilerecordingapi.ProfileNamingWorkload {
+		return ""
+	}
+
+	return prf.GetLabels()[profilerecordingapi.ProfileToWorkloadNameLabel]
+}
+
+// mergeHistoryLimit is the maximum number of merges kept in the merge
+// history annotation of a merged profile.
+const mergeHistoryLimit = 10
+
+// mergeHistoryEntry is a single merge in the merge history annotation.
+type mergeHistoryEntry struct {
+	Time            metav1.Time `json:"time"`
+	Recording       string      `json:"recording"`
+	PartialProfiles int         `json:"partialProfiles"`
+}
+
+// appendMergeHistory adds the merge of the partial profiles to the merge
+// history annotation of the merged profile.
+func appendMergeHistory(
+	mergedProfile metav1.Object,
+	recording *profilerecordingapi.ProfileRecording,
+	partialProfiles int,
+) error {
+	annotations := mergedProfile.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	history := []mergeHistoryEntry{}
+	if value, ok := annotations[profilerecordingapi.ProfileMergeHistoryAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &history); err != nil {
+			// Start a new history instead of failing the merge
+			history = []mergeHistoryEntry{}
+		}
+	}
+
+	history = append(history, mergeHistoryEntry{
+		Time:            metav1.Now(),
+		Recording:       client.ObjectKeyFromObject(recording).String(),
+		PartialProfiles: partialProfiles,
+	})
+	if len(history) > mergeHistoryLimit {
+		history = history[len(history)-mergeHistoryLimit:]
+	}
+
+	value, err := json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("marshal merge history: %w", err)
+	}
+
+	annotations[profilerecordingapi.ProfileMergeHistoryAnnotation] = string(value)
+	mergedProfile.SetAnnotations(annotations)
+
+	return nil
 }
 
-func mergedProfileName(recordingName string, prf metav1.Object) string {
+func mergedProfileName(recording *profilerecordingapi.ProfileRecording, prf metav1.Object) string {
 	suffix := prf.GetLabels()[profilerecordingapi.ProfileToContainerLabel]
 	if suffix == "" {
 		suffix = prf.GetName()
 	}
 
-	return fmt.Sprintf("%s-%s", recordingName, suffix)
+	if workload := namingWorkload(recording, prf); workload != "" {
+		suffix = fmt.Sprintf("%s-%s", workload, suffix)
+	}
+
+	return fmt.Sprintf("%s-%s", recording.Name, suffix)
 }
 
 func mergeMergeableProfiles(profiles []mergeableProfile) (mergeableProfile, error) {
@@ -107,7 +256,7 @@ func listPartialProfiles(
 			return fmt.Errorf("failed to create mergeable profile for %s: %w", clientObj.GetName(), err)
 		}
 
-		containerID := getContainerID(clientObj)
+		containerID := getContainerID(clientObj, recording)
 		if containerID == "" {
 			// todo: log
 			return nil
@@ -145,13 +294,25 @@ func MergeProfiles(
 	return merged.getProfile(), nil
 }
 
-func getContainerID(prf client.Object) string {
+// getContainerID returns the key for grouping the partial profiles of a
+// container. Containers of different workloads are kept apart if the
+// recording names the profiles after workloads.
+func getContainerID(prf client.Object, recording *profilerecordingapi.ProfileRecording) string {
 	labels := prf.GetLabels()
 	if labels == nil {
 		return ""
 	}
 
-	return labels[profilerecordingapi.ProfileToContainerLabel]
+	containerID := labels[profilerecordingapi.ProfileToContainerLabel]
+	if containerID == "" {
+		return ""
+	}
+
+	if workload := namingWorkload(recording, prf); workload != "" {
+		return fmt.Sprintf("%s/%s", workload, containerID)
+	}
+
+	return containerID
 }
 
 func deletePartialProfiles(
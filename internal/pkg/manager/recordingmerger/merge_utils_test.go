@@ -21,10 +21,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
 )
@@ -292,3 +297,80 @@ func TestMergeProfiles(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateUpdateProfileClusterScoped(t *testing.T) {
+	t.Parallel()
+
+	deployment := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "web",
+		UID:        "uid",
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:            "recording-container-abcde",
+		Namespace:       "namespace",
+		OwnerReferences: []metav1.OwnerReference{deployment},
+	}
+
+	for _, tc := range []struct {
+		kind    profilerecordingapi.ProfileRecordingKind
+		partial mergeableProfile
+		merged  client.Object
+	}{
+		{
+			kind: profilerecordingapi.ProfileRecordingKindSeccompProfile,
+			partial: &mergeableSeccompProfile{
+				SeccompProfile: seccompprofile.SeccompProfile{ObjectMeta: *objectMeta.DeepCopy()},
+			},
+			merged: &seccompprofile.SeccompProfile{},
+		},
+		{
+			kind: profilerecordingapi.ProfileRecordingKindSelinuxProfile,
+			partial: &MergeableSelinuxProfile{
+				SelinuxProfile: selinuxprofileapi.SelinuxProfile{ObjectMeta: *objectMeta.DeepCopy()},
+			},
+			merged: &selinuxprofileapi.SelinuxProfile{},
+		},
+		{
+			kind: profilerecordingapi.ProfileRecordingKindAppArmorProfile,
+			partial: &mergeableAppArmorProfile{
+				AppArmorProfile: apparmorprofileapi.AppArmorProfile{ObjectMeta: *objectMeta.DeepCopy()},
+			},
+			merged: &apparmorprofileapi.AppArmorProfile{},
+		},
+	} {
+		t.Run(string(tc.kind), func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, seccompprofile.AddToScheme(s))
+			require.NoError(t, selinuxprofileapi.AddToScheme(s))
+			require.NoError(t, apparmorprofileapi.AddToScheme(s))
+
+			// The profile CRDs are cluster scoped, which means that the
+			// namespaced workloads can never become their owners.
+			gvk, err := apiutil.GVKForObject(tc.merged, s)
+			require.NoError(t, err)
+
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(gvk, meta.RESTScopeRoot)
+
+			cli := fake.NewClientBuilder().WithScheme(s).WithRESTMapper(mapper).Build()
+
+			namespaced, err := cli.IsObjectNamespaced(tc.merged)
+			require.NoError(t, err)
+			require.False(t, namespaced)
+
+			recording := &profilerecordingapi.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{Name: "recording", Namespace: "namespace"},
+			}
+
+			_, err = createUpdateProfile(t.Context(), cli, recording, "recording-container", tc.partial, 1, tc.kind)
+			require.NoError(t, err)
+
+			require.NoError(t, cli.Get(t.Context(), client.ObjectKey{Name: "recording-container", Namespace: "namespace"}, tc.merged))
+			require.Empty(t, tc.merged.GetOwnerReferences())
+		})
+	}
+}
@@ -21,9 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -51,6 +56,7 @@ const (
 	reasonCannotCreateUpdate string = "CannotCreateUpdateMergedProfile"
 	reasonMergedEmptyProfile string = "MergedEmptyProfile"
 	reasonNoPartialProfiles  string = "NoPartialProfiles"
+	reasonCannotBind         string = "CannotCreateUpdateProfileBinding"
 )
 
 // NewController returns a new empty controller instance.
@@ -84,8 +90,11 @@ func (r *PolicyMergeReconciler) Healthz(*http.Request) error {
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/finalizers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;create;update;patch
 
 // Reconcile reconciles a NodeStatus.
 func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -107,40 +116,112 @@ func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Req
 	if !profileRecording.GetDeletionTimestamp().IsZero() { // object is being deleted
 		logger.Info("Is being deleted, will check if there are policies to be merged")
 
-		if err := r.mergeProfiles(ctx, profileRecording); err != nil {
+		if err := r.mergeProfiles(ctx, profileRecording, profileRecording.Spec.MergesIntoExistingProfile()); err != nil {
 			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
 		}
 
 		return reconcile.Result{}, nil
 	}
 
-	// We don't really care until the recording is being deleted
+	if profileRecording.Spec.MergeStrategy == profilerecordingapi.ProfileMergeContinuous {
+		logger.Info("Is merged continuously, will check if there are policies to be merged")
+
+		if err := r.mergeProfiles(ctx, profileRecording, true); err != nil {
+			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	if profileRecording.Spec.MergeOnCompletion && meta.IsStatusConditionTrue(
+		profileRecording.Status.Conditions, profilerecordingapi.ProfileRecordingConditionCompleted,
+	) {
+		logger.Info("Is completed, will check if there are policies to be merged")
+
+		if err := r.mergeProfiles(ctx, profileRecording, true); err != nil {
+			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	// We don't really care until the recording is being deleted, completed
+	// or merged continuously
 	return reconcile.Result{}, nil
 }
 
+// mergeProfiles merges the partial profiles of the recording. An incremental
+// merge also includes the already merged profiles, because recordings merged
+// on completion or continuously keep getting partial profiles from other
+// pods, and appending recordings accumulate into the existing profiles.
 func (r *PolicyMergeReconciler) mergeProfiles(
 	ctx context.Context,
 	profileRecording *profilerecordingapi.ProfileRecording,
+	incremental bool,
 ) error {
-	var err error
+	var (
+		mergedProfiles []string
+		err            error
+	)
 
 	switch profileRecording.Spec.Kind {
 	case profilerecordingapi.ProfileRecordingKindSeccompProfile:
-		err = r.mergeSeccompProfiles(ctx, profileRecording)
+		mergedProfiles, err = r.mergeSeccompProfiles(ctx, profileRecording, incremental)
 	case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
-		err = r.mergeSelinuxProfiles(ctx, profileRecording)
+		mergedProfiles, err = r.mergeSelinuxProfiles(ctx, profileRecording, incremental)
 	case profilerecordingapi.ProfileRecordingKindAppArmorProfile:
-		err = r.mergeAppArmorProfiles(ctx, profileRecording)
+		mergedProfiles, err = r.mergeAppArmorProfiles(ctx, profileRecording, incremental)
 	default:
 		err = fmt.Errorf("%s: %s", errCannotMergeKind, profileRecording.Spec.Kind)
 		r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotMergeKind, err.Error())
 	}
 
 	if err != nil {
+		r.setMergedCondition(
+			ctx, profileRecording, metav1.ConditionFalse,
+			profilerecordingapi.ProfileRecordingReasonMergeFailed, err.Error(),
+		)
+
 		return fmt.Errorf("cannot merge profiles: %w", err)
 	}
 
-	return err
+	if len(mergedProfiles) > 0 {
+		r.setMergedCondition(
+			ctx, profileRecording, metav1.ConditionTrue,
+			profilerecordingapi.ProfileRecordingReasonProfilesMerged,
+			"Merged profiles: "+strings.Join(mergedProfiles, ", "),
+		)
+	}
+
+	return nil
+}
+
+// setMergedCondition sets the Merged condition of the recording. Failures
+// are only logged, because the status is informational.
+func (r *PolicyMergeReconciler) setMergedCondition(
+	ctx context.Context,
+	profileRecording *profilerecordingapi.ProfileRecording,
+	status metav1.ConditionStatus,
+	reason, message string,
+) {
+	if err := util.Retry(func() error {
+		recording := &profilerecordingapi.ProfileRecording{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(profileRecording), recording); err != nil {
+			return err
+		}
+
+		meta.SetStatusCondition(&recording.Status.Conditions, metav1.Condition{
+			Type:               profilerecordingapi.ProfileRecordingConditionMerged,
+			Status:             status,
+			ObservedGeneration: recording.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+
+		return r.client.Status().Update(ctx, recording)
+	}, kerrors.IsConflict); util.IgnoreNotFound(err) != nil {
+		r.log.Error(err, "Cannot update the merged condition of the recording")
+	}
 }
 
 func (r *PolicyMergeReconciler) mergeTypedProfiles(
@@ -149,47 +230,116 @@ func (r *PolicyMergeReconciler) mergeTypedProfiles(
 	createUpdateMergedProfile createUpdateFn,
 	profileItem client.Object,
 	listItem client.ObjectList,
-) error {
+	incremental bool,
+) ([]string, error) {
 	partialProfiles, err := listPartialProfiles(ctx, r.client, listItem, profileRecording)
 	if err != nil {
-		return fmt.Errorf("cannot list partial profiles: %w", err)
+		return nil, fmt.Errorf("cannot list partial profiles: %w", err)
+	}
+
+	if len(partialProfiles) == 0 && incremental {
+		// Nothing new recorded since the last merge
+		return nil, nil
 	}
 
 	if len(partialProfiles) == 0 {
 		r.record.Event(profileRecording, util.EventTypeWarning, reasonNoPartialProfiles, errNoPartialProfiles)
 		r.log.Info(errNoPartialProfiles)
 
-		return nil
+		return nil, nil
 	}
 
+	mergedProfiles := make([]string, 0, len(partialProfiles))
+
 	for cntName, cntPartialProfiles := range partialProfiles {
 		r.log.Info("Merging profiles for container", "container", cntName)
 
+		mergedRecordingName := mergedProfileName(profileRecording, cntPartialProfiles[0])
+		partialProfilesCount := len(cntPartialProfiles)
+
+		if !sharedWorkload(cntPartialProfiles) {
+			// The merged profile does not belong to a single workload
+			removeWorkload(cntPartialProfiles[0])
+		}
+
+		if incremental {
+			cntPartialProfiles, err = r.withMergedProfile(
+				ctx, profileItem, profileRecording.Namespace, mergedRecordingName, cntPartialProfiles,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("cannot get merged profile: %w", err)
+			}
+		}
+
+		images := mergedContainerImages(cntPartialProfiles)
+
 		mergedProfile, err := mergeMergeableProfiles(cntPartialProfiles)
 		if err != nil {
-			return fmt.Errorf("cannot merge partial profiles: %w", err)
+			return nil, fmt.Errorf("cannot merge partial profiles: %w", err)
 		}
 
 		if mergedProfile == nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonMergedEmptyProfile, errEmptyMergedProfile)
 			r.log.Info(errEmptyMergedProfile)
 
-			return nil
+			return nil, nil
 		}
 
-		mergedRecordingName := mergedProfileName(profileRecording.Name, cntPartialProfiles[0])
+		setContainerImages(mergedProfile, images)
 
-		res, err := createUpdateMergedProfile(ctx, r.client, profileRecording, mergedRecordingName, mergedProfile)
+		res, err := createUpdateMergedProfile(
+			ctx, r.client, profileRecording, mergedRecordingName, mergedProfile, partialProfilesCount,
+		)
 		if err != nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotCreateUpdate, err.Error())
 
-			return fmt.Errorf("cannot create or update merged profile: action:  %w", err)
+			return nil, fmt.Errorf("cannot create or update merged profile: action:  %w", err)
 		}
 
 		r.log.Info("Created/updated profile", "action", res, "name", mergedRecordingName)
+		mergedProfiles = append(mergedProfiles, mergedRecordingName)
+
+		if profileRecording.Spec.BindAfterRecording {
+			if err := r.bindMergedProfile(ctx, profileRecording, mergedProfile, mergedRecordingName, images); err != nil {
+				r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotBind, err.Error())
+
+				return nil, fmt.Errorf("cannot bind merged profile: %w", err)
+			}
+		}
+	}
+
+	sort.Strings(mergedProfiles)
+
+	return mergedProfiles, deletePartialProfiles(ctx, r.client, profileItem, profileRecording)
+}
+
+// withMergedProfile adds an already existing merged profile to the partial
+// profiles.
+func (r *PolicyMergeReconciler) withMergedProfile(
+	ctx context.Context,
+	profileItem client.Object,
+	namespace, name string,
+	partialProfiles []mergeableProfile,
+) ([]mergeableProfile, error) {
+	mergedObj, ok := profileItem.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("object %T is not a client.Object", profileItem)
+	}
+
+	if err := r.client.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, mergedObj); err != nil {
+		if util.IgnoreNotFound(err) == nil {
+			return partialProfiles, nil
+		}
+
+		return nil, err
+	}
+
+	merged, err := newMergeableProfile(mergedObj)
+	if err != nil {
+		return nil, err
 	}
 
-	return deletePartialProfiles(ctx, r.client, profileItem, profileRecording)
+	return append(partialProfiles, merged), nil
 }
 
 type createUpdateFn func(
@@ -198,42 +348,51 @@ type createUpdateFn func(
 	profileRecording *profilerecordingapi.ProfileRecording,
 	mergedRecordingName string,
 	mergedProfiles mergeableProfile,
+	partialProfiles int,
 ) (controllerutil.OperationResult, error)
 
 func (r *PolicyMergeReconciler) mergeSeccompProfiles(
 	ctx context.Context,
 	profileRecording *profilerecordingapi.ProfileRecording,
-) error {
+	incremental bool,
+) ([]string, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
 		createUpdateSeccompProfile,
 		&seccompprofile.SeccompProfile{},
-		&seccompprofile.SeccompProfileList{})
+		&seccompprofile.SeccompProfileList{},
+		incremental,
+	)
 }
 
 func (r *PolicyMergeReconciler) mergeSelinuxProfiles(
 	ctx context.Context,
 	profileRecording *profilerecordingapi.ProfileRecording,
-) error {
+	incremental bool,
+) ([]string, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
 		createUpdateSelinuxProfile,
 		&selinuxprofileapi.SelinuxProfile{},
-		&selinuxprofileapi.SelinuxProfileList{})
+		&selinuxprofileapi.SelinuxProfileList{},
+		incremental,
+	)
 }
 
 func (r *PolicyMergeReconciler) mergeAppArmorProfiles(
 	ctx context.Context,
 	profileRecording *profilerecordingapi.ProfileRecording,
-) error {
+	incremental bool,
+) ([]string, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
 		createUpdateApparmorProfile,
 		&apparmorprofileapi.AppArmorProfile{},
 		&apparmorprofileapi.AppArmorProfileList{},
+		incremental,
 	)
 }
 
@@ -243,6 +402,7 @@ func createUpdateSeccompProfile(
 	profileRecording *profilerecordingapi.ProfileRecording,
 	mergedRecordingName string,
 	mergedProfiles mergeableProfile,
+	partialProfiles int,
 ) (controllerutil.OperationResult, error) {
 	return createUpdateProfile(
 		ctx,
@@ -250,6 +410,7 @@ func createUpdateSeccompProfile(
 		profileRecording,
 		mergedRecordingName,
 		mergedProfiles,
+		partialProfiles,
 		profilerecordingapi.ProfileRecordingKindSeccompProfile,
 	)
 }
@@ -260,6 +421,7 @@ func createUpdateSelinuxProfile(
 	profileRecording *profilerecordingapi.ProfileRecording,
 	mergedRecordingName string,
 	mergedProfiles mergeableProfile,
+	partialProfiles int,
 ) (controllerutil.OperationResult, error) {
 	return createUpdateProfile(
 		ctx,
@@ -267,6 +429,7 @@ func createUpdateSelinuxProfile(
 		profileRecording,
 		mergedRecordingName,
 		mergedProfiles,
+		partialProfiles,
 		profilerecordingapi.ProfileRecordingKindSelinuxProfile,
 	)
 }
@@ -277,6 +440,7 @@ func createUpdateApparmorProfile(
 	profileRecording *profilerecordingapi.ProfileRecording,
 	mergedRecordingName string,
 	mergedProfiles mergeableProfile,
+	partialProfiles int,
 ) (controllerutil.OperationResult, error) {
 	return createUpdateProfile(
 		ctx,
@@ -284,6 +448,7 @@ func createUpdateApparmorProfile(
 		profileRecording,
 		mergedRecordingName,
 		mergedProfiles,
+		partialProfiles,
 		profilerecordingapi.ProfileRecordingKindAppArmorProfile,
 	)
 }
@@ -294,68 +459,78 @@ func createUpdateProfile(
 	profileRecording *profilerecordingapi.ProfileRecording,
 	mergedRecordingName string,
 	mergedProfiles mergeableProfile,
+	partialProfiles int,
 	kind profilerecordingapi.ProfileRecordingKind,
 ) (controllerutil.OperationResult, error) {
 	switch kind {
 	case profilerecordingapi.ProfileRecordingKindSeccompProfile:
-		mergedSp := &seccompprofile.SeccompProfile{
-			ObjectMeta: *mergedObjectMeta(mergedRecordingName, profileRecording.Name, profileRecording.Namespace),
-		}
-
 		mergedProf, ok := mergedProfiles.getProfile().(*seccompprofile.SeccompProfile)
 		if !ok {
 			return controllerutil.OperationResultNone, errors.New("cannot convert merged profile to SeccompProfile")
 		}
 
+		mergedSp := &seccompprofile.SeccompProfile{
+			ObjectMeta: *mergedObjectMeta(
+				mergedRecordingName, profileRecording.Name, profileRecording.Namespace, mergedProf,
+			),
+		}
+
 		mergedSpec := mergedProf.Spec.DeepCopy()
 		mergedSp.Spec = *mergedSpec
 
 		return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 			func() error {
 				mergedSp.Spec = *mergedSpec
+				setContainerImages(mergedSp, containerImages(mergedProf))
 
-				return nil
+				return appendMergeHistory(mergedSp, profileRecording, partialProfiles)
 			},
 		)
 
 	case profilerecordingapi.ProfileRecordingKindSelinuxProfile:
-		mergedSp := &selinuxprofileapi.SelinuxProfile{
-			ObjectMeta: *mergedObjectMeta(mergedRecordingName, profileRecording.Name, profileRecording.Namespace),
-		}
-
 		mergedProf, ok := mergedProfiles.getProfile().(*selinuxprofileapi.SelinuxProfile)
 		if !ok {
 			return controllerutil.OperationResultNone, errors.New("cannot convert merged profile to SelinuxProfile")
 		}
 
+		mergedSp := &selinuxprofileapi.SelinuxProfile{
+			ObjectMeta: *mergedObjectMeta(
+				mergedRecordingName, profileRecording.Name, profileRecording.Namespace, mergedProf,
+			),
+		}
+
 		mergedSpec := mergedProf.Spec.DeepCopy()
 		mergedSp.Spec = *mergedSpec
 
 		return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 			func() error {
 				mergedSp.Spec = *mergedSpec
+				setContainerImages(mergedSp, containerImages(mergedProf))
 
-				return nil
+				return appendMergeHistory(mergedSp, profileRecording, partialProfiles)
 			},
 		)
 	case profilerecordingapi.ProfileRecordingKindAppArmorProfile:
-		mergedSp := &apparmorprofileapi.AppArmorProfile{
-			ObjectMeta: *mergedObjectMeta(mergedRecordingName, profileRecording.Name, profileRecording.Namespace),
-		}
-
 		mergedProf, ok := mergedProfiles.getProfile().(*apparmorprofileapi.AppArmorProfile)
 		if !ok {
 			return controllerutil.OperationResultNone, errors.New("cannot convert merged profile to AppArmorProfile")
 		}
 
+		mergedSp := &apparmorprofileapi.AppArmorProfile{
+			ObjectMeta: *mergedObjectMeta(
+				mergedRecordingName, profileRecording.Name, profileRecording.Namespace, mergedProf,
+			),
+		}
+
 		mergedSpec := mergedProf.Spec.DeepCopy()
 		mergedSp.Spec = *mergedSpec
 
 		return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 			func() error {
 				mergedSp.Spec = *mergedSpec
+				setContainerImages(mergedSp, containerImages(mergedProf))
 
-				return nil
+				return appendMergeHistory(mergedSp, profileRecording, partialProfiles)
 			},
 		)
 	default:
@@ -0,0 +1,473 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+)
+
+func testSeccompProfile(name string, partial bool, syscalls ...string) *seccompprofile.SeccompProfile {
+	labels := map[string]string{
+		profilerecordingapi.ProfileToRecordingLabel:          "recording",
+		profilerecordingapi.ProfileToRecordingNamespaceLabel: "namespace",
+		profilerecordingapi.ProfileToContainerLabel:          "container",
+	}
+	if partial {
+		labels[profilebase.ProfilePartialLabel] = "true"
+	}
+
+	return &seccompprofile.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "namespace",
+			Labels:    labels,
+		},
+		Spec: seccompprofile.SeccompProfileSpec{
+			DefaultAction: seccompprofile.ActErrno,
+			Syscalls: []seccompprofile.Syscall{{
+				Action: seccompprofile.ActAllow,
+				Names:  syscalls,
+			}},
+		},
+	}
+}
+
+func TestReconcileMerge(t *testing.T) {
+	t.Parallel()
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, profilerecordingapi.AddToScheme(schemeInstance))
+	require.NoError(t, seccompprofile.AddToScheme(schemeInstance))
+
+	completed := profilerecordingapi.ProfileRecordingStatus{
+		Conditions: []metav1.Condition{{
+			Type:   profilerecordingapi.ProfileRecordingConditionCompleted,
+			Status: metav1.ConditionTrue,
+			Reason: profilerecordingapi.ProfileRecordingReasonDurationReached,
+		}},
+	}
+
+	for _, tc := range []struct {
+		name             string
+		prepare          func(*profilerecordingapi.ProfileRecording)
+		existingSyscalls []string
+		wantSyscalls     []string
+	}{
+		{
+			name: "merge on completion",
+			prepare: func(rec *profilerecordingapi.ProfileRecording) {
+				rec.Spec.MergeOnCompletion = true
+				rec.Status = completed
+			},
+			wantSyscalls: []string{"read", "write"},
+		},
+		{
+			name: "merge on completion into existing profile",
+			prepare: func(rec *profilerecordingapi.ProfileRecording) {
+				rec.Spec.MergeOnCompletion = true
+				rec.Status = completed
+			},
+			existingSyscalls: []string{"open"},
+			wantSyscalls:     []string{"open", "read", "write"},
+		},
+		{
+			name: "continuous merge into existing profile",
+			prepare: func(rec *profilerecordingapi.ProfileRecording) {
+				rec.Spec.MergeStrategy = profilerecordingapi.ProfileMergeContinuous
+			},
+			existingSyscalls: []string{"open"},
+			wantSyscalls:     []string{"open", "read", "write"},
+		},
+		{
+			name: "deletion replaces existing profile",
+			prepare: func(rec *profilerecordingapi.ProfileRecording) {
+				now := metav1.Now()
+				rec.DeletionTimestamp = &now
+				rec.Finalizers = []string{profilerecordingapi.RecordingHasUnmergedProfiles}
+			},
+			existingSyscalls: []string{"open"},
+			wantSyscalls:     []string{"read", "write"},
+		},
+		{
+			name: "deletion appends to existing profile",
+			prepare: func(rec *profilerecordingapi.ProfileRecording) {
+				now := metav1.Now()
+				rec.DeletionTimestamp = &now
+				rec.Finalizers = []string{profilerecordingapi.RecordingHasUnmergedProfiles}
+				rec.Spec.AppendToExistingProfile = true
+			},
+			existingSyscalls: []string{"open"},
+			wantSyscalls:     []string{"open", "read", "write"},
+		},
+		{
+			name:         "not merged while recording",
+			prepare:      func(*profilerecordingapi.ProfileRecording) {},
+			wantSyscalls: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recording := &profilerecordingapi.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "recording",
+					Namespace: "namespace",
+				},
+				Spec: profilerecordingapi.ProfileRecordingSpec{
+					Kind:          profilerecordingapi.ProfileRecordingKindSeccompProfile,
+					Recorder:      profilerecordingapi.ProfileRecorderLogs,
+					MergeStrategy: profilerecordingapi.ProfileMergeContainers,
+				},
+			}
+			tc.prepare(recording)
+
+			objects := []client.Object{
+				recording,
+				testSeccompProfile("recording-container-abcde", true, "read"),
+				testSeccompProfile("recording-container-fghij", true, "write"),
+			}
+			if tc.existingSyscalls != nil {
+				objects = append(objects, testSeccompProfile("recording-container", false, tc.existingSyscalls...))
+			}
+
+			cli := fake.NewClientBuilder().
+				WithScheme(schemeInstance).
+				WithStatusSubresource(&profilerecordingapi.ProfileRecording{}).
+				WithObjects(objects...).
+				Build()
+
+			sut := &PolicyMergeReconciler{
+				client: cli,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			_, err := sut.Reconcile(t.Context(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(recording)})
+			require.NoError(t, err)
+
+			partials := &seccompprofile.SeccompProfileList{}
+			require.NoError(t, cli.List(t.Context(), partials, client.MatchingLabels{profilebase.ProfilePartialLabel: "true"}))
+
+			if tc.wantSyscalls == nil {
+				require.Len(t, partials.Items, 2)
+
+				return
+			}
+
+			require.Empty(t, partials.Items)
+
+			merged := &seccompprofile.SeccompProfile{}
+			require.NoError(t, cli.Get(t.Context(), types.NamespacedName{Name: "recording-container", Namespace: "namespace"}, merged))
+
+			syscalls := []string{}
+			for _, syscall := range merged.Spec.Syscalls {
+				syscalls = append(syscalls, syscall.Names...)
+			}
+
+			require.ElementsMatch(t, tc.wantSyscalls, syscalls)
+
+			history := []mergeHistoryEntry{}
+			require.NoError(t, json.Unmarshal(
+				[]byte(merged.Annotations[profilerecordingapi.ProfileMergeHistoryAnnotation]), &history,
+			))
+			require.Len(t, history, 1)
+			require.Equal(t, "namespace/recording", history[0].Recording)
+			require.Equal(t, 2, history[0].PartialProfiles)
+
+			updated := &profilerecordingapi.ProfileRecording{}
+			if err := cli.Get(t.Context(), client.ObjectKeyFromObject(recording), updated); err == nil {
+				condition := meta.FindStatusCondition(updated.Status.Conditions, profilerecordingapi.ProfileRecordingConditionMerged)
+				require.NotNil(t, condition)
+				require.Equal(t, metav1.ConditionTrue, condition.Status)
+				require.Equal(t, profilerecordingapi.ProfileRecordingReasonProfilesMerged, condition.Reason)
+				require.Contains(t, condition.Message, "recording-container")
+			}
+		})
+	}
+}
+
+func testWorkloadSeccompProfile(name, workload string, syscalls ...string) *seccompprofile.SeccompProfile {
+	profile := testSeccompProfile(name, true, syscalls...)
+	profile.Labels[profilerecordingapi.ProfileToWorkloadKindLabel] = "Deployment"
+	profile.Labels[profilerecordingapi.ProfileToWorkloadNameLabel] = workload
+
+	return profile
+}
+
+func TestReconcileMergeWorkloads(t *testing.T) {
+	t.Parallel()
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, profilerecordingapi.AddToScheme(schemeInstance))
+	require.NoError(t, seccompprofile.AddToScheme(schemeInstance))
+
+	for _, tc := range []struct {
+		name         string
+		naming       profilerecordingapi.ProfileNamingStrategy
+		workloads    []string
+		want         map[string][]string
+		wantWorkload bool
+	}{
+		{
+			name:      "profiles named after workloads",
+			naming:    profilerecordingapi.ProfileNamingWorkload,
+			workloads: []string{"web", "api"},
+			want: map[string][]string{
+				"recording-web-container": {"read"},
+				"recording-api-container": {"write"},
+			},
+			wantWorkload: true,
+		},
+		{
+			name:      "profiles named after recording with single workload",
+			naming:    profilerecordingapi.ProfileNamingRecording,
+			workloads: []string{"web", "web"},
+			want: map[string][]string{
+				"recording-container": {"read", "write"},
+			},
+			wantWorkload: true,
+		},
+		{
+			name:      "profiles named after recording with multiple workloads",
+			naming:    profilerecordingapi.ProfileNamingRecording,
+			workloads: []string{"web", "api"},
+			want: map[string][]string{
+				"recording-container": {"read", "write"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := metav1.Now()
+			recording := &profilerecordingapi.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "recording",
+					Namespace:         "namespace",
+					DeletionTimestamp: &now,
+					Finalizers:        []string{profilerecordingapi.RecordingHasUnmergedProfiles},
+				},
+				Spec: profilerecordingapi.ProfileRecordingSpec{
+					Kind:          profilerecordingapi.ProfileRecordingKindSeccompProfile,
+					Recorder:      profilerecordingapi.ProfileRecorderLogs,
+					MergeStrategy: profilerecordingapi.ProfileMergeContainers,
+					ProfileNaming: tc.naming,
+				},
+			}
+
+			cli := fake.NewClientBuilder().
+				WithScheme(schemeInstance).
+				WithStatusSubresource(&profilerecordingapi.ProfileRecording{}).
+				WithObjects(
+					recording,
+					testWorkloadSeccompProfile("recording-container-abcde", tc.workloads[0], "read"),
+					testWorkloadSeccompProfile("recording-container-fghij", tc.workloads[1], "write"),
+				).
+				Build()
+
+			sut := &PolicyMergeReconciler{
+				client: cli,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			_, err := sut.Reconcile(t.Context(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(recording)})
+			require.NoError(t, err)
+
+			profiles := &seccompprofile.SeccompProfileList{}
+			require.NoError(t, cli.List(t.Context(), profiles))
+			require.Len(t, profiles.Items, len(tc.want))
+
+			for i := range profiles.Items {
+				merged := &profiles.Items[i]
+				wantSyscalls, ok := tc.want[merged.Name]
+				require.True(t, ok, merged.Name)
+
+				syscalls := []string{}
+				for _, syscall := range merged.Spec.Syscalls {
+					syscalls = append(syscalls, syscall.Names...)
+				}
+
+				require.ElementsMatch(t, wantSyscalls, syscalls)
+
+				require.Empty(t, merged.OwnerReferences)
+
+				if !tc.wantWorkload {
+					require.NotContains(t, merged.Labels, profilerecordingapi.ProfileToWorkloadNameLabel)
+
+					continue
+				}
+
+				require.Equal(t, "Deployment", merged.Labels[profilerecordingapi.ProfileToWorkloadKindLabel])
+				require.Contains(t, tc.workloads, merged.Labels[profilerecordingapi.ProfileToWorkloadNameLabel])
+			}
+		})
+	}
+}
+
+func TestReconcileMergeBindAfterRecording(t *testing.T) {
+	t.Parallel()
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, profilerecordingapi.AddToScheme(schemeInstance))
+	require.NoError(t, profilebindingapi.AddToScheme(schemeInstance))
+	require.NoError(t, seccompprofile.AddToScheme(schemeInstance))
+
+	now := metav1.Now()
+	recording := &profilerecordingapi.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "recording",
+			Namespace:         "namespace",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{profilerecordingapi.RecordingHasUnmergedProfiles},
+		},
+		Spec: profilerecordingapi.ProfileRecordingSpec{
+			Kind:               profilerecordingapi.ProfileRecordingKindSeccompProfile,
+			Recorder:           profilerecordingapi.ProfileRecorderLogs,
+			MergeStrategy:      profilerecordingapi.ProfileMergeContainers,
+			BindAfterRecording: true,
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+	}
+
+	partials := []*seccompprofile.SeccompProfile{
+		testSeccompProfile("recording-container-abcde", true, "read"),
+		testSeccompProfile("recording-container-fghij", true, "write"),
+		testSeccompProfile("recording-container-klmno", true, "close"),
+	}
+	for i, image := range []string{"nginx:1", "nginx:2", "nginx:1"} {
+		partials[i].Annotations = map[string]string{
+			profilerecordingapi.ProfileContainerImagesAnnotation: image,
+		}
+	}
+
+	// A binding which got already promoted has to stay enforcing
+	promoted := &profilebindingapi.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bindingName("recording-container", "nginx:1"),
+			Namespace: "namespace",
+		},
+		Spec: profilebindingapi.ProfileBindingSpec{
+			EnforcementMode: profilebindingapi.EnforcementModeEnforce,
+		},
+	}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(schemeInstance).
+		WithStatusSubresource(&profilerecordingapi.ProfileRecording{}).
+		WithObjects(recording, partials[0], partials[1], partials[2], promoted).
+		Build()
+
+	sut := &PolicyMergeReconciler{
+		client: cli,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+
+	_, err := sut.Reconcile(t.Context(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(recording)})
+	require.NoError(t, err)
+
+	merged := &seccompprofile.SeccompProfile{}
+	require.NoError(t, cli.Get(t.Context(), types.NamespacedName{
+		Name: "recording-container", Namespace: "namespace",
+	}, merged))
+	require.Equal(t, "nginx:1,nginx:2", merged.Annotations[profilerecordingapi.ProfileContainerImagesAnnotation])
+
+	bindings := &profilebindingapi.ProfileBindingList{}
+	require.NoError(t, cli.List(t.Context(), bindings))
+	require.Len(t, bindings.Items, 2)
+
+	wantModes := map[string]profilebindingapi.EnforcementMode{
+		"nginx:1": profilebindingapi.EnforcementModeEnforce,
+		"nginx:2": profilebindingapi.EnforcementModeAudit,
+	}
+
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		require.Equal(t, bindingName("recording-container", binding.Spec.Image), binding.Name)
+		require.Equal(t, wantModes[binding.Spec.Image], binding.Spec.EnforcementMode)
+		require.Equal(t, profilebindingapi.ImageMatchExact, binding.Spec.ImageMatch)
+		require.Equal(t, profilebindingapi.ProfileRef{
+			Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+			Name: "recording-container",
+		}, binding.Spec.ProfileRef)
+		require.Equal(t, []string{"container"}, binding.Spec.ContainerNames)
+		require.Equal(t, "web", binding.Spec.PodSelector.MatchLabels["app"])
+		require.Equal(t, "recording", binding.Labels[profilerecordingapi.ProfileToRecordingLabel])
+	}
+}
+
+func TestAppendMergeHistory(t *testing.T) {
+	t.Parallel()
+
+	recording := &profilerecordingapi.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{Name: "recording", Namespace: "namespace"},
+	}
+	profile := &seccompprofile.SeccompProfile{}
+
+	for i := range mergeHistoryLimit + 2 {
+		require.NoError(t, appendMergeHistory(profile, recording, i))
+	}
+
+	history := []mergeHistoryEntry{}
+	require.NoError(t, json.Unmarshal(
+		[]byte(profile.Annotations[profilerecordingapi.ProfileMergeHistoryAnnotation]), &history,
+	))
+	require.Len(t, history, mergeHistoryLimit)
+	require.Equal(t, 2, history[0].PartialProfiles)
+	require.Equal(t, mergeHistoryLimit+1, history[mergeHistoryLimit-1].PartialProfiles)
+
+	profile.Annotations[profilerecordingapi.ProfileMergeHistoryAnnotation] = "invalid"
+	require.NoError(t, appendMergeHistory(profile, recording, 1))
+	require.NoError(t, json.Unmarshal(
+		[]byte(profile.Annotations[profilerecordingapi.ProfileMergeHistoryAnnotation]), &history,
+	))
+	require.Len(t, history, 1)
+}
+
+func TestPartialProfileRecording(t *testing.T) {
+	t.Parallel()
+
+	requests := partialProfileRecording(t.Context(), testSeccompProfile("profile", true))
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: "recording", Namespace: "namespace"},
+	}}, requests)
+
+	require.Empty(t, partialProfileRecording(t.Context(), &seccompprofile.SeccompProfile{}))
+	require.True(t, partialProfilePredicate.Create(event.CreateEvent{Object: testSeccompProfile("profile", true)}))
+	require.False(t, partialProfilePredicate.Create(event.CreateEvent{Object: testSeccompProfile("profile", false)}))
+}
@@ -19,9 +19,19 @@ package recordingmerger
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
 	profilerecordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
 
@@ -39,5 +49,46 @@ func (r *PolicyMergeReconciler) Setup(
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(r.Name()).
 		For(&profilerecordingapi.ProfileRecording{}).
+		Watches(
+			&seccompprofileapi.SeccompProfile{},
+			handler.EnqueueRequestsFromMapFunc(partialProfileRecording),
+			builder.WithPredicates(partialProfilePredicate),
+		).
+		Watches(
+			&selinuxprofileapi.SelinuxProfile{},
+			handler.EnqueueRequestsFromMapFunc(partialProfileRecording),
+			builder.WithPredicates(partialProfilePredicate),
+		).
+		Watches(
+			&apparmorprofileapi.AppArmorProfile{},
+			handler.EnqueueRequestsFromMapFunc(partialProfileRecording),
+			builder.WithPredicates(partialProfilePredicate),
+		).
 		Complete(r)
 }
+
+// partialProfilePredicate filters for partial profiles, which get merged
+// continuously.
+var partialProfilePredicate = predicate.NewPredicateFuncs(func(obj client.Object) bool {
+	return obj.GetLabels()[profilebase.ProfilePartialLabel] == "true"
+})
+
+// partialProfileRecording returns the recording which produced the partial
+// profile.
+func partialProfileRecording(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+
+	name := labels[profilerecordingapi.ProfileToRecordingLabel]
+	if name == "" {
+		return nil
+	}
+
+	namespace := labels[profilerecordingapi.ProfileToRecordingNamespaceLabel]
+	if namespace == "" {
+		namespace = obj.GetNamespace()
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: namespace},
+	}}
+}
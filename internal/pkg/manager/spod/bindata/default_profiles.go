@@ -39,3 +39,30 @@ func DefaultLogEnricherProfile() *seccompprofileapi.SeccompProfile {
 		},
 	}
 }
+
+// DefaultSeccompNotifyRecorderProfile returns the default seccomp profile for
+// recording syscalls via seccomp user notifications.
+func DefaultSeccompNotifyRecorderProfile() *seccompprofileapi.SeccompProfile {
+	namespace := config.GetOperatorNamespace()
+	labels := map[string]string{"app": config.OperatorName}
+
+	return &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.SeccompNotifyRecorderProfile,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			// The container runtimes do not support SCMP_ACT_NOTIFY as
+			// default action, which is why every syscall is listed.
+			DefaultAction: seccompprofileapi.ActAllow,
+			ListenerPath:  config.SeccompNotifyRecorderSocket,
+			Syscalls: []seccompprofileapi.Syscall{
+				{
+					Names:  seccompNotifySyscalls,
+					Action: seccompprofileapi.ActNotify,
+				},
+			},
+		},
+	}
+}
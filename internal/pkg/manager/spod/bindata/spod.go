@@ -61,12 +61,14 @@ const (
 	ContainerIDLogEnricher                           = 2
 	ContainerIDBpfRecorder                           = 3
 	ContainerIDJsonEnricher                          = 4
+	ContainerIDSeccompAgent                          = 5
 	DefaultHostProcPath                              = "/proc"
 	SelinuxContainerName                             = "selinuxd"
 	LogEnricherContainerName                         = "log-enricher"
 	DefaultLogEnricherSource                         = spodapi.LogEnricherSourceAuditd
 	JsonEnricherContainerName                        = "json-enricher"
 	BpfRecorderContainerName                         = "bpf-recorder"
+	SeccompAgentContainerName                        = "seccomp-agent"
 	NonRootEnablerContainerName                      = "non-root-enabler"
 	SelinuxPoliciesCopierContainerName               = "selinux-shared-policies-copier"
 	LocalSeccompProfilePath                          = "security-profiles-operator.json"
@@ -714,6 +716,48 @@ semodule -R
 							},
 						},
 					},
+					{
+						Name:            SeccompAgentContainerName,
+						Args:            []string{"seccomp-agent"},
+						ImagePullPolicy: corev1.PullAlways,
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      "grpc-server-volume",
+								MountPath: filepath.Dir(config.GRPCServerSocketMetrics),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							ReadOnlyRootFilesystem: &truly,
+							// Required to read the memory of the notified processes
+							Privileged: &truly,
+							RunAsUser:  &userRoot,
+							RunAsGroup: &userRoot,
+							SELinuxOptions: &corev1.SELinuxOptions{
+								Type: "spc_t",
+							},
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceMemory:           resource.MustParse("32Mi"),
+								corev1.ResourceCPU:              resource.MustParse("20m"),
+								corev1.ResourceEphemeralStorage: resource.MustParse("10Mi"),
+							},
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory:           resource.MustParse("128Mi"),
+								corev1.ResourceEphemeralStorage: resource.MustParse("64Mi"),
+							},
+						},
+						Env: []corev1.EnvVar{
+							{
+								Name: config.NodeNameEnvKey,
+								ValueFrom: &corev1.EnvVarSource{
+									FieldRef: &corev1.ObjectFieldSelector{
+										FieldPath: "spec.nodeName",
+									},
+								},
+							},
+						},
+					},
 				},
 				Volumes: []corev1.Volume{
 					// /var/lib is used as symlinks cannot be created across
@@ -982,6 +1026,29 @@ func CustomHostProcVolume(path string) (corev1.Volume, corev1.VolumeMount) {
 		}
 }
 
+// SeccompNotifyVolume returns the host path volume as well as the
+// corresponding mount of the seccomp notify sockets used by the log-enricher
+// and the seccomp agent.
+func SeccompNotifyVolume() (corev1.Volume, corev1.VolumeMount) {
+	const volumeName = "seccomp-notify-volume"
+
+	path := filepath.Dir(config.SeccompNotifyRecorderSocket)
+
+	return corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: path,
+					Type: &hostPathDirectoryOrCreate,
+				},
+			},
+		}, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: path,
+			ReadOnly:  false,
+		}
+}
+
 // CustomHostKubeletVolume returns a new host path volume for custom kubelet path
 // as well as corresponding mount used for non-root-enabler.
 func CustomHostKubeletVolume(path string) (corev1.Volume, corev1.VolumeMount) {
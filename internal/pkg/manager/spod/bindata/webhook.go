@@ -115,6 +115,10 @@ const (
 	webhookServerCert            = "webhook-server-cert"
 	rawSelinuxProfileValidation  = "rawselinuxprofile-validation.spo.io"
 	rawSelinuxProfileWebhookPath = "/validate-rawselinuxprofile"
+	appArmorProfileValidation    = "apparmorprofile-validation.spo.io"
+	appArmorProfileWebhookPath   = "/validate-apparmorprofile"
+	seccompProfileValidation     = "seccompprofile-validation.spo.io"
+	seccompProfileWebhookPath    = "/validate-seccompprofile"
 )
 
 type webhook struct {
@@ -197,7 +201,9 @@ func GetWebhook(
 
 	valCfg := getValidatingWebhookConfig().DeepCopy()
 	valCfg.Namespace = namespace
-	valCfg.Webhooks[0].ClientConfig.Service.Namespace = namespace
+	for i := range valCfg.Webhooks {
+		valCfg.Webhooks[i].ClientConfig.Service.Namespace = namespace
+	}
 
 	switch caInjectType {
 	case CAInjectTypeCertManager:
@@ -566,39 +572,46 @@ func getWebhookConfig(execMetadataWebhookEnabled bool) *admissionregv1.MutatingW
 }
 
 func getValidatingWebhookConfig() *admissionregv1.ValidatingWebhookConfiguration {
-	path := rawSelinuxProfileWebhookPath
-
 	return &admissionregv1.ValidatingWebhookConfiguration{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: validatingWebhookConfigName,
 		},
 		Webhooks: []admissionregv1.ValidatingWebhook{
+			validatingWebhook(
+				rawSelinuxProfileValidation, rawSelinuxProfileWebhookPath, "rawselinuxprofiles", "v1", "v1alpha2",
+			),
+			// Requests for other versions are converted to v1 by the API server.
+			validatingWebhook(appArmorProfileValidation, appArmorProfileWebhookPath, "apparmorprofiles", "v1"),
+			validatingWebhook(seccompProfileValidation, seccompProfileWebhookPath, "seccompprofiles", "v1"),
+		},
+	}
+}
+
+func validatingWebhook(name, path, resource string, versions ...string) admissionregv1.ValidatingWebhook {
+	return admissionregv1.ValidatingWebhook{
+		Name:          name,
+		FailurePolicy: &failurePolicyFail,
+		SideEffects:   &sideEffects,
+		Rules: []admissionregv1.RuleWithOperations{
 			{
-				Name:          rawSelinuxProfileValidation,
-				FailurePolicy: &failurePolicyFail,
-				SideEffects:   &sideEffects,
-				Rules: []admissionregv1.RuleWithOperations{
-					{
-						Operations: []admissionregv1.OperationType{
-							"CREATE", "UPDATE",
-						},
-						Rule: admissionregv1.Rule{
-							APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
-							APIVersions: []string{"v1", "v1alpha2"},
-							Resources:   []string{"rawselinuxprofiles"},
-						},
-					},
+				Operations: []admissionregv1.OperationType{
+					"CREATE", "UPDATE",
 				},
-				ClientConfig: admissionregv1.WebhookClientConfig{
-					CABundle: caBundle,
-					Service: &admissionregv1.ServiceReference{
-						Name: serviceName,
-						Path: &path,
-					},
+				Rule: admissionregv1.Rule{
+					APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
+					APIVersions: versions,
+					Resources:   []string{resource},
 				},
-				AdmissionReviewVersions: admissionReviewVersions,
 			},
 		},
+		ClientConfig: admissionregv1.WebhookClientConfig{
+			CABundle: caBundle,
+			Service: &admissionregv1.ServiceReference{
+				Name: serviceName,
+				Path: &path,
+			},
+		},
+		AdmissionReviewVersions: admissionReviewVersions,
 	}
 }
 
@@ -312,6 +312,10 @@ func (r *ReconcileSPOd) defaultProfiles(
 		defaultProfiles = append(defaultProfiles, bindata.DefaultLogEnricherProfile())
 	}
 
+	if ptr.Deref(cfg.Spec.Enricher.EnableSeccompNotifyRecorder, false) {
+		defaultProfiles = append(defaultProfiles, bindata.DefaultSeccompNotifyRecorderProfile())
+	}
+
 	return defaultProfiles
 }
 
@@ -613,11 +617,26 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		// The container runtime connects to the seccomp notify socket on the host
+		if ptr.Deref(cfg.Spec.Enricher.EnableSeccompNotifyRecorder, false) {
+			notifyVolume, notifyMount := bindata.SeccompNotifyVolume()
+			appendVolume(templateSpec, notifyVolume)
+			ctr.VolumeMounts = append(ctr.VolumeMounts, notifyMount)
+			ctr.Args = append(ctr.Args, "--seccomp-notify-recorder=true")
+		}
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the log enricher env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableLogEnricherEnvKey)
 
 		r.getConfiguredLogEnricher(cfg)
+
+		// Drift detection relies on the audit lines collected by the log enricher
+		if ptr.Deref(cfg.Spec.Enricher.EnableDriftDetection, false) {
+			templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
+				templateSpec.Containers[bindata.ContainerIDDaemon].Args,
+				"--with-drift-detection=true")
+		}
 	}
 
 	// Bpf recorder parameters
@@ -698,6 +717,27 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		r.getConfiguredJsonEnricher(cfg)
 	}
 
+	// Seccomp agent parameters
+	if ptr.Deref(cfg.Spec.EnableSeccompAgent, false) {
+		ctr := r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSeccompAgent]
+		ctr.Image = image
+
+		// The container runtime connects to the seccomp agent socket on the host
+		notifyVolume, notifyMount := bindata.SeccompNotifyVolume()
+		appendVolume(templateSpec, notifyVolume)
+		ctr.VolumeMounts = append(ctr.VolumeMounts, notifyMount)
+
+		if useCustomHostProc {
+			appendVolume(templateSpec, volume)
+			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
+		}
+
+		templateSpec.Containers = append(templateSpec.Containers, ctr)
+
+		// HostPID is required to read the memory of the notified processes
+		templateSpec.HostPID = true
+	}
+
 	// AppArmor parameters
 	if ptr.Deref(cfg.Spec.EnableAppArmor, false) {
 		falsely, truly := false, true
@@ -959,6 +999,18 @@ func isBpfRecorderEnabled(cfg *spodapi.SecurityProfilesOperatorDaemon) bool {
 	return ptr.Deref(cfg.Spec.Enricher.EnableBpfRecorder, false) || enableBpfRecorderEnv
 }
 
+// appendVolume adds the volume to the pod spec if no volume with the same
+// name exists yet.
+func appendVolume(templateSpec *corev1.PodSpec, volume corev1.Volume) {
+	for i := range templateSpec.Volumes {
+		if templateSpec.Volumes[i].Name == volume.Name {
+			return
+		}
+	}
+
+	templateSpec.Volumes = append(templateSpec.Volumes, volume)
+}
+
 func addEnvVar(templateSpec *corev1.PodSpec, envVarKey string) {
 	envValue, err := strconv.ParseBool(os.Getenv(envVarKey))
 	if err != nil {
@@ -146,3 +146,17 @@ func Test_getConfiguredJsonEnricherNilInterval(t *testing.T) {
 func containsString(slice []string, element string) bool {
 	return slices.Contains(slice, element)
 }
+
+func Test_appendVolume(t *testing.T) {
+	t.Parallel()
+
+	templateSpec := &v1.PodSpec{}
+
+	appendVolume(templateSpec, v1.Volume{Name: "mercury"})
+	appendVolume(templateSpec, v1.Volume{Name: "venus"})
+
+	// Add once again to ensure it's not duplicated
+	appendVolume(templateSpec, v1.Volume{Name: "mercury"})
+
+	require.Len(t, templateSpec.Volumes, 2)
+}
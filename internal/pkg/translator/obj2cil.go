@@ -168,6 +168,23 @@ func Object2CIL(
 	return cilbuilder.String(), nil
 }
 
+// SystemInherits returns the names of the system policies the profile
+// inherits from. Inherited SelinuxProfile objects are not supported, because
+// they can only be resolved within a cluster.
+func SystemInherits(sp *selinuxprofileapi.SelinuxProfile) ([]string, error) {
+	systemInherits := []string{}
+
+	for _, inherit := range sp.Spec.Inherit {
+		if inherit.Kind != "" && inherit.Kind != selinuxprofileapi.SystemPolicyKind {
+			return nil, fmt.Errorf("unsupported inherit kind %s for %s", inherit.Kind, inherit.Name)
+		}
+
+		systemInherits = append(systemInherits, inherit.Name)
+	}
+
+	return systemInherits, nil
+}
+
 func validateSemanticRule(opts *deniedOptions, ttype selinuxprofileapi.LabelKey,
 	class selinuxprofileapi.ObjectClassKey,
 	perms selinuxprofileapi.PermissionSet,
@@ -18,6 +18,7 @@ package translator
 
 import (
 	"regexp"
+	"slices"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -559,3 +560,53 @@ func TestObject2CIL(t *testing.T) {
 		})
 	}
 }
+
+func TestSystemInherits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inherit []selinuxprofileapi.PolicyRef
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "system policies with and without kind",
+			inherit: []selinuxprofileapi.PolicyRef{
+				{Name: "container"},
+				{Kind: selinuxprofileapi.SystemPolicyKind, Name: "net_container"},
+			},
+			want: []string{"container", "net_container"},
+		},
+		{
+			name:    "no inheritance",
+			inherit: nil,
+			want:    []string{},
+		},
+		{
+			name: "selinux profile object",
+			inherit: []selinuxprofileapi.PolicyRef{
+				{Kind: "SelinuxProfile", Name: "foo"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := SystemInherits(&selinuxprofileapi.SelinuxProfile{
+				Spec: selinuxprofileapi.SelinuxProfileSpec{Inherit: tt.inherit},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SystemInherits() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("SystemInherits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"log"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+)
+
+// UnionAppArmorAbstract merges the rules of additions into base. The base
+// abstract may use glob patterns for paths, whereas additions are expected to
+// contain raw paths only. Paths of additions which are already matched by a
+// pattern of base are not added again.
+func UnionAppArmorAbstract(base, additions *apparmorprofileapi.AppArmorAbstract) {
+	if base.Executable != nil && additions.Executable != nil {
+		base.Executable.AllowedExecutables = mergePaths(
+			base.Executable.AllowedExecutables,
+			additions.Executable.AllowedExecutables,
+		)
+		base.Executable.AllowedLibraries = mergePaths(
+			base.Executable.AllowedLibraries,
+			additions.Executable.AllowedLibraries,
+		)
+		base.Executable.Transitions = mergeRules(
+			base.Executable.Transitions,
+			additions.Executable.Transitions,
+		)
+	} else if additions.Executable != nil {
+		base.Executable = additions.Executable
+	}
+
+	mergeFilesystem(base, additions)
+
+	if base.Network != nil && additions.Network != nil {
+		base.Network.AllowRaw = mergeBools(base.Network.AllowRaw, additions.Network.AllowRaw)
+
+		if base.Network.Protocols != nil && additions.Network.Protocols != nil {
+			base.Network.Protocols.AllowTCP = mergeBools(base.Network.Protocols.AllowTCP, additions.Network.Protocols.AllowTCP)
+			base.Network.Protocols.AllowUDP = mergeBools(base.Network.Protocols.AllowUDP, additions.Network.Protocols.AllowUDP)
+		} else if additions.Network.Protocols != nil {
+			base.Network.Protocols = additions.Network.Protocols
+		}
+
+		base.Network.Rules = mergeRules(base.Network.Rules, additions.Network.Rules)
+	} else if additions.Network != nil {
+		base.Network = additions.Network
+	}
+
+	if base.Capability != nil && additions.Capability != nil {
+		base.Capability.AllowedCapabilities = *mergeDedupSortStrings(
+			&base.Capability.AllowedCapabilities,
+			&additions.Capability.AllowedCapabilities,
+		)
+	} else if additions.Capability != nil {
+		base.Capability = additions.Capability
+	}
+
+	if base.Signal != nil && additions.Signal != nil {
+		base.Signal.Rules = mergeRules(base.Signal.Rules, additions.Signal.Rules)
+	} else if additions.Signal != nil {
+		base.Signal = additions.Signal
+	}
+
+	if base.Ptrace != nil && additions.Ptrace != nil {
+		base.Ptrace.Rules = mergeRules(base.Ptrace.Rules, additions.Ptrace.Rules)
+	} else if additions.Ptrace != nil {
+		base.Ptrace = additions.Ptrace
+	}
+
+	if base.Dbus != nil && additions.Dbus != nil {
+		base.Dbus.Rules = mergeRules(base.Dbus.Rules, additions.Dbus.Rules)
+	} else if additions.Dbus != nil {
+		base.Dbus = additions.Dbus
+	}
+
+	if base.Mount != nil && additions.Mount != nil {
+		base.Mount.Rules = mergeRules(base.Mount.Rules, additions.Mount.Rules)
+	} else if additions.Mount != nil {
+		base.Mount = additions.Mount
+	}
+}
+
+// mergeRules appends the rules of b which are not part of a yet.
+func mergeRules[T any](a, b []T) []T {
+	for i := range b {
+		if !slices.ContainsFunc(a, func(rule T) bool {
+			return reflect.DeepEqual(rule, b[i])
+		}) {
+			a = append(a, b[i])
+		}
+	}
+
+	return a
+}
+
+func mergePaths(a, b []string) []string {
+	if len(a) == 0 {
+		return b
+	}
+
+	if len(b) == 0 {
+		return a
+	}
+
+	merged := newAppArmorPathSet(a)
+	for _, path := range b {
+		if !merged.Matches(path) {
+			merged.Add(path)
+		}
+	}
+
+	return merged.Patterns()
+}
+
+func mergeFilesystem(base, additions *apparmorprofileapi.AppArmorAbstract) {
+	if base.Filesystem != nil && additions.Filesystem != nil {
+		r := newAppArmorPathSet(base.Filesystem.ReadOnlyPaths)
+		w := newAppArmorPathSet(base.Filesystem.WriteOnlyPaths)
+		rw := newAppArmorPathSet(base.Filesystem.ReadWritePaths)
+
+		for _, p := range additions.Filesystem.ReadWritePaths {
+			if rw.Matches(p) {
+				// no changes necessary
+			} else if pat := r.PopMatching(p); pat != nil {
+				rw.Add(*pat)
+			} else if pat := w.PopMatching(p); pat != nil {
+				rw.Add(*pat)
+			} else {
+				rw.Add(p)
+			}
+		}
+
+		for _, p := range additions.Filesystem.ReadOnlyPaths {
+			if rw.Matches(p) {
+				// no changes necessary
+			} else if r.Matches(p) {
+				// no changes necessary
+			} else if pat := w.PopMatching(p); pat != nil {
+				rw.Add(*pat)
+			} else {
+				r.Add(p)
+			}
+		}
+
+		for _, p := range additions.Filesystem.WriteOnlyPaths {
+			if rw.Matches(p) {
+				// no changes necessary
+			} else if pat := r.PopMatching(p); pat != nil {
+				rw.Add(*pat)
+			} else if w.Matches(p) {
+				// no changes necessary
+			} else {
+				w.Add(p)
+			}
+		}
+
+		base.Filesystem = &apparmorprofileapi.AppArmorFsRules{
+			ReadOnlyPaths:  r.Patterns(),
+			WriteOnlyPaths: w.Patterns(),
+			ReadWritePaths: rw.Patterns(),
+			Rules:          mergeRules(base.Filesystem.Rules, additions.Filesystem.Rules),
+		}
+	} else if additions.Filesystem != nil {
+		base.Filesystem = additions.Filesystem
+	}
+}
+
+func newAppArmorPathSet(patterns []string) appArmorPathSet {
+	m := appArmorPathSet{}
+
+	for _, p := range patterns {
+		m.Add(p)
+	}
+
+	return m
+}
+
+type appArmorPathSet struct {
+	paths []apparmorPath
+}
+
+type apparmorPath struct {
+	pattern string
+	expr    *regexp.Regexp
+}
+
+func (m *appArmorPathSet) findMatch(path string) int {
+	for i, p := range m.paths {
+		if p.pattern == path {
+			return i
+		}
+
+		if p.expr != nil && p.expr.MatchString(path) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (m *appArmorPathSet) Matches(path string) bool {
+	return m.findMatch(path) >= 0
+}
+
+func (m *appArmorPathSet) PopMatching(path string) *string {
+	i := m.findMatch(path)
+	if i >= 0 {
+		ret := m.paths[i].pattern
+		m.paths[i] = m.paths[len(m.paths)-1]
+		m.paths = m.paths[:len(m.paths)-1]
+
+		return &ret
+	}
+
+	return nil
+}
+
+func (m *appArmorPathSet) Add(pattern string) {
+	rex, err := appArmorGlobToRegex(pattern)
+	if err != nil {
+		log.Printf("Failed to parse AppArmor glob pattern '%s': %x\n", pattern, err)
+	}
+
+	m.paths = append(m.paths, apparmorPath{
+		pattern: pattern,
+		expr:    rex,
+	})
+}
+
+func (m *appArmorPathSet) Patterns() []string {
+	if len(m.paths) == 0 {
+		return nil
+	}
+
+	ret := make([]string, 0, len(m.paths))
+	for _, p := range m.paths {
+		ret = append(ret, p.pattern)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Convert AppArmor globs (https://gitlab.com/apparmor/apparmor/-/wikis/QuickProfileLanguage#file-globbing)
+// to regular expressions for evaluation. This method may be inaccurate and should not be
+// used for security-sensitive use-cases, but it is good enough for common patterns.
+func appArmorGlobToRegex(pattern string) (*regexp.Regexp, error) {
+	expr := "^" + regexp.MustCompile(`\*\*?|\?|\{.+?\}|\.`).ReplaceAllStringFunc(
+		pattern, func(match string) string {
+			switch match {
+			case "**":
+				return `[^\000]*`
+			case "*":
+				return `[^/\000]*`
+			case "?":
+				return `[^/]`
+			case ".":
+				return `\.`
+			default:
+				inner := regexp.QuoteMeta(match[1 : len(match)-1])
+				inner = strings.ReplaceAll(inner, ",", "|")
+
+				return "(" + inner + ")"
+			}
+		},
+	) + "$"
+
+	return regexp.Compile(expr)
+}
+
+func mergeBools(a, b *bool) *bool {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	merged := (*a || *b)
+
+	return &merged
+}
+
+func mergeDedupSortStrings(a, b *[]string) *[]string {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	merged := append(*a, *b...)
+	sort.Strings(merged)
+	merged = slices.Compact(merged)
+
+	return &merged
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package recordingmerger
+package util
 
 import (
 	"testing"
@@ -165,6 +165,18 @@ func TestMergeFilesystem(t *testing.T) {
 				ReadWritePaths: []string{"/rw/*", "/rw2/baz"},
 			},
 		},
+		{
+			name: "rules",
+			additions: apparmorprofileapi.AppArmorFsRules{
+				Rules: []apparmorprofileapi.AppArmorFileRule{{Path: "/etc/shadow", Mode: "r", Deny: true}},
+			},
+			merged: apparmorprofileapi.AppArmorFsRules{
+				ReadOnlyPaths:  []string{"/r/*"},
+				WriteOnlyPaths: []string{"/w/*"},
+				ReadWritePaths: []string{"/rw/*"},
+				Rules:          []apparmorprofileapi.AppArmorFileRule{{Path: "/etc/shadow", Mode: "r", Deny: true}},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -194,3 +206,42 @@ func TestMergeBools(t *testing.T) {
 	require.False(t, *mergeBools(&False, nil))
 	require.Nil(t, mergeBools(nil, nil))
 }
+
+func TestMergeRules(t *testing.T) {
+	t.Parallel()
+
+	base := &apparmorprofileapi.AppArmorAbstract{
+		Network: &apparmorprofileapi.AppArmorNetworkRules{
+			Rules: []apparmorprofileapi.AppArmorNetworkRule{{Family: "unix", Type: "stream"}},
+		},
+		Signal: &apparmorprofileapi.AppArmorSignalRules{
+			Rules: []apparmorprofileapi.AppArmorSignalRule{{Access: []string{"send"}, Peer: "foo"}},
+		},
+	}
+
+	UnionAppArmorAbstract(base, &apparmorprofileapi.AppArmorAbstract{
+		Network: &apparmorprofileapi.AppArmorNetworkRules{
+			Rules: []apparmorprofileapi.AppArmorNetworkRule{
+				{Family: "unix", Type: "stream"},
+				{Family: "netlink", Type: "raw"},
+			},
+		},
+		Signal: &apparmorprofileapi.AppArmorSignalRules{
+			Rules: []apparmorprofileapi.AppArmorSignalRule{{Access: []string{"send"}, Peer: "foo"}},
+		},
+		Ptrace: &apparmorprofileapi.AppArmorPtraceRules{
+			Rules: []apparmorprofileapi.AppArmorPtraceRule{{Access: []string{"read"}}},
+		},
+	})
+
+	require.Equal(t, []apparmorprofileapi.AppArmorNetworkRule{
+		{Family: "unix", Type: "stream"},
+		{Family: "netlink", Type: "raw"},
+	}, base.Network.Rules)
+	require.Equal(t, []apparmorprofileapi.AppArmorSignalRule{
+		{Access: []string{"send"}, Peer: "foo"},
+	}, base.Signal.Rules)
+	require.Equal(t, []apparmorprofileapi.AppArmorPtraceRule{
+		{Access: []string{"read"}},
+	}, base.Ptrace.Rules)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+)
+
+// selectAllContainers is the audit annotation key for pod level bindings.
+const selectAllContainers = "*"
+
+// auditProfiles maps the container name to the profiles by kind which would
+// have been applied by bindings in Audit mode.
+type auditProfiles map[string]map[profilebindingapi.ProfileBindingKind]string
+
+// isAudit returns true if the binding only reports the profile instead of
+// enforcing it.
+func (b *binding) isAudit() bool {
+	return b.spec.EnforcementMode == profilebindingapi.EnforcementModeAudit
+}
+
+func (a auditProfiles) add(container string, kind profilebindingapi.ProfileBindingKind, bindProfile any) {
+	if a[container] == nil {
+		a[container] = map[profilebindingapi.ProfileBindingKind]string{}
+	}
+
+	a[container][kind] = profileReference(bindProfile)
+}
+
+// annotate sets the audit profiles annotation on the pod.
+func (a auditProfiles) annotate(pod *corev1.Pod) error {
+	value, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("marshal audit profiles: %w", err)
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+
+	pod.Annotations[profilebindingapi.AuditProfilesAnnotation] = string(value)
+
+	return nil
+}
+
+// profileReference returns the reference of the profile as it would have been
+// set in the security context.
+func profileReference(bindProfile any) string {
+	switch v := bindProfile.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return v.Status.LocalhostProfile
+	case *selinuxprofileapi.SelinuxProfile:
+		return v.Status.Usage
+	case *apparmorprofileapi.AppArmorProfile:
+		return v.GetProfileName()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
+	secprofnodestatusapi "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
+)
+
+func TestUpdatePodAudit(t *testing.T) {
+	t.Parallel()
+
+	installed := profilebaseapi.StatusBase{Status: secprofnodestatusapi.ProfileStateInstalled}
+	auditBinding := func(
+		name string, kind profilebindingapi.ProfileBindingKind, mode profilebindingapi.EnforcementMode,
+	) profilebindingapi.ProfileBinding {
+		return profilebindingapi.ProfileBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: profilebindingapi.ProfileBindingSpec{
+				ProfileRef:      profilebindingapi.ProfileRef{Kind: kind, Name: name},
+				Image:           "nginx",
+				ContainerNames:  []string{"nginx"},
+				EnforcementMode: mode,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name            string
+		profileBindings []profilebindingapi.ProfileBinding
+		podContext      *corev1.PodSecurityContext
+		container       corev1.Container
+		wantContext     *corev1.SecurityContext
+		wantAnnotation  string
+		wantEvents      int
+	}{
+		{
+			name: "audit seccomp and enforce apparmor",
+			profileBindings: []profilebindingapi.ProfileBinding{
+				auditBinding("seccomp", profilebindingapi.ProfileBindingKindSeccompProfile,
+					profilebindingapi.EnforcementModeAudit),
+				auditBinding("apparmor", profilebindingapi.ProfileBindingKindAppArmorProfile,
+					profilebindingapi.EnforcementModeEnforce),
+			},
+			container: corev1.Container{Name: "nginx", Image: "nginx"},
+			wantContext: &corev1.SecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{
+					Type:             corev1.SeccompProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("operator/seccomp_audit.json"),
+				},
+				AppArmorProfile: &corev1.AppArmorProfile{
+					Type:             corev1.AppArmorProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("apparmor"),
+				},
+			},
+			wantAnnotation: `{"nginx":{"SeccompProfile":"operator/seccomp.json"}}`,
+			wantEvents:     1,
+		},
+		{
+			name: "audit apparmor and selinux",
+			profileBindings: []profilebindingapi.ProfileBinding{
+				auditBinding("apparmor", profilebindingapi.ProfileBindingKindAppArmorProfile,
+					profilebindingapi.EnforcementModeAudit),
+				auditBinding("selinux", profilebindingapi.ProfileBindingKindSelinuxProfile,
+					profilebindingapi.EnforcementModeAudit),
+			},
+			container: corev1.Container{Name: "nginx", Image: "nginx"},
+			wantContext: &corev1.SecurityContext{
+				AppArmorProfile: &corev1.AppArmorProfile{
+					Type:             corev1.AppArmorProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("apparmor_audit"),
+				},
+			},
+			wantAnnotation: `{"nginx":{"AppArmorProfile":"apparmor","SelinuxProfile":"selinux.process"}}`,
+			wantEvents:     2,
+		},
+		{
+			name: "audit does not weaken existing profile",
+			profileBindings: []profilebindingapi.ProfileBinding{
+				auditBinding("seccomp", profilebindingapi.ProfileBindingKindSeccompProfile,
+					profilebindingapi.EnforcementModeAudit),
+			},
+			container: corev1.Container{
+				Name:  "nginx",
+				Image: "nginx",
+				SecurityContext: &corev1.SecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			},
+			wantContext: &corev1.SecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+			wantAnnotation: `{"nginx":{"SeccompProfile":"operator/seccomp.json"}}`,
+			wantEvents:     1,
+		},
+		{
+			name: "audit does not weaken pod level profiles",
+			profileBindings: []profilebindingapi.ProfileBinding{
+				auditBinding("seccomp", profilebindingapi.ProfileBindingKindSeccompProfile,
+					profilebindingapi.EnforcementModeAudit),
+				auditBinding("apparmor", profilebindingapi.ProfileBindingKindAppArmorProfile,
+					profilebindingapi.EnforcementModeAudit),
+			},
+			podContext: &corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{
+					Type:             corev1.SeccompProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("operator/enforced.json"),
+				},
+				AppArmorProfile: &corev1.AppArmorProfile{
+					Type:             corev1.AppArmorProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("enforced"),
+				},
+			},
+			container:      corev1.Container{Name: "nginx", Image: "nginx"},
+			wantAnnotation: `{"nginx":{"AppArmorProfile":"apparmor","SeccompProfile":"operator/seccomp.json"}}`,
+			wantEvents:     2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			mock.DecodePodReturns(&corev1.Pod{
+				Spec: corev1.PodSpec{
+					SecurityContext: tc.podContext.DeepCopy(),
+					Containers:      []corev1.Container{tc.container},
+				},
+			}, nil)
+			mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+				Status: seccompprofileapi.SeccompProfileStatus{
+					StatusBase:       installed,
+					LocalhostProfile: "operator/seccomp.json",
+				},
+			}, nil)
+			mock.GetAppArmorProfileReturns(&apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "apparmor"},
+				Status:     apparmorprofileapi.AppArmorProfileStatus{StatusBase: installed},
+			}, nil)
+			mock.GetSelinuxProfileReturns(&selinuxprofileapi.SelinuxProfile{
+				Status: selinuxprofileapi.SelinuxProfileStatus{StatusBase: installed, Usage: "selinux.process"},
+			}, nil)
+
+			bindings := make([]binding, 0, len(tc.profileBindings))
+			for i := range tc.profileBindings {
+				bindings = append(bindings, newBinding(&tc.profileBindings[i]))
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			binder := podBinder{impl: mock, log: logr.Discard(), record: utils.NewSafeRecorder(recorder)}
+
+			pod, resp := binder.updatePod(t.Context(), bindings, &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Namespace: "ns",
+					Name:      "pod",
+				},
+			})
+			require.Equal(t, admission.Response{}, resp)
+			require.Equal(t, tc.wantContext, pod.Spec.Containers[0].SecurityContext)
+			require.Equal(t, tc.podContext, pod.Spec.SecurityContext)
+			require.JSONEq(t, tc.wantAnnotation, pod.Annotations[profilebindingapi.AuditProfilesAnnotation])
+			require.Len(t, recorder.Events, tc.wantEvents)
+
+			for range tc.wantEvents {
+				require.Contains(t, <-recorder.Events, "AuditedPod Pod ns/pod would have been bound to")
+			}
+		})
+	}
+}
+
+func TestUpdatePodAuditPodLevel(t *testing.T) {
+	t.Parallel()
+
+	mock := &bindingfakes.FakeImpl{}
+	mock.DecodePodReturns(testPod.DeepCopy(), nil)
+	mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+		Status: seccompprofileapi.SeccompProfileStatus{
+			StatusBase: profilebaseapi.StatusBase{
+				Status: secprofnodestatusapi.ProfileStateInstalled,
+			},
+			LocalhostProfile: "operator/profile.json",
+		},
+	}, nil)
+
+	pb := &profilebindingapi.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+		Spec: profilebindingapi.ProfileBindingSpec{
+			ProfileRef: profilebindingapi.ProfileRef{
+				Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+				Name: "profile",
+			},
+			Image:           profilebindingapi.SelectAllContainersImage,
+			EnforcementMode: profilebindingapi.EnforcementModeAudit,
+		},
+	}
+
+	binder := podBinder{impl: mock, log: logr.Discard(), record: utils.NewSafeRecorder(nil)}
+	pod, resp := binder.updatePod(t.Context(), []binding{newBinding(pb)}, &admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create},
+	})
+	require.Equal(t, admission.Response{}, resp)
+	require.Equal(t, ptr.To("operator/profile_audit.json"), pod.Spec.SecurityContext.SeccompProfile.LocalhostProfile)
+
+	audits := auditProfiles{}
+	require.NoError(t, json.Unmarshal([]byte(pod.Annotations[profilebindingapi.AuditProfilesAnnotation]), &audits))
+	require.Equal(t, auditProfiles{
+		selectAllContainers: {profilebindingapi.ProfileBindingKindSeccompProfile: "operator/profile.json"},
+	}, audits)
+	require.Equal(t, 1, mock.UpdateResourceStatusCallCount())
+}
+
+func TestUpdatePodAuditPodLevelProfile(t *testing.T) {
+	t.Parallel()
+
+	enforced := &corev1.SeccompProfile{
+		Type:             corev1.SeccompProfileTypeLocalhost,
+		LocalhostProfile: ptr.To("operator/enforced.json"),
+	}
+	testPodWithProfile := testPod.DeepCopy()
+	testPodWithProfile.Spec.SecurityContext = &corev1.PodSecurityContext{SeccompProfile: enforced}
+
+	mock := &bindingfakes.FakeImpl{}
+	mock.DecodePodReturns(testPodWithProfile, nil)
+	mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+		Status: seccompprofileapi.SeccompProfileStatus{
+			StatusBase: profilebaseapi.StatusBase{
+				Status: secprofnodestatusapi.ProfileStateInstalled,
+			},
+			LocalhostProfile: "operator/profile.json",
+		},
+	}, nil)
+
+	pb := &profilebindingapi.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+		Spec: profilebindingapi.ProfileBindingSpec{
+			ProfileRef: profilebindingapi.ProfileRef{
+				Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+				Name: "profile",
+			},
+			Image:           profilebindingapi.SelectAllContainersImage,
+			EnforcementMode: profilebindingapi.EnforcementModeAudit,
+		},
+	}
+
+	binder := podBinder{impl: mock, log: logr.Discard(), record: utils.NewSafeRecorder(nil)}
+	pod, resp := binder.updatePod(t.Context(), []binding{newBinding(pb)}, &admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create},
+	})
+	require.Equal(t, admission.Response{}, resp)
+	require.Equal(t, enforced, pod.Spec.SecurityContext.SeccompProfile)
+	require.Contains(t, pod.Annotations, profilebindingapi.AuditProfilesAnnotation)
+}
@@ -22,14 +22,18 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -45,16 +49,27 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
 
-const finalizer = "active-workload-lock"
+const (
+	finalizer = "active-workload-lock"
 
-var ErrProfWithoutStatus = errors.New("profile hasn't been initialized with status")
+	reasonAuditedPod = "AuditedPod"
+)
+
+var (
+	ErrProfWithoutStatus = errors.New("profile hasn't been initialized with status")
+
+	// errProfileUnavailable indicates that a binding should be skipped
+	// because its profile does not exist or is not supported.
+	errProfileUnavailable = errors.New("profile unavailable")
+)
 
 type podBinder struct {
 	impl
-	log logr.Logger
+	log    logr.Logger
+	record *utils.SafeRecorder
 }
 
-func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client) {
+func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, rec record.EventRecorder, c client.Client) {
 	server.Register(
 		"/mutate-v1-pod-binding",
 		&webhook.Admission{
@@ -63,7 +78,8 @@ func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Cli
 					client:  c,
 					decoder: admission.NewDecoder(scheme),
 				},
-				log: logf.Log.WithName("binding"),
+				log:    logf.Log.WithName("binding"),
+				record: utils.NewSafeRecorder(rec),
 			},
 		},
 	)
@@ -71,6 +87,37 @@ func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Cli
 
 type containerList []*corev1.Container
 
+// binding is either a ProfileBinding or a ClusterProfileBinding which applies
+// to the namespace of the pod.
+type binding struct {
+	client.Object
+	name    string
+	cluster bool
+	spec    *profilebindingapi.ProfileBindingSpec
+	status  *profilebindingapi.ProfileBindingStatus
+}
+
+func newBinding(pb *profilebindingapi.ProfileBinding) binding {
+	return binding{Object: pb, name: "profilebinding", spec: &pb.Spec, status: &pb.Status}
+}
+
+func newClusterBinding(cpb *profilebindingapi.ClusterProfileBinding) binding {
+	return binding{
+		Object:  cpb,
+		name:    "clusterprofilebinding",
+		cluster: true,
+		spec:    &cpb.Spec.ProfileBindingSpec,
+		status:  &cpb.Status,
+	}
+}
+
+// profileKinds are the supported profile kinds in the order they get bound.
+var profileKinds = []profilebindingapi.ProfileBindingKind{
+	profilebindingapi.ProfileBindingKindSeccompProfile,
+	profilebindingapi.ProfileBindingKindSelinuxProfile,
+	profilebindingapi.ProfileBindingKindAppArmorProfile,
+}
+
 func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
 	if spec.Containers != nil {
 		for i := range spec.Containers {
@@ -102,12 +149,16 @@ func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resources=leases,verbs=create
 // +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resourceNames=security-profiles-operator-webhook-lock,resources=leases,verbs=get;patch;update
 
@@ -124,9 +175,21 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
-	profilebindings := profileBindings.Items
+	clusterProfileBindings, err := p.ListClusterProfileBindings(ctx)
+	if err != nil {
+		p.log.Error(err, "could not list cluster profile bindings")
+
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	bindings, err := p.resolveBindings(ctx, &req, profileBindings.Items, clusterProfileBindings.Items)
+	if err != nil {
+		p.log.Error(err, "could not resolve cluster profile bindings")
+
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
 
-	pod, admissionResponse := p.updatePod(ctx, profilebindings, &req)
+	pod, admissionResponse := p.updatePod(ctx, bindings, &req)
 	if !cmp.Equal(admissionResponse, admission.Response{}) {
 		return admissionResponse
 	}
@@ -141,130 +204,317 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
 }
 
-func (p *podBinder) updatePod(
+// resolveBindings returns the cluster profile bindings selecting the namespace
+// of the pod followed by the profile bindings of that namespace. On DELETE, all
+// cluster profile bindings referring to the pod are returned to release it.
+func (p *podBinder) resolveBindings(
 	ctx context.Context,
-	profilebindings []profilebindingapi.ProfileBinding,
 	req *admission.Request,
-) (*corev1.Pod, admission.Response) {
-	var err error
+	profileBindings []profilebindingapi.ProfileBinding,
+	clusterProfileBindings []profilebindingapi.ClusterProfileBinding,
+) ([]binding, error) {
+	podID := req.Namespace + "/" + req.Name
+	bindings := make([]binding, 0, len(clusterProfileBindings)+len(profileBindings))
 
-	var podBindProfile *any
+	var namespace *corev1.Namespace
 
-	var containers sync.Map
+	for i := range clusterProfileBindings {
+		cpb := &clusterProfileBindings[i]
 
-	var podProfileBinding *profilebindingapi.ProfileBinding
+		if req.Operation == "DELETE" {
+			if slices.Contains(cpb.Status.ActiveWorkloads, podID) {
+				bindings = append(bindings, newClusterBinding(cpb))
+			}
 
-	podID := req.Namespace + "/" + req.Name
-	pod := &corev1.Pod{}
-	podChanged := false
+			continue
+		}
 
-	if req.Operation != "DELETE" {
-		pod, err = p.DecodePod(*req)
-		if err != nil {
-			p.log.Error(err, "failed to decode pod")
+		if cpb.Spec.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(cpb.Spec.NamespaceSelector)
+			if err != nil {
+				p.log.Error(err, "skip cluster binding due to invalid namespace selector", "cluster-binding", cpb.Name)
+
+				continue
+			}
+
+			if namespace == nil {
+				namespace, err = p.GetNamespace(ctx, req.Namespace)
+				if err != nil {
+					return nil, fmt.Errorf("get namespace of pod: %w", err)
+				}
+			}
 
-			return pod, admission.Errored(http.StatusBadRequest, err)
+			if !selector.Matches(labels.Set(namespace.Labels)) {
+				continue
+			}
 		}
 
-		initContainerMap(&containers, &pod.Spec)
+		bindings = append(bindings, newClusterBinding(cpb))
 	}
 
-	for i := range profilebindings {
-		profileKind := profilebindings[i].Spec.ProfileRef.Kind
+	for i := range profileBindings {
+		bindings = append(bindings, newBinding(&profileBindings[i]))
+	}
 
-		profileName := profilebindings[i].Spec.ProfileRef.Name
+	return bindings, nil
+}
 
-		if req.Operation == "DELETE" {
-			if err := p.removePodFromBinding(ctx, podID, &profilebindings[i]); err != nil {
-				return pod, admission.Errored(http.StatusInternalServerError, err)
+// selectBindings returns the binding taking precedence per container and
+// profile kind, as well as the pod level binding taking precedence.
+func (p *podBinder) selectBindings(
+	bindings []binding, pod *corev1.Pod, containers *sync.Map,
+) (selected map[*corev1.Container]map[profilebindingapi.ProfileBindingKind]*binding, podBinding *binding) {
+	selected = map[*corev1.Container]map[profilebindingapi.ProfileBindingKind]*binding{}
+
+	for i := range bindings {
+		b := &bindings[i]
+
+		matches, err := b.matchesPod(pod)
+		if err != nil {
+			p.log.Error(err, "skip binding due to invalid pod selector", b.name, b.GetName())
+
+			continue
+		}
+
+		if !matches {
+			continue
+		}
+
+		if b.isPodLevel() {
+			if podBinding == nil || b.precedes(podBinding) {
+				podBinding = b
 			}
 
 			continue
 		}
 
-		namespacedName := types.NamespacedName{Namespace: req.Namespace, Name: profileName}
+		containers.Range(func(key, value any) bool {
+			image, ok := key.(string)
+			if !ok {
+				return true
+			}
 
-		var bindProfile any
+			matches, err := b.matchesImage(image)
+			if err != nil {
+				p.log.Error(err, "skip binding due to invalid image", b.name, b.GetName())
 
-		var err error
+				return false
+			}
 
-		switch profileKind {
-		case profilebindingapi.ProfileBindingKindSeccompProfile:
-			bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
-		case profilebindingapi.ProfileBindingKindSelinuxProfile:
-			bindProfile, err = p.getSelinuxProfile(ctx, namespacedName)
-		case profilebindingapi.ProfileBindingKindAppArmorProfile:
-			bindProfile, err = p.getAppArmorProfile(ctx, namespacedName)
-		default:
-			p.log.Info(fmt.Sprintf("profile kind %s not supported", profileKind))
+			cList, ok := value.(containerList)
+			if !matches || !ok {
+				return true
+			}
 
-			continue
-		}
+			for _, c := range cList {
+				if !b.matchesContainer(c.Name) {
+					continue
+				}
 
-		if err != nil {
-			// This relies on util.Retry to propagate the last retried error though the tree of wrapped errors when a
-			// resource is not found. Without this, the last error when the retried reached the timeout would only be
-			// a wait.ErrWaitTimeout error which will never be matched by this if statement.
-			if kerrors.IsNotFound(err) {
-				p.log.Info("skip binding due to unavailable profile", "profile-kind", profileKind, "profile", namespacedName)
-				// When a profile is not found for a pod, the binding should be just skipped. Otherwise all pod CRUD(s)
-				// operation in a namespace with binding enabled will be blocked with 500 error. This might also lead
-				// to a DoS when a ProfileBinding has a non-existing profileRef.
-				continue
+				if selected[c] == nil {
+					selected[c] = map[profilebindingapi.ProfileBindingKind]*binding{}
+				}
+
+				kind := b.spec.ProfileRef.Kind
+				if current := selected[c][kind]; current == nil || b.precedes(current) {
+					selected[c][kind] = b
+				}
 			}
 
-			p.log.Error(err, fmt.Sprintf("failed to get %v %#v", profileKind, namespacedName))
+			return true
+		})
+	}
 
-			return pod, admission.Errored(http.StatusInternalServerError, err)
-		}
+	return selected, podBinding
+}
 
-		if profilebindings[i].Spec.Image == profilebindingapi.SelectAllContainersImage {
-			podBindProfile = &bindProfile
-			podProfileBinding = &profilebindings[i]
+func (p *podBinder) updatePod(
+	ctx context.Context,
+	bindings []binding,
+	req *admission.Request,
+) (*corev1.Pod, admission.Response) {
+	podID := req.Namespace + "/" + req.Name
 
-			continue
+	if req.Operation == "DELETE" {
+		for i := range bindings {
+			if err := p.removePodFromBinding(ctx, podID, &bindings[i]); err != nil {
+				return &corev1.Pod{}, admission.Errored(http.StatusInternalServerError, err)
+			}
 		}
 
-		value, ok := containers.Load(profilebindings[i].Spec.Image)
-		if !ok {
-			continue
-		}
+		return &corev1.Pod{}, admission.Allowed("pod unchanged")
+	}
 
-		containers, ok := value.(containerList)
-		if !ok {
-			continue
-		}
+	pod, err := p.DecodePod(*req)
+	if err != nil {
+		p.log.Error(err, "failed to decode pod")
 
-		for j := range containers {
-			podChanged = p.addSecurityContext(containers[j], bindProfile)
-		}
+		return pod, admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var containers sync.Map
+
+	initContainerMap(&containers, &pod.Spec)
+	selected, podBinding := p.selectBindings(bindings, pod, &containers)
+
+	profiles := map[*binding]any{}
+	changedBindings := []*binding{}
+	audits := auditProfiles{}
+
+	for _, c := range podContainers(&pod.Spec) {
+		for _, kind := range profileKinds {
+			b := selected[c][kind]
+			if b == nil {
+				continue
+			}
+
+			bindProfile, ok := profiles[b]
+			if !ok {
+				bindProfile, err = p.getBindProfile(ctx, req.Namespace, b)
+				if err != nil && !errors.Is(err, errProfileUnavailable) {
+					return pod, admission.Errored(http.StatusInternalServerError, err)
+				}
 
-		if podChanged {
-			if err := p.addPodToBinding(ctx, podID, &profilebindings[i]); err != nil {
-				return pod, admission.Errored(http.StatusInternalServerError, err)
+				profiles[b] = bindProfile
+			}
+
+			if bindProfile == nil {
+				continue
+			}
+
+			if b.isAudit() {
+				audits.add(c.Name, kind, bindProfile)
+			}
+
+			changed := p.addSecurityContext(pod.Spec.SecurityContext, c, bindProfile, b.isAudit()) || b.isAudit()
+			if changed && !slices.Contains(changedBindings, b) {
+				changedBindings = append(changedBindings, b)
 			}
 		}
 	}
 
-	if podChanged {
+	if len(changedBindings) > 0 {
+		if err := p.bindPod(ctx, podID, pod, changedBindings, audits); err != nil {
+			return pod, admission.Errored(http.StatusInternalServerError, err)
+		}
+
 		return pod, admission.Response{}
 	}
 
-	if podBindProfile == nil || podProfileBinding == nil {
+	if podBinding == nil {
 		return pod, admission.Allowed("pod unchanged")
 	}
 
-	if !p.addPodSecurityContext(pod, *podBindProfile) {
+	podBindProfile, err := p.getBindProfile(ctx, req.Namespace, podBinding)
+	if errors.Is(err, errProfileUnavailable) {
 		return pod, admission.Allowed("pod unchanged")
 	}
 
-	if err := p.addPodToBinding(ctx, podID, podProfileBinding); err != nil {
+	if err != nil {
+		return pod, admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if podBinding.isAudit() {
+		audits.add(selectAllContainers, podBinding.spec.ProfileRef.Kind, podBindProfile)
+	}
+
+	if !p.addPodSecurityContext(pod, podBindProfile, podBinding.isAudit()) && !podBinding.isAudit() {
+		return pod, admission.Allowed("pod unchanged")
+	}
+
+	if err := p.bindPod(ctx, podID, pod, []*binding{podBinding}, audits); err != nil {
 		return pod, admission.Errored(http.StatusInternalServerError, err)
 	}
 
 	return pod, admission.Response{}
 }
 
+// bindPod adds the pod to the changed bindings, annotates it with the profiles
+// of the bindings in Audit mode and emits an Event for each of them.
+func (p *podBinder) bindPod(
+	ctx context.Context, podID string, pod *corev1.Pod, bindings []*binding, audits auditProfiles,
+) error {
+	if len(audits) > 0 {
+		if err := audits.annotate(pod); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range bindings {
+		if err := p.addPodToBinding(ctx, podID, b); err != nil {
+			return err
+		}
+
+		if b.isAudit() {
+			p.record.Eventf(b.Object, corev1.EventTypeNormal, reasonAuditedPod,
+				"Pod %s would have been bound to %s %s",
+				podID, b.spec.ProfileRef.Kind, b.spec.ProfileRef.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+// podContainers returns the init containers and containers of the pod.
+func podContainers(spec *corev1.PodSpec) []*corev1.Container {
+	containers := make([]*corev1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+
+	for i := range spec.InitContainers {
+		containers = append(containers, &spec.InitContainers[i])
+	}
+
+	for i := range spec.Containers {
+		containers = append(containers, &spec.Containers[i])
+	}
+
+	return containers
+}
+
+// getBindProfile returns the profile referenced by the binding or
+// errProfileUnavailable if the binding should be skipped.
+func (p *podBinder) getBindProfile(ctx context.Context, namespace string, b *binding) (any, error) {
+	profileKind := b.spec.ProfileRef.Kind
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: b.spec.ProfileRef.Name}
+
+	var (
+		bindProfile any
+		err         error
+	)
+
+	switch profileKind {
+	case profilebindingapi.ProfileBindingKindSeccompProfile:
+		bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
+	case profilebindingapi.ProfileBindingKindSelinuxProfile:
+		bindProfile, err = p.getSelinuxProfile(ctx, namespacedName)
+	case profilebindingapi.ProfileBindingKindAppArmorProfile:
+		bindProfile, err = p.getAppArmorProfile(ctx, namespacedName)
+	default:
+		p.log.Info(fmt.Sprintf("profile kind %s not supported", profileKind))
+
+		return nil, errProfileUnavailable
+	}
+
+	if err != nil {
+		// This relies on util.Retry to propagate the last retried error though the tree of wrapped errors when a
+		// resource is not found. Without this, the last error when the retried reached the timeout would only be
+		// a wait.ErrWaitTimeout error which will never be matched by this if statement.
+		if kerrors.IsNotFound(err) {
+			p.log.Info("skip binding due to unavailable profile", "profile-kind", profileKind, "profile", namespacedName)
+			// When a profile is not found for a pod, the binding should be just skipped. Otherwise all pod CRUD(s)
+			// operation in a namespace with binding enabled will be blocked with 500 error. This might also lead
+			// to a DoS when a ProfileBinding has a non-existing profileRef.
+			return nil, errProfileUnavailable
+		}
+
+		p.log.Error(err, fmt.Sprintf("failed to get %v %#v", profileKind, namespacedName))
+
+		return nil, err
+	}
+
+	return bindProfile, nil
+}
+
 func (p *podBinder) getSeccompProfile(
 	ctx context.Context,
 	key types.NamespacedName,
@@ -334,18 +584,32 @@ func (p *podBinder) getAppArmorProfile(
 	return appArmorProfile, err
 }
 
+// addSecurityContext binds the profile to the container. In audit mode, the
+// non-enforcing variant of the profile gets used if neither the container nor
+// the pod already specify a profile, which is never weakened.
 func (p *podBinder) addSecurityContext(
-	c *corev1.Container, bindProfile any,
+	podContext *corev1.PodSecurityContext, c *corev1.Container, bindProfile any, audit bool,
 ) bool {
 	var podChanged bool
 
 	switch v := bindProfile.(type) {
 	case *seccompprofileapi.SeccompProfile:
-		podChanged = p.addSeccompContext(c, v)
+		if !audit {
+			podChanged = p.addSeccompContext(c, v.Status.LocalhostProfile)
+		} else if !hasSeccompProfile(podContext, c) {
+			podChanged = p.addSeccompContext(c, v.GetAuditLocalhostProfile())
+		}
 	case *selinuxprofileapi.SelinuxProfile:
-		podChanged = p.addSelinuxContext(c, v)
+		// SELinux has no non-enforcing variant of a profile
+		if !audit {
+			podChanged = p.addSelinuxContext(c, v)
+		}
 	case *apparmorprofileapi.AppArmorProfile:
-		podChanged = p.addAppArmorContext(c, v)
+		if !audit {
+			podChanged = p.addAppArmorContext(c, v.GetProfileName())
+		} else if !hasAppArmorProfile(podContext, c) {
+			podChanged = p.addAppArmorContext(c, v.GetAuditProfileName())
+		}
 	default:
 		p.log.Info("Unexpected Profile Type")
 
@@ -355,10 +619,21 @@ func (p *podBinder) addSecurityContext(
 	return podChanged
 }
 
-func (p *podBinder) addSeccompContext(
-	c *corev1.Container, seccompProfile *seccompprofileapi.SeccompProfile,
-) bool {
-	profileRef := seccompProfile.Status.LocalhostProfile
+// hasSeccompProfile returns true if the container specifies a seccomp profile
+// or inherits one from the pod.
+func hasSeccompProfile(podContext *corev1.PodSecurityContext, c *corev1.Container) bool {
+	return (c.SecurityContext != nil && c.SecurityContext.SeccompProfile != nil) ||
+		(podContext != nil && podContext.SeccompProfile != nil)
+}
+
+// hasAppArmorProfile returns true if the container specifies an AppArmor
+// profile or inherits one from the pod.
+func hasAppArmorProfile(podContext *corev1.PodSecurityContext, c *corev1.Container) bool {
+	return (c.SecurityContext != nil && c.SecurityContext.AppArmorProfile != nil) ||
+		(podContext != nil && podContext.AppArmorProfile != nil)
+}
+
+func (p *podBinder) addSeccompContext(c *corev1.Container, profileRef string) bool {
 	sp := corev1.SeccompProfile{
 		Type:             corev1.SeccompProfileTypeLocalhost,
 		LocalhostProfile: &profileRef,
@@ -416,10 +691,7 @@ func (p *podBinder) addSelinuxContext(
 	return false
 }
 
-func (p *podBinder) addAppArmorContext(
-	c *corev1.Container, appArmorProfile *apparmorprofileapi.AppArmorProfile,
-) bool {
-	profileName := appArmorProfile.GetProfileName()
+func (p *podBinder) addAppArmorContext(c *corev1.Container, profileName string) bool {
 	aa := corev1.AppArmorProfile{
 		Type:             corev1.AppArmorProfileTypeLocalhost,
 		LocalhostProfile: &profileName,
@@ -447,18 +719,38 @@ func (p *podBinder) addAppArmorContext(
 	return false
 }
 
+// addPodSecurityContext binds the profile to the pod. In audit mode, the
+// non-enforcing variant of the profile gets used if the pod does not already
+// specify a profile, which is never weakened.
 func (p *podBinder) addPodSecurityContext(
-	pod *corev1.Pod, bindProfile any,
+	pod *corev1.Pod, bindProfile any, audit bool,
 ) bool {
 	var podChanged bool
 
+	podContext := pod.Spec.SecurityContext
+
 	switch v := bindProfile.(type) {
 	case *seccompprofileapi.SeccompProfile:
-		podChanged = p.addPodSeccompContext(pod, v)
+		if audit {
+			if podContext == nil || podContext.SeccompProfile == nil {
+				podChanged = p.addPodSeccompContext(pod, v.GetAuditLocalhostProfile())
+			}
+		} else {
+			podChanged = p.addPodSeccompContext(pod, v.Status.LocalhostProfile)
+		}
 	case *selinuxprofileapi.SelinuxProfile:
-		podChanged = p.addPodSelinuxContext(pod, v)
+		// SELinux has no non-enforcing variant of a profile
+		if !audit {
+			podChanged = p.addPodSelinuxContext(pod, v)
+		}
 	case *apparmorprofileapi.AppArmorProfile:
-		podChanged = p.addPodAppArmorContext(pod, v)
+		if audit {
+			if podContext == nil || podContext.AppArmorProfile == nil {
+				podChanged = p.addPodAppArmorContext(pod, v.GetAuditProfileName())
+			}
+		} else {
+			podChanged = p.addPodAppArmorContext(pod, v.GetProfileName())
+		}
 	default:
 		p.log.Info("Unexpected Profile Type")
 
@@ -468,11 +760,8 @@ func (p *podBinder) addPodSecurityContext(
 	return podChanged
 }
 
-func (p *podBinder) addPodSeccompContext(
-	pod *corev1.Pod, seccompProfile *seccompprofileapi.SeccompProfile,
-) bool {
+func (p *podBinder) addPodSeccompContext(pod *corev1.Pod, profileRef string) bool {
 	podChanged := false
-	profileRef := seccompProfile.Status.LocalhostProfile
 	sp := corev1.SeccompProfile{
 		Type:             corev1.SeccompProfileTypeLocalhost,
 		LocalhostProfile: &profileRef,
@@ -515,11 +804,8 @@ func (p *podBinder) addPodSelinuxContext(
 	return podChanged
 }
 
-func (p *podBinder) addPodAppArmorContext(
-	pod *corev1.Pod, appArmorProfile *apparmorprofileapi.AppArmorProfile,
-) bool {
+func (p *podBinder) addPodAppArmorContext(pod *corev1.Pod, profileName string) bool {
 	podChanged := false
-	profileName := appArmorProfile.GetProfileName()
 	aa := corev1.AppArmorProfile{
 		Type:             corev1.AppArmorProfileTypeLocalhost,
 		LocalhostProfile: &profileName,
@@ -542,34 +828,34 @@ func (p *podBinder) addPodAppArmorContext(
 func (p *podBinder) addPodToBinding(
 	ctx context.Context,
 	podID string,
-	pb *profilebindingapi.ProfileBinding,
+	b *binding,
 ) error {
-	pb.Status.ActiveWorkloads = utils.AppendIfNotExists(pb.Status.ActiveWorkloads, podID)
-	if err := p.UpdateResourceStatus(ctx, p.log, pb, "profilebinding status"); err != nil {
+	b.status.ActiveWorkloads = utils.AppendIfNotExists(b.status.ActiveWorkloads, podID)
+	if err := p.UpdateResourceStatus(ctx, p.log, b.Object, b.name+" status"); err != nil {
 		return fmt.Errorf("add pod to binding: %w", err)
 	}
 
-	if !controllerutil.ContainsFinalizer(pb, finalizer) {
-		controllerutil.AddFinalizer(pb, finalizer)
+	if !controllerutil.ContainsFinalizer(b.Object, finalizer) {
+		controllerutil.AddFinalizer(b.Object, finalizer)
 	}
 
-	return p.UpdateResource(ctx, p.log, pb, "profilebinding")
+	return p.UpdateResource(ctx, p.log, b.Object, b.name)
 }
 
 func (p *podBinder) removePodFromBinding(
 	ctx context.Context,
 	podID string,
-	pb *profilebindingapi.ProfileBinding,
+	b *binding,
 ) error {
-	pb.Status.ActiveWorkloads = utils.RemoveIfExists(pb.Status.ActiveWorkloads, podID)
-	if err := p.UpdateResourceStatus(ctx, p.log, pb, "profilebinding status"); err != nil {
+	b.status.ActiveWorkloads = utils.RemoveIfExists(b.status.ActiveWorkloads, podID)
+	if err := p.UpdateResourceStatus(ctx, p.log, b.Object, b.name+" status"); err != nil {
 		return fmt.Errorf("remove pod from binding: %w", err)
 	}
 
-	if len(pb.Status.ActiveWorkloads) == 0 &&
-		controllerutil.ContainsFinalizer(pb, finalizer) {
-		controllerutil.RemoveFinalizer(pb, finalizer)
+	if len(b.status.ActiveWorkloads) == 0 &&
+		controllerutil.ContainsFinalizer(b.Object, finalizer) {
+		controllerutil.RemoveFinalizer(b.Object, finalizer)
 	}
 
-	return p.UpdateResource(ctx, p.log, pb, "profilebinding")
+	return p.UpdateResource(ctx, p.log, b.Object, b.name)
 }
@@ -40,6 +40,7 @@ import (
 	secprofnodestatusapi "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
 
 var (
@@ -86,6 +87,82 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
 			},
 		},
+		{ // error could not list cluster profile bindings
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingapi.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
+		{ // success pod changed by cluster profile binding
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingapi.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(&profilebindingapi.ClusterProfileBindingList{
+					Items: []profilebindingapi.ClusterProfileBinding{
+						{
+							Spec: profilebindingapi.ClusterProfileBindingSpec{
+								ProfileBindingSpec: profilebindingapi.ProfileBindingSpec{
+									ProfileRef: profilebindingapi.ProfileRef{
+										Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+									},
+									Image: "foo",
+								},
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"team": "web"},
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "web"}},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebaseapi.StatusBase{
+							Status: secprofnodestatusapi.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.NoError(t, err)
+
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Len(t, resp.Patches, 1)
+			},
+		},
+		{ // error could not get namespace for cluster profile binding
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingapi.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(&profilebindingapi.ClusterProfileBindingList{
+					Items: []profilebindingapi.ClusterProfileBinding{
+						{
+							Spec: profilebindingapi.ClusterProfileBindingSpec{
+								NamespaceSelector: &metav1.LabelSelector{},
+							},
+						},
+					},
+				}, nil)
+				mock.GetNamespaceReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
 		{ // error failed to decode pod
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&profilebindingapi.ProfileBindingList{}, nil)
@@ -491,6 +568,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: profilebindingapi.ProfileBindingKindAppArmorProfile,
 								},
+								Image: "foo",
 							},
 						},
 					},
@@ -524,6 +602,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: profilebindingapi.ProfileBindingKindAppArmorProfile,
 								},
+								Image: "foo",
 							},
 						},
 					},
@@ -558,6 +637,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: "unsupported",
 								},
+								Image: "foo",
 							},
 						},
 					},
@@ -598,6 +678,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
 								},
+								Image: "foo",
 							},
 						},
 					},
@@ -632,6 +713,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
 								},
+								Image: "foo",
 							},
 						},
 					},
@@ -746,6 +828,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
 								},
+								Image: "foo",
 							},
 							Status: profilebindingapi.ProfileBindingStatus{
 								ActiveWorkloads: []string{"1", "2", "3"},
@@ -773,6 +856,7 @@ func TestHandle(t *testing.T) {
 								ProfileRef: profilebindingapi.ProfileRef{
 									Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
 								},
+								Image: "foo",
 							},
 							Status: profilebindingapi.ProfileBindingStatus{
 								ActiveWorkloads: []string{"1", "2", "3"},
@@ -828,14 +912,202 @@ func TestHandle(t *testing.T) {
 		},
 	} {
 		mock := &bindingfakes.FakeImpl{}
+		mock.ListClusterProfileBindingsReturns(&profilebindingapi.ClusterProfileBindingList{}, nil)
 		tc.prepare(mock)
 
-		binder := podBinder{impl: mock, log: logr.Discard()}
+		binder := podBinder{impl: mock, log: logr.Discard(), record: utils.NewSafeRecorder(nil)}
 		resp := binder.Handle(t.Context(), tc.request)
 		tc.assert(resp)
 	}
 }
 
+func TestResolveBindings(t *testing.T) {
+	t.Parallel()
+
+	clusterBinding := func(
+		name string, kind profilebindingapi.ProfileBindingKind, selector *metav1.LabelSelector,
+	) profilebindingapi.ClusterProfileBinding {
+		return profilebindingapi.ClusterProfileBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: profilebindingapi.ClusterProfileBindingSpec{
+				ProfileBindingSpec: profilebindingapi.ProfileBindingSpec{
+					ProfileRef: profilebindingapi.ProfileRef{Kind: kind, Name: name},
+					Image:      "foo",
+				},
+				NamespaceSelector: selector,
+			},
+		}
+	}
+
+	namespacedBinding := profilebindingapi.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "namespaced", Namespace: "ns"},
+		Spec: profilebindingapi.ProfileBindingSpec{
+			ProfileRef: profilebindingapi.ProfileRef{
+				Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+				Name: "namespaced",
+			},
+			Image: "foo",
+		},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		operation       admissionv1.Operation
+		clusterBindings []profilebindingapi.ClusterProfileBinding
+		expected        []string
+		namespaceCalls  int
+	}{
+		{
+			name: "cluster binding without selector",
+			clusterBindings: []profilebindingapi.ClusterProfileBinding{
+				clusterBinding("all", profilebindingapi.ProfileBindingKindSelinuxProfile, nil),
+			},
+			expected: []string{"all", "namespaced"},
+		},
+		{
+			name: "cluster bindings with namespace selector",
+			clusterBindings: []profilebindingapi.ClusterProfileBinding{
+				clusterBinding("match", profilebindingapi.ProfileBindingKindSelinuxProfile,
+					&metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}}),
+				clusterBinding("no-match", profilebindingapi.ProfileBindingKindAppArmorProfile,
+					&metav1.LabelSelector{MatchLabels: map[string]string{"team": "db"}}),
+				clusterBinding("invalid", profilebindingapi.ProfileBindingKindAppArmorProfile,
+					&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "team", Operator: "invalid"},
+					}}),
+			},
+			expected:       []string{"match", "namespaced"},
+			namespaceCalls: 1,
+		},
+		{
+			name:      "delete releases only cluster bindings of the pod",
+			operation: admissionv1.Delete,
+			clusterBindings: []profilebindingapi.ClusterProfileBinding{
+				func() profilebindingapi.ClusterProfileBinding {
+					cpb := clusterBinding("active", profilebindingapi.ProfileBindingKindSeccompProfile, nil)
+					cpb.Status.ActiveWorkloads = []string{"ns/pod"}
+
+					return cpb
+				}(),
+				clusterBinding("inactive", profilebindingapi.ProfileBindingKindSelinuxProfile, nil),
+			},
+			expected: []string{"active", "namespaced"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			mock.GetNamespaceReturns(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "web"}},
+			}, nil)
+
+			binder := podBinder{impl: mock, log: logr.Discard(), record: utils.NewSafeRecorder(nil)}
+			req := &admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Namespace: "ns",
+				Name:      "pod",
+				Operation: tc.operation,
+			}}
+
+			bindings, err := binder.resolveBindings(t.Context(), req,
+				[]profilebindingapi.ProfileBinding{*namespacedBinding.DeepCopy()}, tc.clusterBindings)
+			require.NoError(t, err)
+
+			names := []string{}
+			for i := range bindings {
+				names = append(names, bindings[i].GetName())
+			}
+
+			require.Equal(t, tc.expected, names)
+			require.Equal(t, tc.namespaceCalls, mock.GetNamespaceCallCount())
+		})
+	}
+}
+
+func TestSelectBindings(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "init", Image: "busybox:1.36"},
+			},
+			Containers: []corev1.Container{
+				{Name: "nginx", Image: "docker.io/library/nginx:1.19.1"},
+				{Name: "sidecar", Image: "quay.io/org/sidecar:v2"},
+			},
+		},
+	}
+
+	namespaced := func(
+		name string, kind profilebindingapi.ProfileBindingKind, spec profilebindingapi.ProfileBindingSpec,
+	) profilebindingapi.ProfileBinding {
+		spec.ProfileRef = profilebindingapi.ProfileRef{Kind: kind, Name: name}
+
+		return profilebindingapi.ProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+
+	cluster := profilebindingapi.ClusterProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec: profilebindingapi.ClusterProfileBindingSpec{
+			ProfileBindingSpec: profilebindingapi.ProfileBindingSpec{
+				ProfileRef: profilebindingapi.ProfileRef{
+					Kind: profilebindingapi.ProfileBindingKindSeccompProfile,
+					Name: "cluster",
+				},
+				Image:      "quay.io/org/*",
+				ImageMatch: profilebindingapi.ImageMatchGlob,
+			},
+		},
+	}
+
+	profileBindings := []profilebindingapi.ProfileBinding{
+		namespaced("nginx-repository", profilebindingapi.ProfileBindingKindSeccompProfile,
+			profilebindingapi.ProfileBindingSpec{Image: "nginx", ImageMatch: profilebindingapi.ImageMatchRepository}),
+		namespaced("nginx-name", profilebindingapi.ProfileBindingKindSeccompProfile,
+			profilebindingapi.ProfileBindingSpec{Image: "*", ContainerNames: []string{"nginx"}}),
+		namespaced("nginx-apparmor", profilebindingapi.ProfileBindingKindAppArmorProfile,
+			profilebindingapi.ProfileBindingSpec{Image: "nginx:1.19.1"}),
+		namespaced("other-pods", profilebindingapi.ProfileBindingKindSelinuxProfile,
+			profilebindingapi.ProfileBindingSpec{
+				Image:       "*",
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			}),
+		namespaced("pod", profilebindingapi.ProfileBindingKindSelinuxProfile,
+			profilebindingapi.ProfileBindingSpec{Image: "*"}),
+	}
+
+	bindings := []binding{newClusterBinding(&cluster)}
+	for i := range profileBindings {
+		bindings = append(bindings, newBinding(&profileBindings[i]))
+	}
+
+	var containers sync.Map
+
+	initContainerMap(&containers, &pod.Spec)
+
+	binder := podBinder{log: logr.Discard()}
+	selected, podBinding := binder.selectBindings(bindings, pod, &containers)
+
+	names := map[string]map[profilebindingapi.ProfileBindingKind]string{}
+
+	for c, kinds := range selected {
+		names[c.Name] = map[profilebindingapi.ProfileBindingKind]string{}
+		for kind, b := range kinds {
+			names[c.Name][kind] = b.GetName()
+		}
+	}
+
+	// The exact apparmor image does not match the normalized container image.
+	require.Equal(t, map[string]map[profilebindingapi.ProfileBindingKind]string{
+		"nginx":   {profilebindingapi.ProfileBindingKindSeccompProfile: "nginx-name"},
+		"sidecar": {profilebindingapi.ProfileBindingKindSeccompProfile: "cluster"},
+	}, names)
+	require.NotNil(t, podBinding)
+	require.Equal(t, "pod", podBinding.GetName())
+}
+
 func TestNewContainerMap(t *testing.T) {
 	t.Parallel()
 
@@ -60,6 +60,20 @@ type FakeImpl struct {
 		result1 *v1a.AppArmorProfile
 		result2 error
 	}
+	GetNamespaceStub        func(context.Context, string) (*v1.Namespace, error)
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNamespaceReturns struct {
+		result1 *v1.Namespace
+		result2 error
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 *v1.Namespace
+		result2 error
+	}
 	GetSeccompProfileStub        func(context.Context, types.NamespacedName) (*v1b.SeccompProfile, error)
 	getSeccompProfileMutex       sync.RWMutex
 	getSeccompProfileArgsForCall []struct {
@@ -88,6 +102,20 @@ type FakeImpl struct {
 		result1 *v1c.SelinuxProfile
 		result2 error
 	}
+	ListClusterProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1d.ClusterProfileBindingList, error)
+	listClusterProfileBindingsMutex       sync.RWMutex
+	listClusterProfileBindingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []client.ListOption
+	}
+	listClusterProfileBindingsReturns struct {
+		result1 *v1d.ClusterProfileBindingList
+		result2 error
+	}
+	listClusterProfileBindingsReturnsOnCall map[int]struct {
+		result1 *v1d.ClusterProfileBindingList
+		result2 error
+	}
 	ListProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1d.ProfileBindingList, error)
 	listProfileBindingsMutex       sync.RWMutex
 	listProfileBindingsArgsForCall []struct {
@@ -263,6 +291,71 @@ func (fake *FakeImpl) GetAppArmorProfileReturnsOnCall(i int, result1 *v1a.AppArm
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) GetNamespace(arg1 context.Context, arg2 string) (*v1.Namespace, error) {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{arg1, arg2})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakeImpl) GetNamespaceCalls(stub func(context.Context, string) (*v1.Namespace, error)) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakeImpl) GetNamespaceArgsForCall(i int) (context.Context, string) {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	argsForCall := fake.getNamespaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetNamespaceReturns(result1 *v1.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 *v1.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespaceReturnsOnCall(i int, result1 *v1.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 *v1.Namespace
+			result2 error
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 *v1.Namespace
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetSeccompProfile(arg1 context.Context, arg2 types.NamespacedName) (*v1b.SeccompProfile, error) {
 	fake.getSeccompProfileMutex.Lock()
 	ret, specificReturn := fake.getSeccompProfileReturnsOnCall[len(fake.getSeccompProfileArgsForCall)]
@@ -393,6 +486,71 @@ func (fake *FakeImpl) GetSelinuxProfileReturnsOnCall(i int, result1 *v1c.Selinux
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ListClusterProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1d.ClusterProfileBindingList, error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	ret, specificReturn := fake.listClusterProfileBindingsReturnsOnCall[len(fake.listClusterProfileBindingsArgsForCall)]
+	fake.listClusterProfileBindingsArgsForCall = append(fake.listClusterProfileBindingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []client.ListOption
+	}{arg1, arg2})
+	stub := fake.ListClusterProfileBindingsStub
+	fakeReturns := fake.listClusterProfileBindingsReturns
+	fake.recordInvocation("ListClusterProfileBindings", []interface{}{arg1, arg2})
+	fake.listClusterProfileBindingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsCallCount() int {
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	return len(fake.listClusterProfileBindingsArgsForCall)
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsCalls(stub func(context.Context, ...client.ListOption) (*v1d.ClusterProfileBindingList, error)) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = stub
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsArgsForCall(i int) (context.Context, []client.ListOption) {
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	argsForCall := fake.listClusterProfileBindingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsReturns(result1 *v1d.ClusterProfileBindingList, result2 error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = nil
+	fake.listClusterProfileBindingsReturns = struct {
+		result1 *v1d.ClusterProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsReturnsOnCall(i int, result1 *v1d.ClusterProfileBindingList, result2 error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = nil
+	if fake.listClusterProfileBindingsReturnsOnCall == nil {
+		fake.listClusterProfileBindingsReturnsOnCall = make(map[int]struct {
+			result1 *v1d.ClusterProfileBindingList
+			result2 error
+		})
+	}
+	fake.listClusterProfileBindingsReturnsOnCall[i] = struct {
+		result1 *v1d.ClusterProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ListProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1d.ProfileBindingList, error) {
 	fake.listProfileBindingsMutex.Lock()
 	ret, specificReturn := fake.listProfileBindingsReturnsOnCall[len(fake.listProfileBindingsArgsForCall)]
@@ -42,6 +42,8 @@ type defaultImpl struct {
 //counterfeiter:generate . impl
 type impl interface {
 	ListProfileBindings(context.Context, ...client.ListOption) (*profilebindingapi.ProfileBindingList, error)
+	ListClusterProfileBindings(context.Context, ...client.ListOption) (*profilebindingapi.ClusterProfileBindingList, error)
+	GetNamespace(context.Context, string) (*corev1.Namespace, error)
 	UpdateResource(context.Context, logr.Logger, client.Object, string) error
 	UpdateResourceStatus(context.Context, logr.Logger, client.Object, string) error
 	DecodePod(admission.Request) (*corev1.Pod, error)
@@ -61,6 +63,26 @@ func (d *defaultImpl) ListProfileBindings(
 	return profileBindings, nil
 }
 
+func (d *defaultImpl) ListClusterProfileBindings(
+	ctx context.Context, opts ...client.ListOption,
+) (*profilebindingapi.ClusterProfileBindingList, error) {
+	clusterProfileBindings := &profilebindingapi.ClusterProfileBindingList{}
+	if err := d.client.List(ctx, clusterProfileBindings, opts...); err != nil {
+		return nil, fmt.Errorf("list cluster profile bindings: %w", err)
+	}
+
+	return clusterProfileBindings, nil
+}
+
+func (d *defaultImpl) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return nil, fmt.Errorf("get namespace: %w", err)
+	}
+
+	return namespace, nil
+}
+
 func (d *defaultImpl) UpdateResource(
 	ctx context.Context,
 	logger logr.Logger,
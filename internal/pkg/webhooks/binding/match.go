@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+)
+
+// Image match precisions used to resolve conflicts between bindings, where a
+// more precise match takes precedence.
+const (
+	precisionAny = iota
+	precisionPattern
+	precisionRepository
+	precisionExact
+)
+
+// isPodLevel returns true if the binding applies its profile to the pod
+// security context instead of single containers.
+func (b *binding) isPodLevel() bool {
+	return b.spec.Image == profilebindingapi.SelectAllContainersImage && len(b.spec.ContainerNames) == 0
+}
+
+// matchesPod returns true if the pod labels are selected by the binding.
+func (b *binding) matchesPod(pod *corev1.Pod) (bool, error) {
+	if b.spec.PodSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(b.spec.PodSelector)
+	if err != nil {
+		return false, fmt.Errorf("parse pod selector: %w", err)
+	}
+
+	return selector.Matches(labels.Set(pod.Labels)), nil
+}
+
+// matchesContainer returns true if the container name is selected by the binding.
+func (b *binding) matchesContainer(containerName string) bool {
+	return len(b.spec.ContainerNames) == 0 || slices.Contains(b.spec.ContainerNames, containerName)
+}
+
+// matchesImage returns true if the container image is selected by the binding.
+func (b *binding) matchesImage(image string) (bool, error) {
+	if b.spec.Image == profilebindingapi.SelectAllContainersImage {
+		return true, nil
+	}
+
+	switch b.spec.ImageMatch {
+	case profilebindingapi.ImageMatchRepository:
+		return repository(b.spec.Image) == repository(image), nil
+
+	case profilebindingapi.ImageMatchGlob:
+		matches, err := path.Match(b.spec.Image, image)
+		if err != nil {
+			return false, fmt.Errorf("match image glob: %w", err)
+		}
+
+		return matches, nil
+
+	case profilebindingapi.ImageMatchRegex:
+		re, err := regexp.Compile("^(?:" + b.spec.Image + ")$")
+		if err != nil {
+			return false, fmt.Errorf("compile image regex: %w", err)
+		}
+
+		return re.MatchString(image), nil
+
+	case profilebindingapi.ImageMatchExact, "":
+	}
+
+	return b.spec.Image == image, nil
+}
+
+// precision returns how precise the binding selects an image.
+func (b *binding) precision() int {
+	if b.spec.Image == profilebindingapi.SelectAllContainersImage {
+		return precisionAny
+	}
+
+	switch b.spec.ImageMatch {
+	case profilebindingapi.ImageMatchRepository:
+		return precisionRepository
+
+	case profilebindingapi.ImageMatchGlob, profilebindingapi.ImageMatchRegex:
+		return precisionPattern
+
+	case profilebindingapi.ImageMatchExact, "":
+	}
+
+	return precisionExact
+}
+
+// precedes returns true if the binding takes precedence over the other one
+// when both match the same container or pod. The precedence is given by:
+//
+//  1. ProfileBindings over ClusterProfileBindings.
+//  2. Bindings selecting containers by name over bindings which do not.
+//  3. The precision of the image match: exact, repository, glob or regex and "*".
+//  4. Bindings with a pod selector over bindings without.
+//  5. The lexicographically lower binding name.
+func (b *binding) precedes(other *binding) bool {
+	if b.cluster != other.cluster {
+		return !b.cluster
+	}
+
+	hasNames, otherHasNames := len(b.spec.ContainerNames) > 0, len(other.spec.ContainerNames) > 0
+	if hasNames != otherHasNames {
+		return hasNames
+	}
+
+	if precision, otherPrecision := b.precision(), other.precision(); precision != otherPrecision {
+		return precision > otherPrecision
+	}
+
+	hasSelector, otherHasSelector := b.spec.PodSelector != nil, other.spec.PodSelector != nil
+	if hasSelector != otherHasSelector {
+		return hasSelector
+	}
+
+	return b.GetName() < other.GetName()
+}
+
+// repository returns the normalized repository of the image, ignoring the tag
+// and digest. The image gets returned unchanged if it cannot be parsed.
+func repository(image string) string {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return image
+	}
+
+	return ref.Context().Name()
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1"
+)
+
+func TestMatchesImage(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		image      string
+		imageMatch profilebindingapi.ImageMatchType
+		container  string
+		matches    bool
+		shouldErr  bool
+	}{
+		{image: "nginx:1.19.1", container: "nginx:1.19.1", matches: true},
+		{image: "nginx:1.19.1", container: "nginx:1.19.2", matches: false},
+		{image: "*", imageMatch: profilebindingapi.ImageMatchRegex, container: "nginx", matches: true},
+		{
+			image:      "nginx",
+			imageMatch: profilebindingapi.ImageMatchRepository,
+			container:  "docker.io/library/nginx:1.19.1",
+			matches:    true,
+		},
+		{
+			image:      "quay.io/org/app:latest",
+			imageMatch: profilebindingapi.ImageMatchRepository,
+			container:  "quay.io/org/app@sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			matches:    true,
+		},
+		{
+			image:      "quay.io/org/app",
+			imageMatch: profilebindingapi.ImageMatchRepository,
+			container:  "quay.io/org/other:latest",
+			matches:    false,
+		},
+		{
+			image:      "quay.io/org/*",
+			imageMatch: profilebindingapi.ImageMatchGlob,
+			container:  "quay.io/org/app:v1",
+			matches:    true,
+		},
+		{
+			image:      "quay.io/org/*",
+			imageMatch: profilebindingapi.ImageMatchGlob,
+			container:  "quay.io/org/team/app:v1",
+			matches:    false,
+		},
+		{
+			image:      "[",
+			imageMatch: profilebindingapi.ImageMatchGlob,
+			container:  "nginx",
+			shouldErr:  true,
+		},
+		{
+			image:      "quay.io/org/app:v1\\.[0-9]+",
+			imageMatch: profilebindingapi.ImageMatchRegex,
+			container:  "quay.io/org/app:v1.12",
+			matches:    true,
+		},
+		{
+			image:      "app:v1",
+			imageMatch: profilebindingapi.ImageMatchRegex,
+			container:  "quay.io/org/app:v1",
+			matches:    false,
+		},
+		{
+			image:      "(",
+			imageMatch: profilebindingapi.ImageMatchRegex,
+			container:  "nginx",
+			shouldErr:  true,
+		},
+	} {
+		b := newBinding(&profilebindingapi.ProfileBinding{
+			Spec: profilebindingapi.ProfileBindingSpec{Image: tc.image, ImageMatch: tc.imageMatch},
+		})
+
+		matches, err := b.matchesImage(tc.container)
+		if tc.shouldErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, matches, tc.image+" "+tc.container)
+		}
+	}
+}
+
+func TestMatchesPod(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "nginx"}}}
+
+	for _, tc := range []struct {
+		selector  *metav1.LabelSelector
+		matches   bool
+		shouldErr bool
+	}{
+		{selector: nil, matches: true},
+		{selector: &metav1.LabelSelector{}, matches: true},
+		{selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}}, matches: true},
+		{selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "redis"}}, matches: false},
+		{
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "invalid"},
+			}},
+			shouldErr: true,
+		},
+	} {
+		b := newBinding(&profilebindingapi.ProfileBinding{
+			Spec: profilebindingapi.ProfileBindingSpec{PodSelector: tc.selector},
+		})
+
+		matches, err := b.matchesPod(pod)
+		if tc.shouldErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, matches)
+		}
+	}
+}
+
+func TestPrecedes(t *testing.T) {
+	t.Parallel()
+
+	spec := func(
+		image string, imageMatch profilebindingapi.ImageMatchType, names []string, selector *metav1.LabelSelector,
+	) profilebindingapi.ProfileBindingSpec {
+		return profilebindingapi.ProfileBindingSpec{
+			Image:          image,
+			ImageMatch:     imageMatch,
+			ContainerNames: names,
+			PodSelector:    selector,
+		}
+	}
+	namespaced := func(name string, spec profilebindingapi.ProfileBindingSpec) binding {
+		return newBinding(&profilebindingapi.ProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec})
+	}
+	cluster := func(name string, spec profilebindingapi.ProfileBindingSpec) binding {
+		return newClusterBinding(&profilebindingapi.ClusterProfileBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       profilebindingapi.ClusterProfileBindingSpec{ProfileBindingSpec: spec},
+		})
+	}
+
+	for _, tc := range []struct {
+		name          string
+		first, second binding
+	}{
+		{
+			name:   "namespaced over cluster",
+			first:  namespaced("b", spec("*", "", nil, nil)),
+			second: cluster("a", spec("nginx", "", []string{"nginx"}, nil)),
+		},
+		{
+			name:   "container names over image",
+			first:  namespaced("b", spec("*", "", []string{"nginx"}, nil)),
+			second: namespaced("a", spec("nginx", "", nil, nil)),
+		},
+		{
+			name:   "exact over repository",
+			first:  namespaced("b", spec("nginx:1.19.1", "", nil, nil)),
+			second: namespaced("a", spec("nginx", profilebindingapi.ImageMatchRepository, nil, nil)),
+		},
+		{
+			name:   "repository over glob",
+			first:  namespaced("b", spec("nginx", profilebindingapi.ImageMatchRepository, nil, nil)),
+			second: namespaced("a", spec("nginx:*", profilebindingapi.ImageMatchGlob, nil, nil)),
+		},
+		{
+			name:   "regex over any image",
+			first:  namespaced("b", spec("nginx:.*", profilebindingapi.ImageMatchRegex, nil, nil)),
+			second: namespaced("a", spec("*", "", nil, nil)),
+		},
+		{
+			name:   "pod selector over none",
+			first:  namespaced("b", spec("nginx", "", nil, &metav1.LabelSelector{})),
+			second: namespaced("a", spec("nginx", "", nil, nil)),
+		},
+		{
+			name:   "name as tie breaker",
+			first:  cluster("a", spec("nginx", "", nil, nil)),
+			second: cluster("b", spec("nginx", "", nil, nil)),
+		},
+	} {
+		require.True(t, tc.first.precedes(&tc.second), tc.name)
+		require.False(t, tc.second.precedes(&tc.first), tc.name)
+	}
+}
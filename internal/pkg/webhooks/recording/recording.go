@@ -295,11 +295,16 @@ func (p *podSeccompRecorder) updateSeccompSecurityContext(
 			"Container %s had SecurityContext already set, the profile recorder overwrote it", ctr.Name)
 	}
 
+	traceProfile := config.LogEnricherProfile
+	if pr.Spec.SeccompNotify {
+		traceProfile = config.SeccompNotifyRecorderProfile
+	}
+
 	ctr.SecurityContext.SeccompProfile.Type = corev1.SeccompProfileTypeLocalhost
 	profile := fmt.Sprintf(
 		"operator/%s/%s.json",
 		p.GetOperatorNamespace(),
-		config.LogEnricherProfile,
+		traceProfile,
 	)
 	ctr.SecurityContext.SeccompProfile.LocalhostProfile = &profile
 }
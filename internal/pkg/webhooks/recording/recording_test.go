@@ -20,7 +20,9 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
@@ -90,6 +92,107 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, int(resp.Result.Code))
 			},
 		},
+		{ // success pod unchanged - stop after idle with bpf recorder
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
+					Items: []profilerecordingapi.ProfileRecording{
+						{
+							Spec: profilerecordingapi.ProfileRecordingSpec{
+								Kind:          profilerecordingapi.ProfileRecordingKindSeccompProfile,
+								Recorder:      profilerecordingapi.ProfileRecorderBpf,
+								StopAfterIdle: &metav1.Duration{Duration: time.Minute},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // success pod unchanged - merge on completion without merge strategy
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
+					Items: []profilerecordingapi.ProfileRecording{
+						{
+							Spec: profilerecordingapi.ProfileRecordingSpec{
+								Kind:              profilerecordingapi.ProfileRecordingKindSeccompProfile,
+								Recorder:          profilerecordingapi.ProfileRecorderBpf,
+								Duration:          &metav1.Duration{Duration: time.Minute},
+								MergeOnCompletion: true,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // success pod unchanged - append to existing profile without merge strategy
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
+					Items: []profilerecordingapi.ProfileRecording{
+						{
+							Spec: profilerecordingapi.ProfileRecordingSpec{
+								Kind:                    profilerecordingapi.ProfileRecordingKindSeccompProfile,
+								Recorder:                profilerecordingapi.ProfileRecorderBpf,
+								AppendToExistingProfile: true,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // success pod unchanged - bind after recording without merge strategy
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
+					Items: []profilerecordingapi.ProfileRecording{
+						{
+							Spec: profilerecordingapi.ProfileRecordingSpec{
+								Kind:               profilerecordingapi.ProfileRecordingKindSeccompProfile,
+								Recorder:           profilerecordingapi.ProfileRecorderBpf,
+								BindAfterRecording: true,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // success pod unchanged - exec policy with bpf recorder
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
+					Items: []profilerecordingapi.ProfileRecording{
+						{
+							Spec: profilerecordingapi.ProfileRecordingSpec{
+								Kind:       profilerecordingapi.ProfileRecordingKindSeccompProfile,
+								Recorder:   profilerecordingapi.ProfileRecorderBpf,
+								ExecPolicy: profilerecordingapi.ExecPolicyExclude,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
 		// todo: bad combination, selinux + hook
 		// todo: actually look at the content of the patches
 		{ // success pod changed - tailing logs
@@ -138,6 +241,60 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 2) // 2 because security context and the annotation
 			},
 		},
+		{ // success pod changed - seccomp notify
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				spec := profilerecordingapi.ProfileRecordingSpec{
+					Kind:          profilerecordingapi.ProfileRecordingKindSeccompProfile,
+					Recorder:      profilerecordingapi.ProfileRecorderLogs,
+					SeccompNotify: true,
+				}
+				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
+					Items: []profilerecordingapi.ProfileRecording{{Spec: spec}},
+				}, nil)
+				mock.GetProfileRecordingReturns(&profilerecordingapi.ProfileRecording{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-little-profile-recording",
+						Namespace: "test-ns",
+					},
+					Spec: spec,
+				}, nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{
+					Items: []corev1.Pod{},
+				}, nil)
+				mock.GetOperatorNamespaceReturns("test-ns")
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.NoError(t, err)
+
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Len(t, resp.Patches, 2) // 2 because security context and the annotation
+
+				found := false
+
+				for _, patch := range resp.Patches {
+					b, err := json.Marshal(patch.Value)
+					require.NoError(t, err)
+
+					if strings.Contains(string(b), "operator/test-ns/seccomp-notify-trace.json") {
+						found = true
+					}
+				}
+
+				require.True(t, found)
+			},
+		},
 		{ // success pod changed
 			prepare: func(mock *recordingfakes.FakeImpl) {
 				mock.ListProfileRecordingsReturns(&profilerecordingapi.ProfileRecordingList{
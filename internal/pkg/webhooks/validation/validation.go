@@ -26,16 +26,40 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
 )
 
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles,verbs=get;list;watch
 
+// errParserRejected is the denial reason of raw AppArmor profiles which
+// apparmor_parser cannot compile.
+const errParserRejected = "apparmor_parser cannot compile the raw profile, see the webhook logs for details"
+
 type rawSelinuxProfileValidator struct {
 	decoder admission.Decoder
 	log     logr.Logger
 }
 
+// profileParser compiles AppArmor profiles without loading them.
+type profileParser interface {
+	DryRun(ctx context.Context, profile string) error
+	ProfileNames(ctx context.Context, profile string) ([]string, error)
+}
+
+type appArmorProfileValidator struct {
+	decoder admission.Decoder
+	parser  profileParser
+	log     logr.Logger
+}
+
+type seccompProfileValidator struct {
+	decoder admission.Decoder
+	log     logr.Logger
+}
+
 func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme) {
 	server.Register(
 		"/validate-rawselinuxprofile",
@@ -46,6 +70,32 @@ func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme) {
 			},
 		},
 	)
+
+	appArmorValidator := &appArmorProfileValidator{
+		decoder: admission.NewDecoder(scheme),
+		log:     logf.Log.WithName("apparmorprofile-validation"),
+	}
+
+	if parser, found := crd2armor.FindParser(); found {
+		appArmorValidator.parser = parser
+	} else {
+		appArmorValidator.log.Info("apparmor_parser not found, raw profiles are only validated statically")
+	}
+
+	server.Register(
+		"/validate-apparmorprofile",
+		&webhook.Admission{Handler: appArmorValidator},
+	)
+
+	server.Register(
+		"/validate-seccompprofile",
+		&webhook.Admission{
+			Handler: &seccompProfileValidator{
+				decoder: admission.NewDecoder(scheme),
+				log:     logf.Log.WithName("seccompprofile-validation"),
+			},
+		},
+	)
 }
 
 //nolint:gocritic // req passed by value per admission.Handler interface
@@ -65,3 +115,72 @@ func (v *rawSelinuxProfileValidator) Handle(
 
 	return admission.Allowed("")
 }
+
+//nolint:gocritic // req passed by value per admission.Handler interface
+func (v *appArmorProfileValidator) Handle(
+	ctx context.Context, req admission.Request,
+) admission.Response {
+	aap := &apparmorprofileapi.AppArmorProfile{}
+	if err := v.decoder.Decode(req, aap); err != nil {
+		v.log.Error(err, "failed to decode AppArmorProfile")
+
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !aap.IsRaw() {
+		return admission.Allowed("")
+	}
+
+	if err := aap.ValidateRaw(); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	if err := crd2armor.ValidateRawProfile(aap.GetProfileName(), aap.Spec.Raw); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	if v.parser == nil {
+		return admission.Allowed("").WithWarnings(
+			"apparmor_parser is not available in the webhook, the profile is only validated on the nodes",
+		)
+	}
+
+	// The parser output may contain the content of arbitrary files, so it
+	// is only logged.
+	if err := v.parser.DryRun(ctx, aap.Spec.Raw); err != nil {
+		v.log.Error(err, "apparmor_parser rejected raw profile", "profile", aap.GetName())
+
+		return admission.Denied(errParserRejected)
+	}
+
+	names, err := v.parser.ProfileNames(ctx, aap.Spec.Raw)
+	if err != nil {
+		v.log.Error(err, "apparmor_parser rejected raw profile", "profile", aap.GetName())
+
+		return admission.Denied(errParserRejected)
+	}
+
+	if err := crd2armor.ValidateProfileNames(aap.GetProfileName(), names); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+//nolint:gocritic // req passed by value per admission.Handler interface
+func (v *seccompProfileValidator) Handle(
+	_ context.Context, req admission.Request,
+) admission.Response {
+	sp := &seccompprofileapi.SeccompProfile{}
+	if err := v.decoder.Decode(req, sp); err != nil {
+		v.log.Error(err, "failed to decode SeccompProfile")
+
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := sp.ValidateNotify(); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
@@ -23,7 +23,11 @@ with pkgs; buildGoModule rec {
   buildPhase = ''
     make
   '';
+  # apparmor_parser and the AppArmor abstractions are shipped for validating
+  # raw AppArmor profiles in the webhook.
   installPhase = ''
     install -Dm755 -t $out build/security-profiles-operator build/spoc
+    install -Dm755 -t $out ${pkgsStatic.apparmor-parser}/bin/apparmor_parser
+    cp -r ${apparmor-profiles}/etc/apparmor.d $out/apparmor.d
   '';
 }